@@ -14,6 +14,7 @@ import (
 type AddControllersCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
+	ControllerRolesFlags
 	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
 	KYC        currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
@@ -86,6 +87,7 @@ func (cmd *AddControllersCommand) createOperation() (base.Operation, error) { //
 		cmd.contract,
 		cmd.KYC.ID,
 		cmd.controller,
+		cmd.Roles(),
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
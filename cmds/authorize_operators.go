@@ -19,6 +19,8 @@ type AuthorizeOperatorsCommand struct {
 	Operator  currencycmds.AddressFlag    `arg:"" name:"operator" help:"operator" required:"true"`
 	Partition PartitionFlag               `arg:"" name:"partition" help:"default partition" required:"true"`
 	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Allowance currencycmds.BigFlag        `name:"allowance" help:"maximum amount operator may spend"`
+	Expiry    uint64                      `name:"expiry" help:"last height operator may act"`
 	sender    base.Address
 	contract  base.Address
 	operator  base.Address
@@ -87,6 +89,8 @@ func (cmd *AuthorizeOperatorsCommand) createOperation() (base.Operation, error)
 		cmd.STO.ID,
 		cmd.operator,
 		cmd.Partition.Partition,
+		cmd.Allowance.Big,
+		base.Height(cmd.Expiry),
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
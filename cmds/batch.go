@@ -0,0 +1,366 @@
+package cmds
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+// BatchCommand creates the operations of the items in file. The columns of
+// items are named after the arguments of each operation command, eg.
+// contract, sto-id, receiver, amount, partition and currency-id for
+// issue-security-token. Items are split into operations by the max items of
+// network policy; the default is networktypes.DefaultMaxItems, so --max-items
+// should be set when the network policy is updated.
+type BatchCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Operation string                   `arg:"" name:"operation" help:"operation of items, eg. issue-security-token or add-customers" required:"true"`
+	Sender    currencycmds.AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
+	ItemsFile string                   `name:"items-file" help:"csv file with header or json lines file of items" type:"existingfile" required:"true"`
+	Format    string                   `name:"format" help:"format of items file; csv or json, guessed from extension by default"`
+	Set       map[string]string        `name:"set" help:"column value used when item has none, eg. --set currency-id=MCC"`
+	MaxItems  uint                     `name:"max-items" help:"max items in one operation; set it to max items of network policy, 10 by default"`
+	sender    base.Address
+	rows      []batchRow
+}
+
+func NewBatchCommand() BatchCommand {
+	cmd := NewBaseCommand()
+	return BatchCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *BatchCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	ops, err := cmd.createOperations()
+	if err != nil {
+		return err
+	}
+
+	for i := range ops {
+		currencycmds.PrettyPrint(cmd.Out, ops[i])
+	}
+
+	return nil
+}
+
+func (cmd *BatchCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	if _, found := batchOperations[cmd.Operation]; !found {
+		return errors.Errorf("unknown batch operation, %q; one of %s", cmd.Operation, strings.Join(batchOperationNames(), ", "))
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	f, err := os.Open(filepath.Clean(cmd.ItemsFile))
+	if err != nil {
+		return errors.Wrapf(err, "failed to open items file, %q", cmd.ItemsFile)
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	format := cmd.Format
+	if len(format) < 1 {
+		if strings.EqualFold(filepath.Ext(cmd.ItemsFile), ".csv") {
+			format = "csv"
+		} else {
+			format = "json"
+		}
+	}
+
+	switch format {
+	case "csv":
+		cmd.rows, err = readCSVBatchRows(f)
+	case "json":
+		cmd.rows, err = readJSONBatchRows(f)
+	default:
+		return errors.Errorf("unknown items file format, %q", format)
+	}
+
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "failed to read items file, %q", cmd.ItemsFile)
+	case len(cmd.rows) < 1:
+		return errors.Errorf("empty items file, %q", cmd.ItemsFile)
+	}
+
+	for i := range cmd.rows {
+		for k, v := range cmd.Set {
+			if len(cmd.rows[i].values[k]) < 1 {
+				cmd.rows[i].values[k] = v
+			}
+		}
+	}
+
+	return nil
+}
+
+func (cmd *BatchCommand) createOperations() ([]base.Operation, error) {
+	bo := batchOperations[cmd.Operation]
+
+	// NOTE the items of operation are limited by the network policy of node,
+	// which is usually much less than the max items of fact
+	maxItems := uint(networktypes.DefaultMaxItems)
+	switch {
+	case cmd.MaxItems > bo.maxItems:
+		return nil, errors.Errorf("max items over max items of fact, %d > %d", cmd.MaxItems, bo.maxItems)
+	case cmd.MaxItems > 0:
+		maxItems = cmd.MaxItems
+	case maxItems > bo.maxItems:
+		maxItems = bo.maxItems
+	}
+
+	items := make([]util.IsValider, len(cmd.rows))
+	for i := range cmd.rows {
+		it, err := bo.item(cmd.rows[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid item, line %d", cmd.rows[i].line)
+		}
+
+		if err := it.IsValid(nil); err != nil {
+			return nil, errors.Wrapf(err, "invalid item, line %d", cmd.rows[i].line)
+		}
+
+		items[i] = it
+	}
+
+	var ops []base.Operation // nolint:prealloc
+
+	for i := 0; i < len(items); i += int(maxItems) {
+		end := i + int(maxItems)
+		if end > len(items) {
+			end = len(items)
+		}
+
+		op, err := bo.operation([]byte(cmd.Token), cmd.sender, items[i:end], cmd.Privatekey, cmd.NetworkID.NetworkID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create %s operation", cmd.Operation)
+		}
+
+		if err := op.IsValid(cmd.NetworkID.NetworkID()); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s operation", cmd.Operation)
+		}
+
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+func batchOperationNames() []string {
+	names := make([]string, 0, len(batchOperations))
+	for k := range batchOperations {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+type batchOperation struct {
+	maxItems  uint
+	item      func(batchRow) (util.IsValider, error)
+	operation func(token []byte, sender base.Address, items []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error)
+}
+
+// batchRow is an item in items file; values are kept as the strings of
+// command line arguments.
+type batchRow struct {
+	line   int
+	values map[string]string
+}
+
+func (r batchRow) value(k string) string {
+	return r.values[k]
+}
+
+func (r batchRow) required(k string) (string, error) {
+	v := r.values[k]
+	if len(v) < 1 {
+		return "", errors.Errorf("empty %q", k)
+	}
+
+	return v, nil
+}
+
+func (r batchRow) address(k string) (base.Address, error) {
+	v, err := r.required(k)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := base.DecodeAddress(v, enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %q, %q", k, v)
+	}
+
+	return a, nil
+}
+
+func (r batchRow) contractID(k string) (currencytypes.ContractID, error) {
+	v, err := r.required(k)
+
+	return currencytypes.ContractID(v), err
+}
+
+func (r batchRow) currency() (currencytypes.CurrencyID, error) {
+	v, err := r.required("currency-id")
+
+	return currencytypes.CurrencyID(v), err
+}
+
+func (r batchRow) partition() (stotypes.Partition, error) {
+	v, err := r.required("partition")
+
+	return stotypes.Partition(v), err
+}
+
+func (r batchRow) big(k string) (common.Big, error) {
+	v, err := r.required(k)
+	if err != nil {
+		return common.Big{}, err
+	}
+
+	b, err := common.NewBigFromString(v)
+	if err != nil {
+		return common.Big{}, errors.Wrapf(err, "invalid %q, %q", k, v)
+	}
+
+	return b, nil
+}
+
+func (r batchRow) uint64(k string) (uint64, error) {
+	v := r.value(k)
+	if len(v) < 1 {
+		return 0, nil
+	}
+
+	i, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %q, %q", k, v)
+	}
+
+	return i, nil
+}
+
+func readCSVBatchRows(f io.Reader) ([]batchRow, error) {
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read header")
+	}
+
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var rows []batchRow
+
+	for line := 2; ; line++ {
+		record, err := r.Read()
+		switch {
+		case errors.Is(err, io.EOF):
+			return rows, nil
+		case err != nil:
+			return nil, err
+		}
+
+		values := map[string]string{}
+		for i := range header {
+			values[header[i]] = strings.TrimSpace(record[i])
+		}
+
+		rows = append(rows, batchRow{line: line, values: values})
+	}
+}
+
+func readJSONBatchRows(f io.Reader) ([]batchRow, error) {
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []batchRow
+
+	for i, l := range strings.Split(string(b), "\n") {
+		if len(strings.TrimSpace(l)) < 1 {
+			continue
+		}
+
+		d := json.NewDecoder(strings.NewReader(l))
+		d.UseNumber()
+
+		var m map[string]interface{}
+		if err := d.Decode(&m); err != nil {
+			return nil, errors.Wrapf(err, "line %d", i+1)
+		}
+
+		values := map[string]string{}
+		for k, v := range m {
+			values[k] = batchValueString(v)
+		}
+
+		rows = append(rows, batchRow{line: i + 1, values: values})
+	}
+
+	return rows, nil
+}
+
+func batchValueString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case []interface{}:
+		l := make([]string, len(t))
+		for i := range t {
+			l[i] = batchValueString(t[i])
+		}
+
+		return strings.Join(l, ",")
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
@@ -0,0 +1,621 @@
+package cmds
+
+import (
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+// batchOperations are the operations of BatchCommand by the names of
+// operation commands.
+var batchOperations = map[string]batchOperation{
+	"issue-security-token": {
+		maxItems: sto.MaxIssueSecurityTokensItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			receiver, err := r.address("receiver")
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := r.big("amount")
+			if err != nil {
+				return nil, err
+			}
+
+			partition, err := r.partition()
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewIssueSecurityTokensItem(contract, stoID, receiver, amount, partition, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.IssueSecurityTokensItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.IssueSecurityTokensItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewIssueSecurityTokens(sto.NewIssueSecurityTokensFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"transfer-security-token": {
+		maxItems: sto.MaxTransferSecurityTokensPartitionItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			holder, err := r.address("tokenholder")
+			if err != nil {
+				return nil, err
+			}
+
+			receiver, err := r.address("receiver")
+			if err != nil {
+				return nil, err
+			}
+
+			partition, err := r.partition()
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := r.big("amount")
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewTransferSecurityTokensPartitionItem(contract, stoID, holder, receiver, partition, amount, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.TransferSecurityTokensPartitionItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.TransferSecurityTokensPartitionItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewTransferSecurityTokensPartition(sto.NewTransferSecurityTokensPartitionFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"redeem-token": {
+		maxItems: sto.MaxRedeemTokensItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			holder, err := r.address("tokenholder")
+			if err != nil {
+				return nil, err
+			}
+
+			amount, err := r.big("amount")
+			if err != nil {
+				return nil, err
+			}
+
+			partition, err := r.partition()
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewRedeemTokensItem(contract, stoID, holder, amount, partition, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.RedeemTokensItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.RedeemTokensItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewRedeemTokens(sto.NewRedeemTokensFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"authorize-operator": {
+		maxItems: sto.MaxAuthorizeOperatorsItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			operator, err := r.address("operator")
+			if err != nil {
+				return nil, err
+			}
+
+			partition, err := r.partition()
+			if err != nil {
+				return nil, err
+			}
+
+			allowance := common.NilBig
+			if len(r.value("allowance")) > 0 {
+				if allowance, err = r.big("allowance"); err != nil {
+					return nil, err
+				}
+			}
+
+			expiry, err := r.uint64("expiry")
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewAuthorizeOperatorsItem(contract, stoID, operator, partition, allowance, base.Height(expiry), cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.AuthorizeOperatorsItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.AuthorizeOperatorsItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewAuthorizeOperators(sto.NewAuthorizeOperatorsFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"revoke-operator": {
+		maxItems: sto.MaxRevokeOperatorsItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			operator, err := r.address("operator")
+			if err != nil {
+				return nil, err
+			}
+
+			partition, err := r.partition()
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewRevokeOperatorsItem(contract, stoID, operator, partition, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.RevokeOperatorsItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.RevokeOperatorsItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewRevokeOperators(sto.NewRevokeOperatorsFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"authorize-global-operator": {
+		maxItems: sto.MaxAuthorizeGlobalOperatorsItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			operator, err := r.address("operator")
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewAuthorizeGlobalOperatorsItem(contract, stoID, operator, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.AuthorizeGlobalOperatorsItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.AuthorizeGlobalOperatorsItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewAuthorizeGlobalOperators(sto.NewAuthorizeGlobalOperatorsFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"revoke-global-operator": {
+		maxItems: sto.MaxRevokeGlobalOperatorsItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, stoID, cid, err := r.stoItem()
+			if err != nil {
+				return nil, err
+			}
+
+			operator, err := r.address("operator")
+			if err != nil {
+				return nil, err
+			}
+
+			return sto.NewRevokeGlobalOperatorsItem(contract, stoID, operator, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]sto.RevokeGlobalOperatorsItem, len(l))
+			for i := range l {
+				items[i] = l[i].(sto.RevokeGlobalOperatorsItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := sto.NewRevokeGlobalOperators(sto.NewRevokeGlobalOperatorsFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"add-controllers": {
+		maxItems: kyc.MaxAddControllersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			controller, err := r.address("controller")
+			if err != nil {
+				return nil, err
+			}
+
+			fl := ControllerRolesFlags{Role: []string{string(kyctypes.RoleReviewer), string(kyctypes.RoleApprover)}}
+			if v := r.value("role"); len(v) > 0 {
+				fl.Role = strings.Split(v, ",")
+			}
+
+			return kyc.NewAddControllersItem(contract, kycID, controller, fl.Roles(), cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.AddControllersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.AddControllersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewAddControllers(kyc.NewAddControllersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"remove-controllers": {
+		maxItems: kyc.MaxRemoveControllersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			controller, err := r.address("controller")
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewRemoveControllersItem(contract, kycID, controller, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.RemoveControllersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.RemoveControllersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewRemoveControllers(kyc.NewRemoveControllersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"add-customers": {
+		maxItems: kyc.MaxAddCustomersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			customer, err := r.address("customer")
+			if err != nil {
+				return nil, err
+			}
+
+			info, err := r.customerInfo()
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewAddCustomersItem(contract, kycID, customer, info, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.AddCustomersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.AddCustomersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewAddCustomers(kyc.NewAddCustomersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"update-customers": {
+		maxItems: kyc.MaxUpdateCustomersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			customer, err := r.address("customer")
+			if err != nil {
+				return nil, err
+			}
+
+			info, err := r.customerInfo()
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewUpdateCustomersItem(contract, kycID, customer, info, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.UpdateCustomersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.UpdateCustomersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewUpdateCustomers(kyc.NewUpdateCustomersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"renew-customers": {
+		maxItems: kyc.MaxRenewCustomersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			customer, err := r.address("customer")
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := r.required("expiry-height"); err != nil {
+				return nil, err
+			}
+
+			expiry, err := r.uint64("expiry-height")
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewRenewCustomersItem(contract, kycID, customer, expiry, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.RenewCustomersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.RenewCustomersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewRenewCustomers(kyc.NewRenewCustomersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"approve-customers": {
+		maxItems: kyc.MaxApproveCustomersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			customer, err := r.address("customer")
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewApproveCustomersItem(contract, kycID, customer, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.ApproveCustomersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.ApproveCustomersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewApproveCustomers(kyc.NewApproveCustomersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+	"remove-customers": {
+		maxItems: kyc.MaxRemoveCustomersItems,
+		item: func(r batchRow) (util.IsValider, error) {
+			contract, kycID, cid, err := r.kycItem()
+			if err != nil {
+				return nil, err
+			}
+
+			customer, err := r.address("customer")
+			if err != nil {
+				return nil, err
+			}
+
+			return kyc.NewRemoveCustomersItem(contract, kycID, customer, cid), nil
+		},
+		operation: func(token []byte, sender base.Address, l []util.IsValider, priv base.Privatekey, networkID base.NetworkID) (base.Operation, error) {
+			items := make([]kyc.RemoveCustomersItem, len(l))
+			for i := range l {
+				items[i] = l[i].(kyc.RemoveCustomersItem) //nolint:forcetypeassert //...
+			}
+
+			op, err := kyc.NewRemoveCustomers(kyc.NewRemoveCustomersFact(token, sender, items))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.HashSign(priv, networkID); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	},
+}
+
+func (r batchRow) stoItem() (base.Address, currencytypes.ContractID, currencytypes.CurrencyID, error) {
+	contract, err := r.address("contract")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	stoID, err := r.contractID("sto-id")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	cid, err := r.currency()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return contract, stoID, cid, nil
+}
+
+func (r batchRow) kycItem() (base.Address, currencytypes.ContractID, currencytypes.CurrencyID, error) {
+	contract, err := r.address("contract")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	kycID, err := r.contractID("kyc-id")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	cid, err := r.currency()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return contract, kycID, cid, nil
+}
+
+func (r batchRow) customerInfo() (kyctypes.CustomerInfo, error) {
+	status, err := r.required("status")
+	if err != nil {
+		return kyctypes.CustomerInfo{}, err
+	}
+
+	expiry, err := r.uint64("expiry-height")
+	if err != nil {
+		return kyctypes.CustomerInfo{}, err
+	}
+
+	fl := CustomerInfoFlags{
+		Jurisdiction:  r.value("jurisdiction"),
+		Accreditation: r.value("accreditation"),
+		Category:      r.value("category"),
+		ExpiryHeight:  expiry,
+		DocumentHash:  r.value("document-hash"),
+	}
+
+	return fl.CustomerInfo(kyctypes.CustomerStatus(status))
+}
@@ -0,0 +1,78 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/pkg/captable"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type CapTableCommand struct { //nolint:govet //...
+	BaseCommand
+	Storage  string                      `arg:"" name:"storage" help:"base directory of local storage; storage.base of node design" type:"existingdir"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO      currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	Height   int64                       `name:"height" help:"cap table at height; last height if not set" default:"-1"`
+	Format   string                      `name:"format" help:"output format; json or csv" enum:"json,csv" default:"json"`
+	contract base.Address
+	height   base.Height
+}
+
+func NewCapTableCommand() CapTableCommand {
+	cmd := NewBaseCommand()
+	return CapTableCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *CapTableCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	t, err := captable.Load(launch.LocalFSDataDirectory(cmd.Storage), cmd.Encoder, cmd.contract, cmd.STO.ID, cmd.height)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Format == "csv" {
+		return t.WriteCSV(cmd.Out)
+	}
+
+	b, err := util.MarshalJSONIndent(t)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.Out, string(b))
+
+	return errors.WithStack(err)
+}
+
+func (cmd *CapTableCommand) parseFlags() error {
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	cmd.height = base.NilHeight
+	if cmd.Height >= 0 {
+		cmd.height = base.Height(cmd.Height)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package cmds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/pkg/invariant"
+	"github.com/ProtoconNet/mitum-sto/pkg/localfs"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+type CheckSupplyCommand struct { //nolint:govet //...
+	BaseCommand
+	Storage  string                      `arg:"" name:"storage" help:"base directory of local storage; storage.base of node design" type:"existingdir"`
+	Contract currencycmds.AddressFlag    `name:"contract" help:"contract address of sto"`
+	STO      currencycmds.ContractIDFlag `name:"sto-id" help:"sto id; needs contract"`
+	Height   int64                       `name:"height" help:"check states at height; last height if not set" default:"-1"`
+	prefix   string
+	height   base.Height
+}
+
+func NewCheckSupplyCommand() CheckSupplyCommand {
+	cmd := NewBaseCommand()
+	return CheckSupplyCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *CheckSupplyCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	sts, last, err := localfs.LoadStates(launch.LocalFSDataDirectory(cmd.Storage), cmd.Encoder, cmd.height, cmd.filter)
+	if err != nil {
+		return err
+	}
+
+	vs, err := invariant.Check(sts)
+	if err != nil {
+		return err
+	}
+
+	b, err := util.MarshalJSONIndent(struct {
+		Height     base.Height           `json:"height"`
+		Violations []invariant.Violation `json:"violations"`
+	}{Height: last, Violations: vs})
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(cmd.Out, string(b)); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(vs) > 0 {
+		return errors.Errorf("%d violations found at height, %d", len(vs), last)
+	}
+
+	return nil
+}
+
+func (cmd *CheckSupplyCommand) parseFlags() error {
+	cmd.height = base.NilHeight
+	if cmd.Height >= 0 {
+		cmd.height = base.Height(cmd.Height)
+	}
+
+	cmd.prefix = stostate.STOPrefix
+
+	if len(cmd.Contract.String()) < 1 {
+		if len(cmd.STO.ID) > 0 {
+			return errors.Errorf("sto id needs contract")
+		}
+
+		return nil
+	}
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+
+	if len(cmd.STO.ID) < 1 {
+		cmd.prefix = stostate.STOPrefix + contract.String()
+	} else {
+		cmd.prefix = stostate.StateKeySTOPrefix(contract, cmd.STO.ID)
+	}
+
+	return nil
+}
+
+func (cmd *CheckSupplyCommand) filter(key string) bool {
+	switch {
+	case !strings.HasPrefix(key, cmd.prefix):
+		return false
+	case cmd.prefix == stostate.STOPrefix:
+		return true
+	default:
+		// NOTE the prefix must not match the longer contract address or id
+		rest := key[len(cmd.prefix):]
+
+		return len(rest) > 0 && (rest[0] == ':' || rest[0] == '-')
+	}
+}
@@ -7,20 +7,23 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 )
 
 type CreateKYCServiceCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
+	ControllerRolesFlags
 	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of kyc" required:"true"`
 	KYC         currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
 	Controller  currencycmds.AddressFlag    `arg:"" name:"controller" help:"controller" required:"true"`
 	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Quorum      uint64                      `name:"quorum" help:"number of approvers which approve pending customer" default:"1"`
 	sender      base.Address
 	contract    base.Address
-	controllers []base.Address
+	controllers []kyctypes.Controller
 }
 
 func NewCreateKYCServiceCommand() CreateKYCServiceCommand {
@@ -73,13 +76,13 @@ func (cmd *CreateKYCServiceCommand) parseFlags() error {
 	if err != nil {
 		return errors.Wrapf(err, "invalid controller account format, %q", cmd.Controller.String())
 	}
-	cmd.controllers = []base.Address{controller}
+	cmd.controllers = []kyctypes.Controller{kyctypes.NewController(controller, cmd.Roles())}
 
 	return nil
 }
 
 func (cmd *CreateKYCServiceCommand) createOperation() (base.Operation, error) { // nolint:dupl}
-	fact := kyc.NewCreateKYCServiceFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.KYC.ID, cmd.controllers, cmd.Currency.CID)
+	fact := kyc.NewCreateKYCServiceFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.KYC.ID, cmd.controllers, cmd.Quorum, cmd.Currency.CID)
 
 	op, err := kyc.NewCreateKYCService(fact)
 	if err != nil {
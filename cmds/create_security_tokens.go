@@ -7,6 +7,7 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
 )
 
@@ -20,9 +21,11 @@ type CreateSecurityTokensCommand struct {
 	Partition   PartitionFlag               `arg:"" name:"default-partition" help:"default partition" required:"true"`
 	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	Controller  currencycmds.AddressFlag    `name:"controller" help:"controller"`
+	KYCRequirementFlags
 	sender      base.Address
 	contract    base.Address
 	controllers []base.Address
+	kyc         stotypes.KYCRequirement
 }
 
 func NewCreateSecurityTokensCommand() CreateSecurityTokensCommand {
@@ -79,6 +82,12 @@ func (cmd *CreateSecurityTokensCommand) parseFlags() error {
 		cmd.controllers = []base.Address{controller}
 	}
 
+	kyc, err := cmd.KYCRequirement()
+	if err != nil {
+		return errors.Wrap(err, "invalid kyc requirement")
+	}
+	cmd.kyc = kyc
+
 	return nil
 }
 
@@ -91,6 +100,7 @@ func (cmd *CreateSecurityTokensCommand) createOperation() (base.Operation, error
 		cmd.Granularity,
 		cmd.Partition.Partition,
 		cmd.controllers,
+		cmd.kyc,
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
@@ -1,7 +1,13 @@
 package cmds
 
 import (
+	"strings"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 )
 
 type PartitionFlag struct {
@@ -21,3 +27,73 @@ func (v *PartitionFlag) UnmarshalText(b []byte) error {
 func (v *PartitionFlag) String() string {
 	return v.Partition.String()
 }
+
+type CustomerInfoFlags struct {
+	Jurisdiction  string `name:"jurisdiction" help:"ISO 3166-1 alpha-2 country code of customer, eg. US"`
+	Accreditation string `name:"accreditation" help:"accreditation type of customer, eg. accredited"`
+	Category      string `name:"category" help:"investor category of customer, eg. retail"`
+	ExpiryHeight  uint64 `name:"expiry-height" help:"last height of the verification; 0 means no expiry" default:"0"`
+	DocumentHash  string `name:"document-hash" help:"hash of off-chain kyc document"`
+}
+
+func (fl CustomerInfoFlags) CustomerInfo(status kyctypes.CustomerStatus) (kyctypes.CustomerInfo, error) {
+	info := kyctypes.NewCustomerInfo(
+		status,
+		kyctypes.Jurisdiction(fl.Jurisdiction),
+		fl.Accreditation,
+		fl.Category,
+		fl.ExpiryHeight,
+		fl.DocumentHash,
+	)
+	if err := info.IsValid(nil); err != nil {
+		return kyctypes.CustomerInfo{}, err
+	}
+
+	return info, nil
+}
+
+type KYCRequirementFlags struct {
+	KYCServices []string `name:"kyc" help:"kyc service required for tokenholders, as <contract>:<kyc-id>"`
+	KYCMode     string   `name:"kyc-mode" help:"how kyc services are combined; any-of or all-of" default:"any-of"`
+}
+
+func (fl KYCRequirementFlags) KYCRequirement() (stotypes.KYCRequirement, error) {
+	if len(fl.KYCServices) < 1 {
+		return stotypes.EmptyKYCRequirement(), nil
+	}
+
+	services := make([]stotypes.KYCService, len(fl.KYCServices))
+	for i, s := range fl.KYCServices {
+		l := strings.SplitN(s, ":", 2)
+		if len(l) != 2 {
+			return stotypes.KYCRequirement{}, errors.Errorf("invalid kyc service format, %q", s)
+		}
+
+		contract, err := base.DecodeAddress(l[0], enc)
+		if err != nil {
+			return stotypes.KYCRequirement{}, errors.Wrapf(err, "invalid kyc contract account format, %q", l[0])
+		}
+
+		services[i] = stotypes.NewKYCService(contract, currencytypes.ContractID(l[1]))
+	}
+
+	kyc := stotypes.NewKYCRequirement(services, stotypes.KYCMode(fl.KYCMode))
+	if err := kyc.IsValid(nil); err != nil {
+		return stotypes.KYCRequirement{}, err
+	}
+
+	return kyc, nil
+}
+
+type ControllerRolesFlags struct {
+	Role []string `name:"role" help:"role of controller; reviewer, approver or admin" default:"reviewer,approver"`
+}
+
+func (fl ControllerRolesFlags) Roles() []kyctypes.Role {
+	roles := make([]kyctypes.Role, len(fl.Role))
+	for i := range fl.Role {
+		roles[i] = kyctypes.Role(fl.Role[i])
+	}
+
+	return roles
+}
@@ -0,0 +1,493 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	isaacoperation "github.com/ProtoconNet/mitum-currency/v3/operation/isaac"
+	"github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/genesis"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	isaacblock "github.com/ProtoconNet/mitum2/isaac/block"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/logging"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+)
+
+// GenesisBlockGenerator is the genesis block generator of mitum-currency with
+// the sto genesis facts. The generator of mitum-currency rejects the facts it
+// does not know and can not be extended, so it is copied here.
+type GenesisBlockGenerator struct {
+	local    base.LocalNode
+	enc      encoder.Encoder
+	db       isaac.Database
+	proposal base.ProposalSignFact
+	ivp      base.INITVoteproof
+	avp      base.ACCEPTVoteproof
+	*logging.Logging
+	dataroot  string
+	networkID base.NetworkID
+	facts     []base.Fact
+	ops       []base.Operation
+}
+
+func NewGenesisBlockGenerator(
+	local base.LocalNode,
+	networkID base.NetworkID,
+	enc encoder.Encoder,
+	db isaac.Database,
+	dataroot string,
+	facts []base.Fact,
+) *GenesisBlockGenerator {
+	return &GenesisBlockGenerator{
+		Logging: logging.NewLogging(func(zctx zerolog.Context) zerolog.Context {
+			return zctx.Str("module", "genesis-block-generator")
+		}),
+		local:     local,
+		networkID: networkID,
+		enc:       enc,
+		db:        db,
+		dataroot:  dataroot,
+		facts:     facts,
+	}
+}
+
+func (g *GenesisBlockGenerator) Generate() (base.BlockMap, error) {
+	e := util.StringError("generate genesis block")
+
+	if err := g.generateOperations(); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if err := g.newProposal(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	if err := g.process(); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	fsreader, err := isaacblock.NewLocalFSReaderFromHeight(g.dataroot, base.GenesisHeight, g.enc)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	switch blockmap, found, err := fsreader.BlockMap(); {
+	case err != nil:
+		return nil, e.Wrap(err)
+	case !found:
+		return nil, errors.Errorf("blockmap not found")
+	default:
+		if err := blockmap.IsValid(g.networkID); err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		g.Log().Info().Interface("blockmap", blockmap).Msg("genesis block generated")
+
+		if err := g.closeDatabase(); err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		return blockmap, nil
+	}
+}
+
+func (g *GenesisBlockGenerator) generateOperations() error {
+	g.ops = make([]base.Operation, len(g.facts))
+
+	factTypes := map[string]struct{}{}
+	contracts := map[string]struct{}{}
+	accounts := map[string]struct{}{}
+	var contractFacts []genesis.GenesisContractFact
+
+	for i := range g.facts {
+		fact := g.facts[i]
+
+		var err error
+
+		hinter, ok := fact.(hint.Hinter)
+		if !ok {
+			return errors.Errorf("fact does not support Hinter")
+		}
+
+		switch ht := hinter.Hint(); {
+		case ht.IsCompatible(isaacoperation.SuffrageGenesisJoinFactHint):
+			if _, found := factTypes[ht.String()]; found {
+				return errors.Errorf("multiple join operation found")
+			}
+
+			g.ops[i], err = g.joinOperation(fact)
+		case ht.IsCompatible(isaacoperation.GenesisNetworkPolicyFactHint):
+			if _, found := factTypes[ht.String()]; found {
+				return errors.Errorf("multiple network policy operation found")
+			}
+
+			g.ops[i], err = g.networkPolicyOperation(fact)
+		case ht.IsCompatible(currency.RegisterGenesisCurrencyFactHint):
+			if _, found := factTypes[ht.String()]; found {
+				return errors.Errorf("multiple RegisterGenesisCurrency operation found")
+			}
+			g.ops[i], err = g.registerGenesisCurrencyOperation(fact, g.networkID)
+			if err == nil {
+				err = addGenesisAccount(accounts, fact)
+			}
+		case ht.IsCompatible(network.GenesisNetworkPolicyFactHint):
+			if _, found := factTypes[ht.String()]; found {
+				return errors.Errorf("multiple sto network policy operation found")
+			}
+
+			g.ops[i], err = g.stoNetworkPolicyOperation(fact)
+		case ht.IsCompatible(genesis.GenesisContractFactHint):
+			f, ok := fact.(genesis.GenesisContractFact)
+			if !ok {
+				return errors.Errorf("expected GenesisContractFact, not %T", fact)
+			}
+
+			if _, found := contracts[f.Contract().String()]; found {
+				return errors.Errorf("multiple genesis contract operation found for %q", f.Contract())
+			}
+			contracts[f.Contract().String()] = struct{}{}
+			contractFacts = append(contractFacts, f)
+
+			g.ops[i], err = g.genesisContractOperation(f)
+		default:
+			return errors.Errorf("unknown genesis fact, %q", ht)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		factTypes[hinter.Hint().String()] = struct{}{}
+	}
+
+	return checkGenesisTokenHolders(accounts, contractFacts)
+}
+
+func addGenesisAccount(accounts map[string]struct{}, i base.Fact) error {
+	fact, ok := i.(currency.RegisterGenesisCurrencyFact)
+	if !ok {
+		return errors.Errorf("expected RegisterGenesisCurrencyFact, not %T", i)
+	}
+
+	a, err := fact.Address()
+	if err != nil {
+		return err
+	}
+
+	accounts[a.String()] = struct{}{}
+
+	return nil
+}
+
+// checkGenesisTokenHolders checks the tokenholders of genesis allocations are
+// the accounts created in genesis; the genesis operations can not see the
+// accounts of each other while processing.
+func checkGenesisTokenHolders(accounts map[string]struct{}, facts []genesis.GenesisContractFact) error {
+	for _, f := range facts {
+		for _, s := range f.SecurityTokens() {
+			for _, a := range s.Allocations() {
+				if _, found := accounts[a.TokenHolder().String()]; !found {
+					return errors.Errorf("genesis tokenholder account not found, %s-%s, %q",
+						f.Contract(), s.Design().STO(), a.TokenHolder())
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) joinOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make join operation")
+
+	basefact, ok := i.(isaacoperation.SuffrageGenesisJoinFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected SuffrageGenesisJoinFact, not %T", i)
+	}
+
+	fact := isaacoperation.NewSuffrageGenesisJoinFact(basefact.Nodes(), g.networkID)
+
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := isaacoperation.NewSuffrageGenesisJoin(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis join operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) networkPolicyOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make join operation")
+
+	basefact, ok := i.(isaacoperation.GenesisNetworkPolicyFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected GenesisNetworkPolicyFact, not %T", i)
+	}
+
+	fact := isaacoperation.NewGenesisNetworkPolicyFact(basefact.Policy())
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := isaacoperation.NewGenesisNetworkPolicy(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis network policy operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) registerGenesisCurrencyOperation(i base.Fact, token []byte) (base.Operation, error) {
+	e := util.StringError("make registerGenesisCurrency operation")
+
+	basefact, ok := i.(currency.RegisterGenesisCurrencyFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected RegisterGenesisCurrencyFact, not %T", i)
+	}
+	acks, err := types.NewBaseAccountKeys(basefact.Keys().Keys(), basefact.Keys().Threshold())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	fact := currency.NewRegisterGenesisCurrencyFact(token, basefact.GenesisNodeKey(), acks, basefact.Currencies())
+	if err := fact.IsValid(g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+	op := currency.NewRegisterGenesisCurrency(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+	g.Log().Debug().Interface("operation", op).Msg("genesis join operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) stoNetworkPolicyOperation(i base.Fact) (base.Operation, error) {
+	e := util.StringError("make sto network policy operation")
+
+	basefact, ok := i.(network.GenesisNetworkPolicyFact)
+	if !ok {
+		return nil, e.WithMessage(nil, "expected GenesisNetworkPolicyFact, not %T", i)
+	}
+
+	fact := network.NewGenesisNetworkPolicyFact(basefact.Policy())
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := network.NewGenesisNetworkPolicy(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis sto network policy operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) genesisContractOperation(basefact genesis.GenesisContractFact) (base.Operation, error) {
+	e := util.StringError("make genesis contract operation")
+
+	fact := genesis.NewGenesisContractFact(
+		basefact.Contract(),
+		basefact.Owner(),
+		basefact.KYCServices(),
+		basefact.SecurityTokens(),
+	)
+
+	if err := fact.IsValid(nil); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	op := genesis.NewGenesisContract(fact)
+	if err := op.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	g.Log().Debug().Interface("operation", op).Msg("genesis contract operation created")
+
+	return op, nil
+}
+
+func (g *GenesisBlockGenerator) newProposal(ops []util.Hash) error {
+	e := util.StringError("make genesis proposal")
+
+	nops := make([]util.Hash, len(ops)+len(g.ops))
+	copy(nops[:len(ops)], ops)
+
+	for i := range g.ops {
+		nops[i+len(ops)] = g.ops[i].Hash()
+	}
+
+	fact := isaac.NewProposalFact(base.GenesisPoint, g.local.Address(), nil, nops)
+	sign := isaac.NewProposalSignFact(fact)
+
+	if err := sign.Sign(g.local.Privatekey(), g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sign.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.proposal = sign
+
+	g.Log().Debug().Interface("proposal", sign).Msg("proposal created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) initVoetproof() error {
+	e := util.StringError("make genesis init voteproof")
+
+	fact := isaac.NewINITBallotFact(base.GenesisPoint, nil, g.proposal.Fact().Hash(), nil)
+	if err := fact.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	sf := isaac.NewINITBallotSignFact(fact)
+	if err := sf.NodeSign(g.local.Privatekey(), g.networkID, g.local.Address()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sf.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	vp := isaac.NewINITVoteproof(fact.Point().Point)
+	vp.
+		SetMajority(fact).
+		SetSignFacts([]base.BallotSignFact{sf}).
+		SetThreshold(base.MaxThreshold).
+		Finish()
+
+	if err := vp.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.ivp = vp
+
+	g.Log().Debug().Interface("init_voteproof", vp).Msg("init voteproof created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) acceptVoteproof(proposal, newblock util.Hash) error {
+	e := util.StringError("make genesis accept voteproof")
+
+	fact := isaac.NewACCEPTBallotFact(base.GenesisPoint, proposal, newblock, nil)
+	if err := fact.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	sf := isaac.NewACCEPTBallotSignFact(fact)
+	if err := sf.NodeSign(g.local.Privatekey(), g.networkID, g.local.Address()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sf.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	vp := isaac.NewACCEPTVoteproof(fact.Point().Point)
+	vp.
+		SetMajority(fact).
+		SetSignFacts([]base.BallotSignFact{sf}).
+		SetThreshold(base.MaxThreshold).
+		Finish()
+
+	if err := vp.IsValid(g.networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	g.avp = vp
+
+	g.Log().Debug().Interface("init_voteproof", vp).Msg("init voteproof created for genesis")
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) process() error {
+	e := util.StringError("process blockgenerator")
+
+	if err := g.initVoetproof(); err != nil {
+		return e.Wrap(err)
+	}
+
+	pp, err := g.newProposalProcessor()
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	_ = pp.SetLogging(g.Logging)
+
+	switch m, err := pp.Process(context.Background(), g.ivp); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		if err := m.IsValid(g.networkID); err != nil {
+			return e.Wrap(err)
+		}
+
+		g.Log().Info().Interface("manifest", m).Msg("genesis block generated")
+
+		if err := g.acceptVoteproof(g.proposal.Fact().Hash(), m.Hash()); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if _, err := pp.Save(context.Background(), g.avp); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) closeDatabase() error {
+	e := util.StringError("close database")
+
+	if err := g.db.MergeAllPermanent(); err != nil {
+		return e.WithMessage(err, "failed to merge temps")
+	}
+
+	return nil
+}
+
+func (g *GenesisBlockGenerator) newProposalProcessor() (*isaac.DefaultProposalProcessor, error) {
+	return isaac.NewDefaultProposalProcessor(
+		g.proposal,
+		nil,
+		launch.NewBlockWriterFunc(g.local, g.networkID, g.dataroot, g.enc, g.db),
+		func(key string) (base.State, bool, error) {
+			return nil, false, nil
+		},
+		func(_ context.Context, operationhash util.Hash) (base.Operation, error) {
+			for i := range g.ops {
+				op := g.ops[i]
+				if operationhash.Equal(op.Hash()) {
+					return op, nil
+				}
+			}
+
+			return nil, util.ErrNotFound.Errorf("operation not found")
+		},
+		func(base.Height, hint.Hint) (base.OperationProcessor, error) {
+			return nil, nil
+		},
+	)
+}
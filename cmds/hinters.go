@@ -2,11 +2,15 @@ package cmds
 
 import (
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/genesis"
 	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
 	"github.com/ProtoconNet/mitum-sto/operation/sto"
 	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 
 	"github.com/ProtoconNet/mitum2/launch"
@@ -25,10 +29,28 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: stostate.TokenHolderPartitionOperatorsStateValueHint, Instance: stostate.TokenHolderPartitionOperatorsStateValue{}},
 	{Hint: stostate.PartitionBalanceStateValueHint, Instance: stostate.PartitionBalanceStateValue{}},
 	{Hint: stostate.OperatorTokenHoldersStateValueHint, Instance: stostate.OperatorTokenHoldersStateValue{}},
+	{Hint: stostate.TokenHolderOperatorsStateValueHint, Instance: stostate.TokenHolderOperatorsStateValue{}},
+	{Hint: stostate.OperatorTokenHolderStateValueHint, Instance: stostate.OperatorTokenHolderStateValue{}},
+	{Hint: stostate.OperatorTokenHoldersCountStateValueHint, Instance: stostate.OperatorTokenHoldersCountStateValue{}},
+	{Hint: stostate.OperatorAllowanceStateValueHint, Instance: stostate.OperatorAllowanceStateValue{}},
+	{Hint: stostate.JurisdictionHoldersCountStateValueHint, Instance: stostate.JurisdictionHoldersCountStateValue{}},
+	{Hint: stostate.TokenHolderJurisdictionStateValueHint, Instance: stostate.TokenHolderJurisdictionStateValue{}},
+	{Hint: stostate.MigratedStateValueHint, Instance: stostate.MigratedStateValue{}},
+	{Hint: stostate.MigratingStateValueHint, Instance: stostate.MigratingStateValue{}},
 	{Hint: stotypes.DesignHint, Instance: stotypes.Design{}},
+	{Hint: stotypes.LegacyDesignHint, Instance: stotypes.Design{}},
+	{Hint: stotypes.JurisdictionCapHint, Instance: stotypes.JurisdictionCap{}},
+	{Hint: stotypes.JurisdictionRuleHint, Instance: stotypes.JurisdictionRule{}},
+	{Hint: stotypes.LegacyJurisdictionsDesignHint, Instance: stotypes.Design{}},
+	{Hint: stotypes.ServiceFeeHint, Instance: stotypes.ServiceFee{}},
+	{Hint: stotypes.FeeScheduleHint, Instance: stotypes.FeeSchedule{}},
 	{Hint: stotypes.DocumentHint, Instance: stotypes.Document{}},
 	{Hint: stotypes.PolicyHint, Instance: stotypes.Policy{}},
+	{Hint: stotypes.LegacyPolicyHint, Instance: stotypes.Policy{}},
+	{Hint: stotypes.KYCServiceHint, Instance: stotypes.KYCService{}},
+	{Hint: stotypes.KYCRequirementHint, Instance: stotypes.KYCRequirement{}},
 	{Hint: sto.CreateSecurityTokensItemHint, Instance: sto.CreateSecurityTokensItem{}},
+	{Hint: sto.LegacyCreateSecurityTokensItemHint, Instance: sto.CreateSecurityTokensItem{}},
 	{Hint: sto.CreateSecurityTokensHint, Instance: sto.CreateSecurityTokens{}},
 	{Hint: sto.IssueSecurityTokensItemHint, Instance: sto.IssueSecurityTokensItem{}},
 	{Hint: sto.IssueSecurityTokensHint, Instance: sto.IssueSecurityTokens{}},
@@ -40,21 +62,60 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: sto.AuthorizeOperatorsHint, Instance: sto.AuthorizeOperators{}},
 	{Hint: sto.RevokeOperatorsItemHint, Instance: sto.RevokeOperatorsItem{}},
 	{Hint: sto.RevokeOperatorsHint, Instance: sto.RevokeOperators{}},
+	{Hint: sto.AuthorizeGlobalOperatorsItemHint, Instance: sto.AuthorizeGlobalOperatorsItem{}},
+	{Hint: sto.AuthorizeGlobalOperatorsHint, Instance: sto.AuthorizeGlobalOperators{}},
+	{Hint: sto.RevokeGlobalOperatorsItemHint, Instance: sto.RevokeGlobalOperatorsItem{}},
+	{Hint: sto.RevokeGlobalOperatorsHint, Instance: sto.RevokeGlobalOperators{}},
 	{Hint: sto.SetDocumentHint, Instance: sto.SetDocument{}},
+	{Hint: sto.UpdateJurisdictionRuleHint, Instance: sto.UpdateJurisdictionRule{}},
+	{Hint: sto.UpdateFeeScheduleHint, Instance: sto.UpdateFeeSchedule{}},
+	{Hint: sto.MigrateSecurityTokensHint, Instance: sto.MigrateSecurityTokens{}},
+	{Hint: sto.RecoverTokenHolderHint, Instance: sto.RecoverTokenHolder{}},
 
 	{Hint: kyctypes.DesignHint, Instance: kyctypes.Design{}},
 	{Hint: kycstate.DesignStateValueHint, Instance: kycstate.DesignStateValue{}},
 	{Hint: kyctypes.PolicyHint, Instance: kyctypes.Policy{}},
+	{Hint: kyctypes.LegacyPolicyHint, Instance: kyctypes.Policy{}},
+	{Hint: kyctypes.LegacyRolesPolicyHint, Instance: kyctypes.Policy{}},
+	{Hint: kyctypes.ControllerHint, Instance: kyctypes.Controller{}},
+	{Hint: kyctypes.CustomerInfoHint, Instance: kyctypes.CustomerInfo{}},
 	{Hint: kycstate.CustomerStateValueHint, Instance: kycstate.CustomerStateValue{}},
+	{Hint: kycstate.LegacyCustomerStateValueHint, Instance: kycstate.CustomerStateValue{}},
+	{Hint: kycstate.RemovedCustomerStateValueHint, Instance: kycstate.RemovedCustomerStateValue{}},
+	{Hint: kycstate.CustomerApprovalsStateValueHint, Instance: kycstate.CustomerApprovalsStateValue{}},
+	{Hint: kycstate.MigratedStateValueHint, Instance: kycstate.MigratedStateValue{}},
 	{Hint: kyc.CreateKYCServiceHint, Instance: kyc.CreateKYCService{}},
+	{Hint: kyc.LegacyCreateKYCServiceHint, Instance: kyc.CreateKYCService{}},
+	{Hint: kyc.LegacyRolesCreateKYCServiceHint, Instance: kyc.CreateKYCService{}},
 	{Hint: kyc.AddControllersItemHint, Instance: kyc.AddControllersItem{}},
+	{Hint: kyc.LegacyAddControllersItemHint, Instance: kyc.AddControllersItem{}},
 	{Hint: kyc.AddControllersHint, Instance: kyc.AddControllers{}},
 	{Hint: kyc.RemoveControllersItemHint, Instance: kyc.RemoveControllersItem{}},
 	{Hint: kyc.RemoveControllersHint, Instance: kyc.RemoveControllers{}},
 	{Hint: kyc.AddCustomersItemHint, Instance: kyc.AddCustomersItem{}},
+	{Hint: kyc.LegacyAddCustomersItemHint, Instance: kyc.AddCustomersItem{}},
 	{Hint: kyc.AddCustomersHint, Instance: kyc.AddCustomers{}},
 	{Hint: kyc.UpdateCustomersItemHint, Instance: kyc.UpdateCustomersItem{}},
+	{Hint: kyc.LegacyUpdateCustomersItemHint, Instance: kyc.UpdateCustomersItem{}},
 	{Hint: kyc.UpdateCustomersHint, Instance: kyc.UpdateCustomers{}},
+	{Hint: kyc.RenewCustomersItemHint, Instance: kyc.RenewCustomersItem{}},
+	{Hint: kyc.RenewCustomersHint, Instance: kyc.RenewCustomers{}},
+	{Hint: kyc.ApproveCustomersItemHint, Instance: kyc.ApproveCustomersItem{}},
+	{Hint: kyc.ApproveCustomersHint, Instance: kyc.ApproveCustomers{}},
+	{Hint: kyc.RemoveCustomersItemHint, Instance: kyc.RemoveCustomersItem{}},
+	{Hint: kyc.RemoveCustomersHint, Instance: kyc.RemoveCustomers{}},
+	{Hint: kyc.MigrateKYCServiceHint, Instance: kyc.MigrateKYCService{}},
+
+	{Hint: networktypes.NetworkPolicyHint, Instance: networktypes.NetworkPolicy{}},
+	{Hint: networkstate.NetworkPolicyStateValueHint, Instance: networkstate.NetworkPolicyStateValue{}},
+	{Hint: network.GenesisNetworkPolicyHint, Instance: network.GenesisNetworkPolicy{}},
+	{Hint: network.UpdateNetworkPolicyHint, Instance: network.UpdateNetworkPolicy{}},
+
+	{Hint: genesis.GenesisCustomerHint, Instance: genesis.GenesisCustomer{}},
+	{Hint: genesis.GenesisKYCServiceHint, Instance: genesis.GenesisKYCService{}},
+	{Hint: genesis.GenesisAllocationHint, Instance: genesis.GenesisAllocation{}},
+	{Hint: genesis.GenesisSecurityTokenHint, Instance: genesis.GenesisSecurityToken{}},
+	{Hint: genesis.GenesisContractHint, Instance: genesis.GenesisContract{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
@@ -64,13 +125,30 @@ var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: sto.RedeemTokensFactHint, Instance: sto.RedeemTokensFact{}},
 	{Hint: sto.AuthorizeOperatorsFactHint, Instance: sto.AuthorizeOperatorsFact{}},
 	{Hint: sto.RevokeOperatorsFactHint, Instance: sto.RevokeOperatorsFact{}},
+	{Hint: sto.AuthorizeGlobalOperatorsFactHint, Instance: sto.AuthorizeGlobalOperatorsFact{}},
+	{Hint: sto.RevokeGlobalOperatorsFactHint, Instance: sto.RevokeGlobalOperatorsFact{}},
 	{Hint: sto.SetDocumentFactHint, Instance: sto.SetDocumentFact{}},
+	{Hint: sto.UpdateJurisdictionRuleFactHint, Instance: sto.UpdateJurisdictionRuleFact{}},
+	{Hint: sto.UpdateFeeScheduleFactHint, Instance: sto.UpdateFeeScheduleFact{}},
+	{Hint: sto.MigrateSecurityTokensFactHint, Instance: sto.MigrateSecurityTokensFact{}},
+	{Hint: sto.RecoverTokenHolderFactHint, Instance: sto.RecoverTokenHolderFact{}},
 
 	{Hint: kyc.CreateKYCServiceFactHint, Instance: kyc.CreateKYCServiceFact{}},
+	{Hint: kyc.LegacyCreateKYCServiceFactHint, Instance: kyc.CreateKYCServiceFact{}},
+	{Hint: kyc.LegacyRolesCreateKYCServiceFactHint, Instance: kyc.CreateKYCServiceFact{}},
 	{Hint: kyc.AddControllersFactHint, Instance: kyc.AddControllersFact{}},
-	{Hint: kyc.RemoveControllersFactHint, Instance: kyc.RemoveControllers{}},
+	{Hint: kyc.RemoveControllersFactHint, Instance: kyc.RemoveControllersFact{}},
 	{Hint: kyc.AddCustomersFactHint, Instance: kyc.AddCustomersFact{}},
 	{Hint: kyc.UpdateCustomersFactHint, Instance: kyc.UpdateCustomersFact{}},
+	{Hint: kyc.RenewCustomersFactHint, Instance: kyc.RenewCustomersFact{}},
+	{Hint: kyc.ApproveCustomersFactHint, Instance: kyc.ApproveCustomersFact{}},
+	{Hint: kyc.RemoveCustomersFactHint, Instance: kyc.RemoveCustomersFact{}},
+	{Hint: kyc.MigrateKYCServiceFactHint, Instance: kyc.MigrateKYCServiceFact{}},
+
+	{Hint: network.GenesisNetworkPolicyFactHint, Instance: network.GenesisNetworkPolicyFact{}},
+	{Hint: network.UpdateNetworkPolicyFactHint, Instance: network.UpdateNetworkPolicyFact{}},
+
+	{Hint: genesis.GenesisContractFactHint, Instance: genesis.GenesisContractFact{}},
 }
 
 func init() {
@@ -0,0 +1,123 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/logging"
+	"github.com/ProtoconNet/mitum2/util/ps"
+)
+
+var PNameGenerateGenesis = ps.Name("mitum-sto-generate-genesis")
+
+type INITCommand struct {
+	GenesisDesign string `arg:"" name:"genesis design" help:"genesis design" type:"filepath"`
+	Vault         string `name:"vault" help:"privatekey path of vault"`
+	launch.DesignFlag
+	launch.DevFlags `embed:"" prefix:"dev."`
+}
+
+func (cmd *INITCommand) Run(pctx context.Context) error {
+	var log *logging.Logging
+	if err := util.LoadFromContextOK(pctx, launch.LoggingContextKey, &log); err != nil {
+		return err
+	}
+
+	nctx := util.ContextWithValues(pctx, map[util.ContextKey]interface{}{
+		launch.DesignFlagContextKey:        cmd.DesignFlag,
+		launch.DevFlagsContextKey:          cmd.DevFlags,
+		launch.GenesisDesignFileContextKey: cmd.GenesisDesign,
+		launch.VaultContextKey:             cmd.Vault,
+	})
+
+	pps := DefaultINITPS()
+	_ = pps.SetLogging(log)
+
+	log.Log().Debug().Interface("process", pps.Verbose()).Msg("process ready")
+
+	_, err := pps.Run(nctx) //revive:disable-line:modifies-parameter
+	defer func() {
+		log.Log().Debug().Interface("process", pps.Verbose()).Msg("process will be closed")
+
+		if _, err = pps.Close(pctx); err != nil {
+			log.Log().Error().Err(err).Msg("failed to close")
+		}
+	}()
+
+	return err
+}
+
+// DefaultINITPS is the init process of mitum-currency with the sto hinters
+// and the sto genesis facts.
+func DefaultINITPS() *ps.PS {
+	pps := ps.NewPS("cmd-init")
+
+	_ = pps.
+		AddOK(launch.PNameEncoder, currencycmds.PEncoder, nil).
+		AddOK(launch.PNameDesign, launch.PLoadDesign, nil, launch.PNameEncoder).
+		AddOK(currencycmds.PNameDigestDesign, currencycmds.PLoadDigestDesign, nil, launch.PNameEncoder).
+		AddOK(launch.PNameTimeSyncer, launch.PStartTimeSyncer, launch.PCloseTimeSyncer, launch.PNameDesign).
+		AddOK(launch.PNameLocal, launch.PLocal, nil, launch.PNameDesign).
+		AddOK(launch.PNameStorage, launch.PStorage, launch.PCloseStorage, launch.PNameLocal).
+		AddOK(PNameGenerateGenesis, PGenerateGenesis, nil, launch.PNameStorage)
+
+	_ = pps.POK(launch.PNameEncoder).
+		PostAddOK(launch.PNameAddHinters, PAddHinters)
+
+	_ = pps.POK(launch.PNameDesign).
+		PostAddOK(launch.PNameCheckDesign, launch.PCheckDesign).
+		PostAddOK(launch.PNameINITObjectCache, launch.PINITObjectCache).
+		PostAddOK(launch.PNameGenesisDesign, launch.PGenesisDesign)
+
+	_ = pps.POK(launch.PNameStorage).
+		PreAddOK(launch.PNameCleanStorage, launch.PCleanStorage).
+		PreAddOK(launch.PNameCreateLocalFS, launch.PCreateLocalFS).
+		PreAddOK(launch.PNameLoadDatabase, launch.PLoadDatabase)
+
+	return pps
+}
+
+func PGenerateGenesis(pctx context.Context) (context.Context, error) {
+	e := util.StringError("generate genesis block")
+
+	var log *logging.Logging
+	var design launch.NodeDesign
+	var genesisDesign launch.GenesisDesign
+	var enc encoder.Encoder
+	var local base.LocalNode
+	var isaacParams *isaac.Params
+	var db isaac.Database
+
+	if err := util.LoadFromContextOK(pctx,
+		launch.LoggingContextKey, &log,
+		launch.DesignContextKey, &design,
+		launch.GenesisDesignContextKey, &genesisDesign,
+		launch.EncoderContextKey, &enc,
+		launch.LocalContextKey, &local,
+		launch.ISAACParamsContextKey, &isaacParams,
+		launch.CenterDatabaseContextKey, &db,
+	); err != nil {
+		return pctx, e.Wrap(err)
+	}
+
+	g := NewGenesisBlockGenerator(
+		local,
+		isaacParams.NetworkID(),
+		enc,
+		db,
+		launch.LocalFSDataDirectory(design.Storage.Base),
+		genesisDesign.Facts,
+	)
+	_ = g.SetLogging(log)
+
+	if _, err := g.Generate(); err != nil {
+		return pctx, e.Wrap(err)
+	}
+
+	return pctx, nil
+}
@@ -6,4 +6,8 @@ type KYCCommand struct {
 	RemoveControllers RemoveControllersCommand `cmd:"" name:"remove-controllers" help:"remove controllers from key service"`
 	AddCustomers      AddCustomersCommand      `cmd:"" name:"add-customers" help:"add customer status to kyc service"`
 	UpdateCustomers   UpdateCustomersCommand   `cmd:"" name:"update-customers" help:"update registered customer status"`
+	ApproveCustomers  ApproveCustomersCommand  `cmd:"" name:"approve-customers" help:"approve pending customer of kyc service"`
+	RenewCustomers    RenewCustomersCommand    `cmd:"" name:"renew-customers" help:"extend expiry height of registered customer"`
+	RemoveCustomers   RemoveCustomersCommand   `cmd:"" name:"remove-customers" help:"remove registered customer from kyc service"`
+	MigrateKYCService MigrateKYCServiceCommand `cmd:"" name:"migrate-kyc-service" help:"migrate kyc service to another contract account"`
 }
@@ -0,0 +1,107 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type MigrateKYCServiceCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of kyc service" required:"true"`
+	KYC         currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
+	NewContract currencycmds.AddressFlag    `arg:"" name:"new-contract" help:"contract address kyc service migrated to" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Customer    []string                    `name:"customer" help:"customer of kyc service; unlisted customers are left behind"`
+	sender      base.Address
+	contract    base.Address
+	newContract base.Address
+	customers   []base.Address
+}
+
+func NewMigrateKYCServiceCommand() MigrateKYCServiceCommand {
+	cmd := NewBaseCommand()
+	return MigrateKYCServiceCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *MigrateKYCServiceCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *MigrateKYCServiceCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	newContract, err := cmd.NewContract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid new contract account format, %q", cmd.NewContract.String())
+	}
+	cmd.newContract = newContract
+
+	customers := make([]base.Address, len(cmd.Customer))
+	for i := range cmd.Customer {
+		customer, err := base.DecodeAddress(cmd.Customer[i], enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid customer format, %q", cmd.Customer[i])
+		}
+		customers[i] = customer
+	}
+	cmd.customers = customers
+
+	return nil
+}
+
+func (cmd *MigrateKYCServiceCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := kyc.NewMigrateKYCServiceFact(
+		[]byte(cmd.Token), cmd.sender, cmd.contract, cmd.KYC.ID, cmd.newContract, cmd.customers, cmd.Currency.CID)
+
+	op, err := kyc.NewMigrateKYCService(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate-kyc-service operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate-kyc-service operation")
+	}
+
+	return op, nil
+}
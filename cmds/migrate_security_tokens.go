@@ -0,0 +1,110 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type MigrateSecurityTokensCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender       currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract     currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO          currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	NewContract  currencycmds.AddressFlag    `arg:"" name:"new-contract" help:"contract address sto migrated to" required:"true"`
+	Currency     currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Mode         string                      `name:"mode" help:"migration mode; migrate, finish or abort" enum:"migrate,finish,abort" default:"migrate"`
+	TokenHolder  []string                    `name:"tokenholder" help:"tokenholder of sto; empty for finish"`
+	sender       base.Address
+	contract     base.Address
+	newContract  base.Address
+	tokenHolders []base.Address
+}
+
+func NewMigrateSecurityTokensCommand() MigrateSecurityTokensCommand {
+	cmd := NewBaseCommand()
+	return MigrateSecurityTokensCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *MigrateSecurityTokensCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *MigrateSecurityTokensCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	newContract, err := cmd.NewContract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid new contract account format, %q", cmd.NewContract.String())
+	}
+	cmd.newContract = newContract
+
+	holders := make([]base.Address, len(cmd.TokenHolder))
+	for i := range cmd.TokenHolder {
+		holder, err := base.DecodeAddress(cmd.TokenHolder[i], enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid tokenholder format, %q", cmd.TokenHolder[i])
+		}
+		holders[i] = holder
+	}
+	cmd.tokenHolders = holders
+
+	return nil
+}
+
+func (cmd *MigrateSecurityTokensCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := sto.NewMigrateSecurityTokensFact(
+		[]byte(cmd.Token), cmd.sender, cmd.contract, cmd.STO.ID, cmd.newContract,
+		sto.MigrationMode(cmd.Mode), cmd.tokenHolders, cmd.Currency.CID,
+	)
+
+	op, err := sto.NewMigrateSecurityTokens(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate-security-tokens operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create migrate-security-tokens operation")
+	}
+
+	return op, nil
+}
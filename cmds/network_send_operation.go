@@ -91,6 +91,7 @@ type NetworkClientCommand struct { //nolint:govet //...
 	//revive:disable:nested-structs
 	NodeInfo          launchcmd.NetworkClientNodeInfoCommand          `cmd:"" name:"node-info" help:"remote node info"`
 	SendOperation     NetworkClientSendOperationCommand               `cmd:"" name:"send-operation" help:"send operation"`
+	SimulateOperation NetworkClientSimulateOperationCommand           `cmd:"" name:"simulate-operation" help:"simulate operation against states of remote node"`
 	State             launchcmd.NetworkClientStateCommand             `cmd:"" name:"state" help:"get state"`
 	LastBlockMap      launchcmd.NetworkClientLastBlockMapCommand      `cmd:"" name:"last-blockmap" help:"get last blockmap"`
 	SetAllowConsensus launchcmd.NetworkClientSetAllowConsensusCommand `cmd:"" name:"set-allow-consensus" help:"set to enter consensus"`
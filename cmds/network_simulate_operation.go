@@ -0,0 +1,86 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/ProtoconNet/mitum-sto/pkg/simulate"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+type NetworkClientSimulateOperationCommand struct { //nolint:govet //...
+	BaseNetworkClientCommand
+}
+
+func (cmd *NetworkClientSimulateOperationCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = cmd.Client.Close()
+	}()
+
+	if cmd.Body == nil {
+		return errors.Errorf("empty body")
+	}
+
+	buf := bytes.NewBuffer(nil)
+
+	if _, err := io.Copy(buf, cmd.Body); err != nil {
+		return errors.WithStack(err)
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(cmd.Encoder, buf.Bytes(), &op); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(pctx, cmd.Timeout)
+	defer cancel()
+
+	var height base.Height
+
+	switch m, found, err := cmd.Client.LastBlockMap(ctx, cmd.Remote.ConnInfo(), nil); {
+	case err != nil:
+		return err
+	case !found:
+		return errors.Errorf("last blockmap not found")
+	default:
+		height = m.Manifest().Height()
+	}
+
+	// NOTE the states are fetched from remote once; processors load the same
+	// state several times.
+	type fetched struct {
+		st    base.State
+		found bool
+	}
+
+	sts := map[string]fetched{}
+
+	getStateFunc := func(key string) (base.State, bool, error) {
+		if i, found := sts[key]; found {
+			return i.st, i.found, nil
+		}
+
+		st, found, err := cmd.Client.State(ctx, cmd.Remote.ConnInfo(), key, nil)
+		if err != nil {
+			return nil, false, err
+		}
+
+		sts[key] = fetched{st: st, found: found}
+
+		return st, found, nil
+	}
+
+	result, err := simulate.NewSimulator(base.NetworkID(cmd.NetworkID)).Simulate(ctx, op, height+1, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	return cmd.Print(result, cmd.Out)
+}
@@ -5,6 +5,7 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	stodigest "github.com/ProtoconNet/mitum-sto/pkg/digest"
 	"github.com/ProtoconNet/mitum2/base"
 	isaacblock "github.com/ProtoconNet/mitum2/isaac/block"
 	isaacdatabase "github.com/ProtoconNet/mitum2/isaac/database"
@@ -36,6 +37,10 @@ func ProcessDigester(ctx context.Context) (context.Context, error) {
 	}
 	root := launch.LocalFSDataDirectory(design.Storage.Base)
 
+	if err := stodigest.CreateIndex(ctx, st); err != nil {
+		return ctx, err
+	}
+
 	di := currencydigest.NewDigester(st, root, nil)
 	_ = di.SetLogging(log)
 
@@ -163,6 +168,14 @@ func digestFollowup(ctx context.Context, height base.Height) error {
 			return err
 		}
 
+		if err := stodigest.DigestFeeEvents(ctx, st, m.Manifest().Height(), ops, opstree, sts); err != nil {
+			return err
+		}
+
+		if err := stodigest.DigestStates(ctx, st, sts); err != nil {
+			return err
+		}
+
 		if err := st.SetLastBlock(m.Manifest().Height()); err != nil {
 			return err
 		}
@@ -170,3 +183,43 @@ func digestFollowup(ctx context.Context, height base.Height) error {
 	}
 	return nil
 }
+
+// digestSTOBlock stores the sto and kyc states and the fee events of block at
+// height from the local fs storage, root, in digest database. It runs before
+// the digester, so they are found at the last digested height.
+func digestSTOBlock(ctx context.Context, st *currencydigest.Database, root string, height base.Height) error {
+	reader, err := isaacblock.NewLocalFSReaderFromHeight(root, height, enc)
+	if err != nil {
+		return err
+	}
+
+	var ops []base.Operation
+	switch v, found, err := reader.Item(base.BlockMapItemTypeOperations); {
+	case err != nil:
+		return err
+	case found:
+		ops = v.([]base.Operation) //nolint:forcetypeassert //...
+	}
+
+	var opstree fixedtree.Tree
+	switch v, found, err := reader.Item(base.BlockMapItemTypeOperationsTree); {
+	case err != nil:
+		return err
+	case found:
+		opstree = v.(fixedtree.Tree) //nolint:forcetypeassert //...
+	}
+
+	var sts []base.State
+	switch v, found, err := reader.Item(base.BlockMapItemTypeStates); {
+	case err != nil:
+		return err
+	case found:
+		sts = v.([]base.State) //nolint:forcetypeassert //...
+	}
+
+	if err := stodigest.DigestFeeEvents(ctx, st, height, ops, opstree, sts); err != nil {
+		return err
+	}
+
+	return stodigest.DigestStates(ctx, st, sts)
+}
@@ -5,9 +5,7 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
-	"github.com/ProtoconNet/mitum-currency/v3/types"
-	"github.com/ProtoconNet/mitum-sto/operation/kyc"
-	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
 
 	"github.com/ProtoconNet/mitum-sto/operation/processor"
 	"github.com/ProtoconNet/mitum2/base"
@@ -20,11 +18,6 @@ import (
 
 var PNameOperationProcessorsMap = ps.Name("mitum-sto-operation-processors-map")
 
-type processorInfo struct {
-	hint      hint.Hint
-	processor types.GetNewProcessor
-}
-
 func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 	var isaacParams *isaac.Params
 	var db isaac.Database
@@ -49,27 +42,20 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	}
 
-	ps := []processorInfo{
-		{sto.AuthorizeOperatorsHint, sto.NewAuthorizeOperatorsProcessor()},
-		{sto.CreateSecurityTokensHint, sto.NewCreateSecurityTokensProcessor()},
-		{sto.IssueSecurityTokensHint, sto.NewIssueSecurityTokensProcessor()},
-		{sto.RedeemTokensHint, sto.NewRedeemTokensProcessor()},
-		{sto.RevokeOperatorsHint, sto.NewRevokeOperatorsProcessor()},
-		{sto.SetDocumentHint, sto.NewSetDocumentProcessor()},
-		{sto.TransferSecurityTokensPartitionHint, sto.NewTransferSecurityTokensPartitionProcessor()},
-		{kyc.AddControllersHint, kyc.NewAddControllersProcessor()},
-		{kyc.AddCustomersHint, kyc.NewAddCustomersProcessor()},
-		{kyc.CreateKYCServiceHint, kyc.NewCreateKYCServiceProcessor()},
-		{kyc.RemoveControllersHint, kyc.NewRemoveControllersProcessor()},
-		{kyc.UpdateCustomersHint, kyc.NewUpdateCustomersProcessor()},
-	}
+	ps := append(
+		processor.STOProcessors(),
+		processor.ProcessorInfo{
+			Hint:      network.UpdateNetworkPolicyHint,
+			Processor: network.NewUpdateNetworkPolicyProcessor(isaacParams.Threshold()),
+		},
+	)
 
 	for _, p := range ps {
-		if err := opr.SetProcessor(p.hint, p.processor); err != nil {
+		if err := opr.SetProcessor(p.Hint, p.Processor); err != nil {
 			return pctx, err
 		}
 
-		if err := set.Add(p.hint, func(height base.Height) (base.OperationProcessor, error) {
+		if err := set.Add(p.Hint, func(height base.Height) (base.OperationProcessor, error) {
 			return opr.New(
 				height,
 				db.State,
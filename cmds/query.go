@@ -0,0 +1,208 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type QueryCommand struct { //nolint:govet //...
+	//revive:disable:nested-structs
+	STO struct {
+		Design     QuerySTODesignCommand     `cmd:"" name:"design" help:"get sto design"`
+		Partitions QuerySTOPartitionsCommand `cmd:"" name:"partitions" help:"get partitions of tokenholder"`
+		Balance    QuerySTOBalanceCommand    `cmd:"" name:"balance" help:"get balance of partition or of tokenholder in partition"`
+		Operators  QuerySTOOperatorsCommand  `cmd:"" name:"operators" help:"get operators of tokenholder"`
+	} `cmd:"" name:"sto" help:"query sto state"`
+	KYC struct {
+		Design   QueryKYCDesignCommand   `cmd:"" name:"design" help:"get kyc design"`
+		Customer QueryKYCCustomerCommand `cmd:"" name:"customer" help:"get kyc customer"`
+	} `cmd:"" name:"kyc" help:"query kyc state"`
+	//revive:enable:nested-structs
+}
+
+type baseQueryCommand struct { //nolint:govet //...
+	BaseNetworkClientCommand
+}
+
+func (cmd *baseQueryCommand) address(f currencycmds.AddressFlag, name string) (base.Address, error) {
+	a, err := f.Encode(cmd.Encoder)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s format, %q", name, f.String())
+	}
+
+	return a, nil
+}
+
+func (cmd *baseQueryCommand) query(pctx context.Context, key string) error {
+	defer func() {
+		_ = cmd.Client.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(pctx, cmd.Timeout)
+	defer cancel()
+
+	cmd.Log.Debug().Str("key", key).Msg("query state")
+
+	switch st, found, err := cmd.Client.State(ctx, cmd.Remote.ConnInfo(), key, nil); {
+	case err != nil:
+		return err
+	case !found:
+		return errors.Errorf("state not found, %q", key)
+	default:
+		return cmd.Print(st, cmd.Out)
+	}
+}
+
+type QuerySTODesignCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO      currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+}
+
+func (cmd *QuerySTODesignCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	return cmd.query(pctx, stostate.StateKeyDesign(contract, cmd.STO.ID))
+}
+
+type QuerySTOPartitionsCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO         currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	TokenHolder currencycmds.AddressFlag    `arg:"" name:"tokenholder" help:"tokenholder" required:"true"`
+}
+
+func (cmd *QuerySTOPartitionsCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	holder, err := cmd.address(cmd.TokenHolder, "tokenholder")
+	if err != nil {
+		return err
+	}
+
+	return cmd.query(pctx, stostate.StateKeyTokenHolderPartitions(contract, cmd.STO.ID, holder))
+}
+
+type QuerySTOBalanceCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO         currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	Partition   PartitionFlag               `arg:"" name:"partition" help:"partition" required:"true"`
+	TokenHolder string                      `name:"tokenholder" help:"tokenholder; total balance of partition if empty"`
+}
+
+func (cmd *QuerySTOBalanceCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	if len(cmd.TokenHolder) < 1 {
+		return cmd.query(pctx, stostate.StateKeyPartitionBalance(contract, cmd.STO.ID, cmd.Partition.Partition))
+	}
+
+	holder, err := base.DecodeAddress(cmd.TokenHolder, cmd.Encoder)
+	if err != nil {
+		return errors.Wrapf(err, "invalid tokenholder format, %q", cmd.TokenHolder)
+	}
+
+	return cmd.query(pctx, stostate.StateKeyTokenHolderPartitionBalance(contract, cmd.STO.ID, holder, cmd.Partition.Partition))
+}
+
+type QuerySTOOperatorsCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO         currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	TokenHolder currencycmds.AddressFlag    `arg:"" name:"tokenholder" help:"tokenholder" required:"true"`
+	Partition   PartitionFlag               `name:"partition" help:"partition; operators for all partitions if empty"`
+}
+
+func (cmd *QuerySTOOperatorsCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	holder, err := cmd.address(cmd.TokenHolder, "tokenholder")
+	if err != nil {
+		return err
+	}
+
+	if len(cmd.Partition.Partition) < 1 {
+		return cmd.query(pctx, stostate.StateKeyTokenHolderOperators(contract, cmd.STO.ID, holder))
+	}
+
+	return cmd.query(pctx, stostate.StateKeyTokenHolderPartitionOperators(contract, cmd.STO.ID, holder, cmd.Partition.Partition))
+}
+
+type QueryKYCDesignCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of kyc" required:"true"`
+	KYC      currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
+}
+
+func (cmd *QueryKYCDesignCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	return cmd.query(pctx, kycstate.StateKeyDesign(contract, cmd.KYC.ID))
+}
+
+type QueryKYCCustomerCommand struct { //nolint:govet //...
+	baseQueryCommand
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of kyc" required:"true"`
+	KYC      currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
+	Customer currencycmds.AddressFlag    `arg:"" name:"customer" help:"customer" required:"true"`
+}
+
+func (cmd *QueryKYCCustomerCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	contract, err := cmd.address(cmd.Contract, "contract account")
+	if err != nil {
+		return err
+	}
+
+	customer, err := cmd.address(cmd.Customer, "customer")
+	if err != nil {
+		return err
+	}
+
+	return cmd.query(pctx, kycstate.StateKeyCustomer(contract, cmd.KYC.ID, customer))
+}
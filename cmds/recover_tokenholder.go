@@ -0,0 +1,103 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type RecoverTokenHolderCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address, new address of tokenholder" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO         currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	TokenHolder currencycmds.AddressFlag    `arg:"" name:"lost-tokenholder" help:"lost tokenholder address" required:"true"`
+	Controller  currencycmds.AddressFlag    `arg:"" name:"controller" help:"controller address of sto" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender      base.Address
+	contract    base.Address
+	lostHolder  base.Address
+	controller  base.Address
+}
+
+func NewRecoverTokenHolderCommand() RecoverTokenHolderCommand {
+	cmd := NewBaseCommand()
+	return RecoverTokenHolderCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *RecoverTokenHolderCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RecoverTokenHolderCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	lostHolder, err := cmd.TokenHolder.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid lost tokenholder format, %q", cmd.TokenHolder.String())
+	}
+	cmd.lostHolder = lostHolder
+
+	controller, err := cmd.Controller.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid controller format, %q", cmd.Controller.String())
+	}
+	cmd.controller = controller
+
+	return nil
+}
+
+func (cmd *RecoverTokenHolderCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := sto.NewRecoverTokenHolderFact(
+		[]byte(cmd.Token), cmd.sender, cmd.contract, cmd.STO.ID, cmd.lostHolder, cmd.controller, cmd.Currency.CID)
+
+	op, err := sto.NewRecoverTokenHolder(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recover-tokenholder operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create recover-tokenholder operation")
+	}
+
+	return op, nil
+}
@@ -10,6 +10,8 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/ProtoconNet/mitum-sto/pkg/captable"
+	"github.com/ProtoconNet/mitum-sto/pkg/simulate"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/isaac"
 	isaacnetwork "github.com/ProtoconNet/mitum2/isaac/network"
@@ -224,9 +226,19 @@ func (cmd *RunCommand) pWhenNewBlockConfirmed(pctx context.Context) (context.Con
 		return pctx, err
 	}
 
+	var st *currencydigest.Database
+	if err := util.LoadFromContext(pctx, currencycmds.ContextValueDigestDatabase, &st); err != nil {
+		return pctx, err
+	}
+
+	var design launch.NodeDesign
+	if err := util.LoadFromContext(pctx, launch.DesignContextKey, &design); err != nil {
+		return pctx, err
+	}
+
 	var f func(height base.Height)
 	if di != nil {
-		g := cmd.whenBlockSaved(db, di)
+		g := cmd.whenBlockSaved(db, di, st, launch.LocalFSDataDirectory(design.Storage.Base), log)
 
 		f = func(height base.Height) {
 			g(pctx)
@@ -260,6 +272,9 @@ func (cmd *RunCommand) pWhenNewBlockConfirmed(pctx context.Context) (context.Con
 func (cmd *RunCommand) whenBlockSaved(
 	db isaac.Database,
 	di *currencydigest.Digester,
+	st *currencydigest.Database,
+	root string,
+	log *logging.Logging,
 ) ps.Func {
 	return func(ctx context.Context) (context.Context, error) {
 		switch m, found, err := db.LastBlockMap(); {
@@ -270,6 +285,10 @@ func (cmd *RunCommand) whenBlockSaved(
 		default:
 			if di != nil {
 				go func() {
+					if err := digestSTOBlock(ctx, st, root, m.Manifest().Height()); err != nil {
+						log.Log().Error().Err(err).Interface("height", m.Manifest().Height()).Msg("failed to digest sto block")
+					}
+
 					di.Digest([]base.BlockMap{m})
 				}()
 			}
@@ -374,6 +393,14 @@ func (cmd *RunCommand) pDigestAPIHandlers(ctx context.Context) (context.Context,
 		return ctx, err
 	}
 
+	if err := cmd.setCapTableHandler(ctx, cache, router); err != nil {
+		return ctx, err
+	}
+
+	if err := cmd.setSimulateHandler(ctx, isaacparams.ISAAC.NetworkID(), router); err != nil {
+		return ctx, err
+	}
+
 	return ctx, nil
 }
 
@@ -426,6 +453,57 @@ func (cmd *RunCommand) setDigestHandlers(
 	return handlers, nil
 }
 
+func (cmd *RunCommand) setCapTableHandler(
+	ctx context.Context,
+	cache currencydigest.Cache,
+	router *mux.Router,
+) error {
+	var st *currencydigest.Database
+	if err := util.LoadFromContext(ctx, currencycmds.ContextValueDigestDatabase, &st); err != nil {
+		return err
+	}
+
+	h := captable.NewHandler(enc, cache, st)
+
+	_ = router.Name(captable.HandlerPathCapTable).
+		Path(captable.HandlerPathCapTable).
+		Handler(currencydigest.NewCachedHTTPHandler(cache, h.Handle)).
+		Methods(http.MethodOptions, http.MethodGet)
+
+	return nil
+}
+
+func (cmd *RunCommand) setSimulateHandler(
+	ctx context.Context,
+	networkID base.NetworkID,
+	router *mux.Router,
+) error {
+	var db isaac.Database
+	if err := util.LoadFromContextOK(ctx, launch.CenterDatabaseContextKey, &db); err != nil {
+		return err
+	}
+
+	lastHeight := func() (base.Height, error) {
+		switch m, found, err := db.LastBlockMap(); {
+		case err != nil:
+			return base.NilHeight, err
+		case !found:
+			return base.NilHeight, errors.Errorf("last blockmap not found")
+		default:
+			return m.Manifest().Height(), nil
+		}
+	}
+
+	h := simulate.NewHandler(enc, simulate.NewSimulator(networkID), lastHeight, db.State)
+
+	_ = router.Name(simulate.HandlerPathSimulate).
+		Path(simulate.HandlerPathSimulate).
+		HandlerFunc(h.Handle).
+		Methods(http.MethodOptions, http.MethodPost)
+
+	return nil
+}
+
 func (cmd *RunCommand) setDigestNetworkClient(
 	ctx context.Context,
 	params *launch.LocalParams,
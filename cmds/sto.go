@@ -7,5 +7,12 @@ type STOCommand struct {
 	RedeemTokens                    RedeemTokensCommand                    `cmd:"" name:"redeem-token" help:"redeem tokens from tokenholder"`
 	AuthorizeOperators              AuthorizeOperatorsCommand              `cmd:"" name:"authorize-operator" help:"authorize operator"`
 	RevokeOperators                 RevokeOperatorsCommand                 `cmd:"" name:"revoke-operator" help:"revoke operator"`
+	AuthorizeGlobalOperators        AuthorizeGlobalOperatorsCommand        `cmd:"" name:"authorize-global-operator" help:"authorize operator for all partitions"`
+	RevokeGlobalOperators           RevokeGlobalOperatorsCommand           `cmd:"" name:"revoke-global-operator" help:"revoke operator for all partitions"`
 	SetDocument                     SetDocumentCommand                     `cmd:"" name:"set-document" help:"set sto documents"`
+	UpdateJurisdictionRule          UpdateJurisdictionRuleCommand          `cmd:"" name:"update-jurisdiction-rule" help:"update jurisdiction rule of sto tokenholders"`
+	UpdateFeeSchedule               UpdateFeeScheduleCommand               `cmd:"" name:"update-fee-schedule" help:"update service fee schedule of sto"`
+	MigrateSecurityTokens           MigrateSecurityTokensCommand           `cmd:"" name:"migrate-security-tokens" help:"migrate sto to another contract account"`
+	RecoverTokenHolder              RecoverTokenHolderCommand              `cmd:"" name:"recover-tokenholder" help:"recover tokens of lost tokenholder; should be signed by controller too"`
+	UpdateNetworkPolicy             UpdateNetworkPolicyCommand             `cmd:"" name:"update-network-policy" help:"update sto network policy"`
 }
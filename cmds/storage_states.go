@@ -0,0 +1,186 @@
+package cmds
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/pkg/localfs"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/launch"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type StorageStatesCommand struct { //nolint:govet //...
+	BaseCommand
+	Storage  string                      `arg:"" name:"storage" help:"base directory of local storage; storage.base of node design" type:"existingdir"`
+	Kind     string                      `name:"kind" help:"kind of states; sto, kyc or all" enum:"sto,kyc,all" default:"all"`
+	Contract currencycmds.AddressFlag    `name:"contract" help:"contract address"`
+	ID       currencycmds.ContractIDFlag `name:"id" help:"sto or kyc id; needs contract"`
+	Holder   currencycmds.AddressFlag    `name:"holder" help:"tokenholder or customer address"`
+	Height   int64                       `name:"height" help:"states at height; last height if not set" default:"-1"`
+	Format   string                      `name:"format" help:"output format; json or csv" enum:"json,csv" default:"json"`
+	prefixes []string
+	holder   string
+	height   base.Height
+}
+
+func NewStorageStatesCommand() StorageStatesCommand {
+	cmd := NewBaseCommand()
+	return StorageStatesCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *StorageStatesCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	sts, last, err := localfs.LoadStates(launch.LocalFSDataDirectory(cmd.Storage), cmd.Encoder, cmd.height, cmd.filter)
+	if err != nil {
+		return err
+	}
+
+	cmd.Log.Debug().
+		Int64("height", last.Int64()).
+		Int("states", len(sts)).
+		Msg("states loaded")
+
+	if cmd.Format == "csv" {
+		return cmd.printCSV(sts)
+	}
+
+	return cmd.printJSON(sts)
+}
+
+func (cmd *StorageStatesCommand) parseFlags() error {
+	cmd.height = base.NilHeight
+	if cmd.Height >= 0 {
+		cmd.height = base.Height(cmd.Height)
+	}
+
+	if len(cmd.Holder.String()) > 0 {
+		holder, err := cmd.Holder.Encode(enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid holder format, %q", cmd.Holder.String())
+		}
+		cmd.holder = holder.String()
+	}
+
+	var prefixes []string
+	switch cmd.Kind {
+	case "sto":
+		prefixes = []string{stostate.STOPrefix}
+	case "kyc":
+		prefixes = []string{kycstate.KYCPrefix}
+	default:
+		prefixes = []string{stostate.STOPrefix, kycstate.KYCPrefix}
+	}
+
+	if len(cmd.Contract.String()) < 1 {
+		if len(cmd.ID.ID) > 0 {
+			return errors.Errorf("id needs contract")
+		}
+
+		cmd.prefixes = prefixes
+
+		return nil
+	}
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+
+	for i := range prefixes {
+		switch {
+		case len(cmd.ID.ID) < 1:
+			cmd.prefixes = append(cmd.prefixes, prefixes[i]+contract.String())
+		case prefixes[i] == stostate.STOPrefix:
+			cmd.prefixes = append(cmd.prefixes, stostate.StateKeySTOPrefix(contract, cmd.ID.ID))
+		default:
+			cmd.prefixes = append(cmd.prefixes, kycstate.StateKeyKYCPrefix(contract, cmd.ID.ID))
+		}
+	}
+
+	return nil
+}
+
+func (cmd *StorageStatesCommand) filter(key string) bool {
+	if len(cmd.holder) > 0 && !strings.Contains(key, cmd.holder) {
+		return false
+	}
+
+	for i := range cmd.prefixes {
+		if !strings.HasPrefix(key, cmd.prefixes[i]) {
+			continue
+		}
+
+		// NOTE the prefix must not match the longer contract address or id
+		if rest := key[len(cmd.prefixes[i]):]; len(rest) < 1 || rest[0] == ':' || rest[0] == '-' {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cmd *StorageStatesCommand) printJSON(sts []base.State) error {
+	for i := range sts {
+		b, err := util.MarshalJSON(sts[i])
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintln(cmd.Out, string(b)); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+func (cmd *StorageStatesCommand) printCSV(sts []base.State) error {
+	w := csv.NewWriter(cmd.Out)
+
+	if err := w.Write([]string{"key", "height", "hint", "value"}); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := range sts {
+		st := sts[i]
+
+		var ht string
+		if h, ok := st.Value().(hint.Hinter); ok {
+			ht = h.Hint().String()
+		}
+
+		b, err := util.MarshalJSON(st.Value())
+		if err != nil {
+			return err
+		}
+
+		if err := w.Write([]string{st.Key(), st.Height().String(), ht, string(b)}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	w.Flush()
+
+	return errors.WithStack(w.Error())
+}
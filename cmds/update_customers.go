@@ -7,17 +7,19 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 )
 
 type UpdateCustomersCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
+	CustomerInfoFlags
 	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
 	KYC      currencycmds.ContractIDFlag `arg:"" name:"kyc-id" help:"kyc id" required:"true"`
 	Customer currencycmds.AddressFlag    `arg:"" name:"customer" help:"customer" required:"true"`
-	Status   bool                        `arg:"" name:"status" help:"customer status" required:"true"`
+	Status   string                      `arg:"" name:"status" help:"customer status; pending, approved or rejected" required:"true"`
 	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	sender   base.Address
 	contract base.Address
@@ -82,11 +84,16 @@ func (cmd *UpdateCustomersCommand) parseFlags() error {
 func (cmd *UpdateCustomersCommand) createOperation() (base.Operation, error) { // nolint:dupl
 	var items []kyc.UpdateCustomersItem
 
+	info, err := cmd.CustomerInfo(kyctypes.CustomerStatus(cmd.Status))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid customer info")
+	}
+
 	item := kyc.NewUpdateCustomersItem(
 		cmd.contract,
 		cmd.KYC.ID,
 		cmd.customer,
-		cmd.Status,
+		info,
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
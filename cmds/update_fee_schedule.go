@@ -0,0 +1,122 @@
+package cmds
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateFeeScheduleCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO      currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Fee      []string                    `name:"fee" help:"service fee, as <operation>:<currency>:<flat>[:<basis points>], eg. transfer:MCC:0:25"`
+	sender   base.Address
+	contract base.Address
+	schedule stotypes.FeeSchedule
+}
+
+func NewUpdateFeeScheduleCommand() UpdateFeeScheduleCommand {
+	cmd := NewBaseCommand()
+	return UpdateFeeScheduleCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *UpdateFeeScheduleCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateFeeScheduleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	fees := make([]stotypes.ServiceFee, len(cmd.Fee))
+	for i, f := range cmd.Fee {
+		l := strings.Split(f, ":")
+		if len(l) < 3 || len(l) > 4 {
+			return errors.Errorf("invalid service fee format, %q", f)
+		}
+
+		flat, err := common.NewBigFromString(l[2])
+		if err != nil {
+			return errors.Wrapf(err, "invalid flat amount of service fee, %q", f)
+		}
+
+		var rate uint64
+		if len(l) == 4 {
+			rate, err = strconv.ParseUint(l[3], 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "invalid rate of service fee, %q", f)
+			}
+		}
+
+		fees[i] = stotypes.NewServiceFee(stotypes.FeeOperation(l[0]), currencytypes.CurrencyID(l[1]), flat, rate)
+	}
+
+	schedule := stotypes.NewFeeSchedule(fees)
+	if err := schedule.IsValid(nil); err != nil {
+		return errors.Wrap(err, "invalid fee schedule")
+	}
+	cmd.schedule = schedule
+
+	return nil
+}
+
+func (cmd *UpdateFeeScheduleCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := sto.NewUpdateFeeScheduleFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.STO.ID, cmd.schedule, cmd.Currency.CID)
+
+	op, err := sto.NewUpdateFeeSchedule(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-fee-schedule operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-fee-schedule operation")
+	}
+
+	return op, nil
+}
@@ -0,0 +1,125 @@
+package cmds
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateJurisdictionRuleCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of sto" required:"true"`
+	STO      currencycmds.ContractIDFlag `arg:"" name:"sto-id" help:"sto id" required:"true"`
+	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Allow    []string                    `name:"allow" help:"allowed jurisdiction, eg. US"`
+	Deny     []string                    `name:"deny" help:"denied jurisdiction, eg. KP"`
+	Cap      []string                    `name:"cap" help:"max tokenholders of jurisdiction, as <jurisdiction>:<max>, eg. US:99"`
+	sender   base.Address
+	contract base.Address
+	rule     stotypes.JurisdictionRule
+}
+
+func NewUpdateJurisdictionRuleCommand() UpdateJurisdictionRuleCommand {
+	cmd := NewBaseCommand()
+	return UpdateJurisdictionRuleCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *UpdateJurisdictionRuleCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateJurisdictionRuleCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	allowed := make([]kyctypes.Jurisdiction, len(cmd.Allow))
+	for i := range cmd.Allow {
+		allowed[i] = kyctypes.Jurisdiction(cmd.Allow[i])
+	}
+
+	denied := make([]kyctypes.Jurisdiction, len(cmd.Deny))
+	for i := range cmd.Deny {
+		denied[i] = kyctypes.Jurisdiction(cmd.Deny[i])
+	}
+
+	caps := make([]stotypes.JurisdictionCap, len(cmd.Cap))
+	for i, c := range cmd.Cap {
+		l := strings.SplitN(c, ":", 2)
+		if len(l) != 2 {
+			return errors.Errorf("invalid jurisdiction cap format, %q", c)
+		}
+
+		maxHolders, err := strconv.ParseUint(l[1], 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid max tokenholders of jurisdiction cap, %q", c)
+		}
+
+		caps[i] = stotypes.NewJurisdictionCap(kyctypes.Jurisdiction(l[0]), maxHolders)
+	}
+
+	rule := stotypes.NewJurisdictionRule(allowed, denied, caps)
+	if err := rule.IsValid(nil); err != nil {
+		return errors.Wrap(err, "invalid jurisdiction rule")
+	}
+	cmd.rule = rule
+
+	return nil
+}
+
+func (cmd *UpdateJurisdictionRuleCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	fact := sto.NewUpdateJurisdictionRuleFact([]byte(cmd.Token), cmd.sender, cmd.contract, cmd.STO.ID, cmd.rule, cmd.Currency.CID)
+
+	op, err := sto.NewUpdateJurisdictionRule(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-jurisdiction-rule operation")
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-jurisdiction-rule operation")
+	}
+
+	return op, nil
+}
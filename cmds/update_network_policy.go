@@ -0,0 +1,95 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+type UpdateNetworkPolicyCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Node                     currencycmds.AddressFlag `arg:"" name:"node" help:"node address" required:"true"`
+	MaxItems                 uint64                   `name:"max-items" help:"max items in an operation" default:"10"`
+	MinLengthPartition       uint64                   `name:"min-length-partition" help:"min length of new partition" default:"3"`
+	MaxLengthPartition       uint64                   `name:"max-length-partition" help:"max length of new partition" default:"10"`
+	MaxOperatorsInOperators  uint64                   `name:"max-operators" help:"max operators of tokenholder" default:"10"`
+	MaxTokenHoldersInHolders uint64                   `name:"max-tokenholders" help:"max tokenholders of operator" default:"10000"`
+	node                     base.Address
+	policy                   networktypes.NetworkPolicy
+}
+
+func NewUpdateNetworkPolicyCommand() UpdateNetworkPolicyCommand {
+	cmd := NewBaseCommand()
+	return UpdateNetworkPolicyCommand{
+		BaseCommand: *cmd,
+	}
+}
+
+func (cmd *UpdateNetworkPolicyCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateNetworkPolicyCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	node, err := cmd.Node.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid node format, %q", cmd.Node.String())
+	}
+	cmd.node = node
+
+	cmd.policy = networktypes.NewNetworkPolicy(
+		cmd.MaxItems,
+		cmd.MinLengthPartition,
+		cmd.MaxLengthPartition,
+		cmd.MaxOperatorsInOperators,
+		cmd.MaxTokenHoldersInHolders,
+	)
+	if err := cmd.policy.IsValid(nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (cmd *UpdateNetworkPolicyCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	fact := network.NewUpdateNetworkPolicyFact([]byte(cmd.Token), cmd.policy)
+
+	op, err := network.NewUpdateNetworkPolicy(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-network-policy operation")
+	}
+
+	err = op.NodeSign(cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.node)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create update-network-policy operation")
+	}
+
+	return op, nil
+}
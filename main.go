@@ -27,15 +27,20 @@ var (
 //revive:disable:nested-structs
 var CLI struct { //nolint:govet //...
 	launch.BaseFlags
-	Init      currencycmds.INITCommand `cmd:"" help:"init node"`
-	Run       cmds.RunCommand          `cmd:"" help:"run node"`
-	Storage   launchcmd.Storage        `cmd:""`
-	Operation struct {
+	Init        cmds.INITCommand          `cmd:"" help:"init node"`
+	Run         cmds.RunCommand           `cmd:"" help:"run node"`
+	Storage     launchcmd.Storage         `cmd:""`
+	States      cmds.StorageStatesCommand `cmd:"" help:"print sto and kyc states in local storage"`
+	CapTable    cmds.CapTableCommand      `cmd:"" name:"cap-table" help:"print cap table of sto from local storage"`
+	CheckSupply cmds.CheckSupplyCommand   `cmd:"" name:"check-supply" help:"check supply invariants of sto in local storage"`
+	Operation   struct {
 		Currency currencycmds.CurrencyCommand `cmd:"" help:"currency operation"`
 		Suffrage currencycmds.SuffrageCommand `cmd:"" help:"suffrage operation"`
 		STO      cmds.STOCommand              `cmd:"" help:"sto operation"`
 		KYC      cmds.KYCCommand              `cmd:"" help:"kyc operation"`
+		Batch    cmds.BatchCommand            `cmd:"" help:"create operations of items in csv or json lines file"`
 	} `cmd:"" help:"create operation"`
+	Query   cmds.QueryCommand `cmd:"" help:"query sto and kyc state from remote node"`
 	Network struct {
 		Client cmds.NetworkClientCommand `cmd:"" help:"network client"`
 	} `cmd:"" help:"network"`
@@ -0,0 +1,5 @@
+/*
+Package fee provides the fees of sto and kyc operations, which are taken from
+the sender and credited to the fee receivers.
+*/
+package fee
@@ -0,0 +1,180 @@
+package fee
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// charge is the amount of currency paid to receiver. With nil receiver, the
+// amount is taken from sender but not credited.
+type charge struct {
+	currency currencytypes.CurrencyID
+	receiver base.Address
+	amount   common.Big
+}
+
+// Fees collects the fees which the sender of operation pays. The balance of
+// sender is checked against the sum of fees by currency, and every balance is
+// written once, so the sender can also be the receiver.
+type Fees struct {
+	charges []charge
+}
+
+func NewFees() *Fees {
+	return &Fees{}
+}
+
+// AddCurrencyFee adds the fee of currency policy of cid, paid to the receiver
+// of its feeer.
+func (f *Fees) AddCurrencyFee(cid currencytypes.CurrencyID, getStateFunc base.GetStateFunc) error {
+	policy, err := currencystate.ExistsCurrencyPolicy(cid, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	k, err := policy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return err
+	}
+
+	f.Add(cid, policy.Feeer().Receiver(), k)
+
+	return nil
+}
+
+// Add adds amount of cid paid to receiver. The amounts to the same receiver
+// in the same currency are summed.
+func (f *Fees) Add(cid currencytypes.CurrencyID, receiver base.Address, amount common.Big) {
+	if !amount.OverZero() {
+		return
+	}
+
+	for i := range f.charges {
+		c := f.charges[i]
+
+		switch {
+		case c.currency != cid:
+			continue
+		case c.receiver == nil && receiver == nil,
+			c.receiver != nil && receiver != nil && c.receiver.Equal(receiver):
+			f.charges[i].amount = c.amount.Add(amount)
+
+			return
+		}
+	}
+
+	f.charges = append(f.charges, charge{currency: cid, receiver: receiver, amount: amount})
+}
+
+// Required returns the sum of fees by currency, in the form of
+// currencyoperation.CheckEnoughBalance.
+func (f *Fees) Required() map[currencytypes.CurrencyID][2]common.Big {
+	required := map[currencytypes.CurrencyID][2]common.Big{}
+
+	for _, c := range f.charges {
+		rq := [2]common.Big{common.ZeroBig, common.ZeroBig}
+		if k, found := required[c.currency]; found {
+			rq = k
+		}
+
+		required[c.currency] = [2]common.Big{rq[0].Add(c.amount), rq[1].Add(c.amount)}
+	}
+
+	return required
+}
+
+// Event is the fee which payer paid to receiver.
+type Event struct {
+	Payer    base.Address             `json:"payer"`
+	Receiver base.Address             `json:"receiver"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+	Amount   common.Big               `json:"amount"`
+}
+
+// Events returns the fees which payer pays to the receivers; the fees without
+// receiver are not included.
+func (f *Fees) Events(payer base.Address) []Event {
+	var events []Event
+
+	for _, c := range f.charges {
+		if c.receiver == nil {
+			continue
+		}
+
+		events = append(events, Event{Payer: payer, Receiver: c.receiver, Currency: c.currency, Amount: c.amount})
+	}
+
+	return events
+}
+
+// Pay checks sender has enough balance for the fees and returns the state
+// merge values, which take the fees from sender and credit them to the
+// receivers. The receiver without the balance of currency gets the new one.
+func (f *Fees) Pay(sender base.Address, getStateFunc base.GetStateFunc) ([]base.StateMergeValue, error) {
+	if len(f.charges) < 1 {
+		return nil, nil
+	}
+
+	sb, err := currencyoperation.CheckEnoughBalance(sender, f.Required(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	balances := map[string]currencytypes.Amount{}
+
+	balance := func(key string, cid currencytypes.CurrencyID, st base.State) (currencytypes.Amount, error) {
+		if am, found := balances[key]; found {
+			return am, nil
+		}
+
+		keys = append(keys, key)
+
+		if st == nil {
+			return currencytypes.NewAmount(common.ZeroBig, cid), nil
+		}
+
+		return currency.StateBalanceValue(st)
+	}
+
+	for _, c := range f.charges {
+		st := sb[c.currency]
+
+		am, err := balance(st.Key(), c.currency, st)
+		if err != nil {
+			return nil, err
+		}
+		balances[st.Key()] = am.WithBig(am.Big().Sub(c.amount))
+
+		if c.receiver == nil {
+			continue
+		}
+
+		rk := currency.StateKeyBalance(c.receiver, c.currency)
+
+		var rst base.State
+		switch i, found, err := getStateFunc(rk); {
+		case err != nil:
+			return nil, err
+		case found:
+			rst = i
+		}
+
+		am, err = balance(rk, c.currency, rst)
+		if err != nil {
+			return nil, err
+		}
+		balances[rk] = am.WithBig(am.Big().Add(c.amount))
+	}
+
+	sts := make([]base.StateMergeValue, len(keys))
+	for i, k := range keys {
+		sts[i] = currencystate.NewStateMergeValue(k, currency.NewBalanceStateValue(balances[k]))
+	}
+
+	return sts, nil
+}
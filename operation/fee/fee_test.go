@@ -0,0 +1,206 @@
+package fee_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/fee"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+var cid = currencytypes.CurrencyID("MCC")
+
+func TestFeesPay(t *testing.T) {
+	amount := func(n int64) currencytypes.Amount {
+		return currencytypes.NewAmount(common.NewBig(n), cid)
+	}
+
+	cases := []struct {
+		name     string
+		fees     func(t *testing.T, s *test.States, sender, receiver base.Address) *fee.Fees
+		balance  int64
+		balances func(sender, receiver base.Address) map[string]int64
+		events   func(sender, receiver base.Address) map[string]int64
+		err      string
+	}{
+		{
+			name: "credit receiver",
+			fees: func(t *testing.T, s *test.States, _, receiver base.Address) *fee.Fees {
+				s.SetCurrency(cid, receiver, common.NewBig(10))
+				s.SetBalance(receiver, amount(5))
+
+				fees := fee.NewFees()
+				for i := 0; i < 2; i++ {
+					if err := fees.AddCurrencyFee(cid, s.GetStateFunc); err != nil {
+						t.Fatalf("failed to add fee: %+v", err)
+					}
+				}
+
+				return fees
+			},
+			balance: 1000,
+			balances: func(sender, receiver base.Address) map[string]int64 {
+				return map[string]int64{
+					currency.StateKeyBalance(sender, cid):   980,
+					currency.StateKeyBalance(receiver, cid): 25,
+				}
+			},
+			events: func(_, receiver base.Address) map[string]int64 {
+				return map[string]int64{receiver.String(): 20}
+			},
+		},
+		{
+			name: "sender is receiver",
+			fees: func(t *testing.T, s *test.States, sender, _ base.Address) *fee.Fees {
+				s.SetCurrency(cid, sender, common.NewBig(10))
+
+				fees := fee.NewFees()
+				if err := fees.AddCurrencyFee(cid, s.GetStateFunc); err != nil {
+					t.Fatalf("failed to add fee: %+v", err)
+				}
+
+				return fees
+			},
+			balance: 1000,
+			balances: func(sender, _ base.Address) map[string]int64 {
+				return map[string]int64{currency.StateKeyBalance(sender, cid): 1000}
+			},
+			events: func(sender, _ base.Address) map[string]int64 {
+				return map[string]int64{sender.String(): 10}
+			},
+		},
+		{
+			name: "without receiver",
+			fees: func(*testing.T, *test.States, base.Address, base.Address) *fee.Fees {
+				fees := fee.NewFees()
+				fees.Add(cid, nil, common.NewBig(10))
+
+				return fees
+			},
+			balance: 1000,
+			balances: func(sender, _ base.Address) map[string]int64 {
+				return map[string]int64{currency.StateKeyBalance(sender, cid): 990}
+			},
+		},
+		{
+			name: "no fee",
+			fees: func(t *testing.T, s *test.States, _, receiver base.Address) *fee.Fees {
+				s.SetCurrency(cid, receiver, common.ZeroBig)
+
+				fees := fee.NewFees()
+				if err := fees.AddCurrencyFee(cid, s.GetStateFunc); err != nil {
+					t.Fatalf("failed to add fee: %+v", err)
+				}
+
+				return fees
+			},
+			balance: 0,
+		},
+		{
+			name: "insufficient balance",
+			fees: func(t *testing.T, s *test.States, _, receiver base.Address) *fee.Fees {
+				fees := fee.NewFees()
+				fees.Add(cid, receiver, common.NewBig(10))
+
+				return fees
+			},
+			balance: 5,
+			err:     "insufficient balance",
+		},
+		{
+			name: "receiver without balance",
+			fees: func(t *testing.T, s *test.States, _, receiver base.Address) *fee.Fees {
+				fees := fee.NewFees()
+				fees.Add(cid, receiver, common.NewBig(10))
+
+				return fees
+			},
+			balance: 1000,
+			balances: func(sender, receiver base.Address) map[string]int64 {
+				return map[string]int64{
+					currency.StateKeyBalance(sender, cid):   990,
+					currency.StateKeyBalance(receiver, cid): 10,
+				}
+			},
+			events: func(_, receiver base.Address) map[string]int64 {
+				return map[string]int64{receiver.String(): 10}
+			},
+		},
+	}
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func(t *testing.T) {
+			s := test.NewStates(t)
+
+			sender := s.NewAccount(amount(c.balance)).Address
+			receiver := s.NewAccount().Address
+
+			fees := c.fees(t, s, sender, receiver)
+
+			mvs, err := fees.Pay(sender, s.GetStateFunc)
+
+			switch {
+			case len(c.err) > 0:
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected error, %q, but %v", c.err, err)
+				}
+
+				return
+			case err != nil:
+				t.Fatalf("failed to pay: %+v", err)
+			}
+
+			var balances, events map[string]int64
+			if c.balances != nil {
+				balances = c.balances(sender, receiver)
+			}
+
+			if c.events != nil {
+				events = c.events(sender, receiver)
+			}
+
+			if len(mvs) != len(balances) {
+				t.Fatalf("expected %d state merge values, but %d", len(balances), len(mvs))
+			}
+
+			for _, mv := range mvs {
+				v, ok := mv.Value().(currency.BalanceStateValue)
+				if !ok {
+					t.Fatalf("unexpected state value, %q: %T", mv.Key(), mv.Value())
+				}
+
+				expected, found := balances[mv.Key()]
+				if !found {
+					t.Fatalf("unexpected balance, %q", mv.Key())
+				}
+
+				if !v.Amount.Big().Equal(common.NewBig(expected)) {
+					t.Errorf("balance of %q: expected %d, but %s", mv.Key(), expected, v.Amount.Big())
+				}
+			}
+
+			evs := fees.Events(sender)
+			if len(evs) != len(events) {
+				t.Fatalf("expected %d fee events, but %d", len(events), len(evs))
+			}
+
+			for _, ev := range evs {
+				expected, found := events[ev.Receiver.String()]
+				if !found {
+					t.Fatalf("unexpected fee event to %q", ev.Receiver)
+				}
+
+				if !ev.Payer.Equal(sender) || ev.Currency != cid || !ev.Amount.Equal(common.NewBig(expected)) {
+					t.Errorf("fee event to %q: expected %d from %q, but %s from %q",
+						ev.Receiver, expected, sender, ev.Amount, ev.Payer)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+/*
+Package genesis provides the genesis operations of sto and kyc.
+*/
+package genesis
@@ -0,0 +1,171 @@
+package genesis
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	GenesisContractFactHint = hint.MustNewHint("mitum-sto-genesis-contract-fact-v0.0.1")
+	GenesisContractHint     = hint.MustNewHint("mitum-sto-genesis-contract-v0.0.1")
+)
+
+// GenesisContractFact creates the contract account of owner with kyc services
+// and security tokens in genesis block.
+type GenesisContractFact struct {
+	base.BaseFact
+	contract base.Address
+	owner    base.Address
+	kycs     []GenesisKYCService
+	stos     []GenesisSecurityToken
+}
+
+func NewGenesisContractFact(
+	contract, owner base.Address,
+	kycs []GenesisKYCService,
+	stos []GenesisSecurityToken,
+) GenesisContractFact {
+	fact := GenesisContractFact{
+		BaseFact: base.NewBaseFact(
+			GenesisContractFactHint,
+			base.Token(localtime.New(localtime.Now().UTC()).Bytes()),
+		),
+		contract: contract,
+		owner:    owner,
+		kycs:     kycs,
+		stos:     stos,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GenesisContractFact) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid GenesisContractFact")
+
+	if err := util.CheckIsValiders(nil, false, fact.BaseFact, fact.contract, fact.owner); err != nil {
+		return e.Wrap(err)
+	}
+
+	if fact.contract.Equal(fact.owner) {
+		return e.Errorf("contract address is same with owner, %s", fact.owner)
+	}
+
+	if len(fact.kycs) < 1 && len(fact.stos) < 1 {
+		return e.Errorf("empty kyc services and security tokens")
+	}
+
+	kycs := map[string]struct{}{}
+	for _, k := range fact.kycs {
+		if err := k.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := kycs[k.Design().KYC().String()]; found {
+			return e.Errorf("duplicate kyc service found, %s", k.Design().KYC())
+		}
+
+		kycs[k.Design().KYC().String()] = struct{}{}
+	}
+
+	stos := map[string]struct{}{}
+	for _, s := range fact.stos {
+		if err := s.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := stos[s.Design().STO().String()]; found {
+			return e.Errorf("duplicate security token found, %s", s.Design().STO())
+		}
+
+		stos[s.Design().STO().String()] = struct{}{}
+	}
+
+	if !fact.Hash().Equal(fact.GenerateHash()) {
+		return e.Errorf("hash does not match")
+	}
+
+	return nil
+}
+
+func (fact GenesisContractFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GenesisContractFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GenesisContractFact) Bytes() []byte {
+	kbs := make([][]byte, len(fact.kycs))
+	for i, k := range fact.kycs {
+		kbs[i] = k.Bytes()
+	}
+
+	sbs := make([][]byte, len(fact.stos))
+	for i, s := range fact.stos {
+		sbs[i] = s.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.contract.Bytes(),
+		fact.owner.Bytes(),
+		util.ConcatBytesSlice(kbs...),
+		util.ConcatBytesSlice(sbs...),
+	)
+}
+
+func (fact GenesisContractFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GenesisContractFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GenesisContractFact) Owner() base.Address {
+	return fact.owner
+}
+
+func (fact GenesisContractFact) KYCServices() []GenesisKYCService {
+	return fact.kycs
+}
+
+func (fact GenesisContractFact) SecurityTokens() []GenesisSecurityToken {
+	return fact.stos
+}
+
+// GenesisContract is only used for genesis block.
+type GenesisContract struct {
+	common.BaseOperation
+}
+
+func NewGenesisContract(fact GenesisContractFact) GenesisContract {
+	return GenesisContract{
+		BaseOperation: common.NewBaseOperation(GenesisContractHint, fact),
+	}
+}
+
+func (op GenesisContract) IsValid(networkID []byte) error {
+	e := util.ErrInvalid.Errorf("invalid GenesisContract")
+
+	if err := op.BaseOperation.IsValid(networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(op.Signs()) > 1 {
+		return e.Errorf("multiple signs found")
+	}
+
+	if _, ok := op.Fact().(GenesisContractFact); !ok {
+		return e.Errorf("not GenesisContractFact, %T", op.Fact())
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package genesis // nolint:dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (fact GenesisContractFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":           fact.Hint().String(),
+			"contract":        fact.contract,
+			"owner":           fact.owner,
+			"kyc_services":    fact.kycs,
+			"security_tokens": fact.stos,
+			"hash":            fact.BaseFact.Hash().String(),
+			"token":           fact.BaseFact.Token(),
+		},
+	)
+}
+
+type GenesisContractFactBSONUnmarshaler struct {
+	Hint           string   `bson:"_hint"`
+	Contract       string   `bson:"contract"`
+	Owner          string   `bson:"owner"`
+	KYCServices    bson.Raw `bson:"kyc_services"`
+	SecurityTokens bson.Raw `bson:"security_tokens"`
+}
+
+func (fact *GenesisContractFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisContractFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf GenesisContractFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Contract, uf.Owner, uf.KYCServices, uf.SecurityTokens)
+}
+
+func (op GenesisContract) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *GenesisContract) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisContract")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package genesis
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *GenesisContractFact) unpack(enc encoder.Encoder, ca, ow string, bks, bss []byte) error {
+	e := util.StringError("failed to unmarshal GenesisContractFact")
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(ow, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.owner = a
+	}
+
+	hks, err := enc.DecodeSlice(bks)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	kycs := make([]GenesisKYCService, len(hks))
+	for i := range hks {
+		k, ok := hks[i].(GenesisKYCService)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GenesisKYCService, not %T", hks[i]))
+		}
+
+		kycs[i] = k
+	}
+	fact.kycs = kycs
+
+	hss, err := enc.DecodeSlice(bss)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	stos := make([]GenesisSecurityToken, len(hss))
+	for i := range hss {
+		s, ok := hss[i].(GenesisSecurityToken)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GenesisSecurityToken, not %T", hss[i]))
+		}
+
+		stos[i] = s
+	}
+	fact.stos = stos
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package genesis
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type GenesisContractFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Contract       base.Address           `json:"contract"`
+	Owner          base.Address           `json:"owner"`
+	KYCServices    []GenesisKYCService    `json:"kyc_services"`
+	SecurityTokens []GenesisSecurityToken `json:"security_tokens"`
+}
+
+func (fact GenesisContractFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisContractFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Contract:              fact.contract,
+		Owner:                 fact.owner,
+		KYCServices:           fact.kycs,
+		SecurityTokens:        fact.stos,
+	})
+}
+
+type GenesisContractFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Contract       string          `json:"contract"`
+	Owner          string          `json:"owner"`
+	KYCServices    json.RawMessage `json:"kyc_services"`
+	SecurityTokens json.RawMessage `json:"security_tokens"`
+}
+
+func (fact *GenesisContractFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisContractFact")
+
+	var uf GenesisContractFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Contract, uf.Owner, uf.KYCServices, uf.SecurityTokens)
+}
+
+type GenesisContractMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op GenesisContract) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisContractMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *GenesisContract) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisContract")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package genesis
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+func (op GenesisContract) PreProcess(ctx context.Context, getStateFunc base.GetStateFunc) (
+	context.Context, base.OperationProcessReasonError, error,
+) {
+	fact := op.Fact().(GenesisContractFact) //nolint:forcetypeassert //...
+
+	if err := currencystate.CheckNotExistsState(currency.StateKeyAccount(fact.Contract()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account already exists, %q: %w", fact.Contract(), err), nil
+	}
+
+	sts, err := contractStates(fact)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	getStateFunc = genesisStateFunc(sts, getStateFunc)
+
+	for _, s := range fact.SecurityTokens() {
+		if _, err := securityTokenStates(fact.Contract(), s, getStateFunc); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError(
+				"invalid genesis security token, %s-%s: %w", fact.Contract(), s.Design().STO(), err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (op GenesisContract) Process(_ context.Context, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact := op.Fact().(GenesisContractFact) //nolint:forcetypeassert //...
+
+	sts, err := contractStates(fact)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	getStateFunc = genesisStateFunc(sts, getStateFunc)
+
+	for _, s := range fact.SecurityTokens() {
+		ssts, err := securityTokenStates(fact.Contract(), s, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"invalid genesis security token, %s-%s: %w", fact.Contract(), s.Design().STO(), err), nil
+		}
+
+		sts = append(sts, ssts...)
+	}
+
+	return sts, nil, nil
+}
+
+// contractStates returns the states of contract account and its kyc services.
+func contractStates(fact GenesisContractFact) ([]base.StateMergeValue, error) {
+	ca := fact.Contract()
+
+	ks, err := currencytypes.NewContractAccountKeys()
+	if err != nil {
+		return nil, errors.Errorf("failed to create contract account keys: %v", err)
+	}
+
+	nac, err := currencytypes.NewAccount(ca, nil)
+	if err != nil {
+		return nil, errors.Errorf("failed to create contract account, %q: %v", ca, err)
+	}
+
+	ncac, err := nac.SetKeys(ks)
+	if err != nil {
+		return nil, errors.Errorf("failed to set contract account keys, %q: %v", ca, err)
+	}
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(currency.StateKeyAccount(ca), currency.NewAccountStateValue(ncac)),
+		currencystate.NewStateMergeValue(
+			extension.StateKeyContractAccount(ca),
+			extension.NewContractAccountStateValue(currencytypes.NewContractAccountStatus(fact.Owner())),
+		),
+	}
+
+	for _, k := range fact.KYCServices() {
+		kid := k.Design().KYC()
+
+		sts = append(sts, currencystate.NewStateMergeValue(
+			kycstate.StateKeyDesign(ca, kid),
+			kycstate.NewDesignStateValue(k.Design()),
+		))
+
+		for _, c := range k.Customers() {
+			sts = append(sts, currencystate.NewStateMergeValue(
+				kycstate.StateKeyCustomer(ca, kid, c.Customer()),
+				kycstate.NewCustomerStateValue(c.Info()),
+			))
+		}
+	}
+
+	return sts, nil
+}
+
+// genesisStateFunc finds the states of sts before getStateFunc; the genesis
+// operations can not see the states of each other, so the tokenholders are
+// checked with the kyc services of the same genesis contract.
+func genesisStateFunc(sts []base.StateMergeValue, getStateFunc base.GetStateFunc) base.GetStateFunc {
+	m := map[string]base.State{}
+	for i := range sts {
+		m[sts[i].Key()] = common.NewBaseState(base.GenesisHeight, sts[i].Key(), sts[i].Value(), nil, nil)
+	}
+
+	return func(key string) (base.State, bool, error) {
+		if st, found := m[key]; found {
+			return st, true, nil
+		}
+
+		return getStateFunc(key)
+	}
+}
+
+// checkTokenHolder checks holder of genesis allocations like
+// IssueSecurityTokens checks receiver. The account of holder is checked by the
+// genesis block generator, which knows the accounts created in genesis.
+func checkTokenHolder(kyc stotypes.KYCRequirement, holder base.Address, getStateFunc base.GetStateFunc) error {
+	if err := currencystate.CheckNotExistsState(extension.StateKeyContractAccount(holder), getStateFunc); err != nil {
+		return errors.Errorf("contract account cannot be tokenholder, %q", holder)
+	}
+
+	return stostate.CheckTokenHolderKYC(kyc, holder, base.GenesisHeight, getStateFunc)
+}
+
+// securityTokenStates merges the allocations by partition and by tokenholder,
+// so each state key is written once in genesis block. The tokenholders are
+// counted in their jurisdictions like IssueSecurityTokens does.
+func securityTokenStates(
+	ca base.Address, s GenesisSecurityToken, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	sid := s.Design().STO()
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			stostate.StateKeyDesign(ca, sid),
+			stostate.NewDesignStateValue(s.Design()),
+		),
+	}
+
+	pbs := map[stotypes.Partition]common.Big{}
+	var holders []base.Address
+	hps := map[string][]stotypes.Partition{}
+	hpbs := map[string]map[stotypes.Partition]common.Big{}
+
+	for _, a := range s.Allocations() {
+		p := a.Partition()
+		h := a.TokenHolder().String()
+
+		if b, found := pbs[p]; found {
+			pbs[p] = b.Add(a.Amount())
+		} else {
+			pbs[p] = a.Amount()
+		}
+
+		if _, found := hpbs[h]; !found {
+			holders = append(holders, a.TokenHolder())
+			hpbs[h] = map[stotypes.Partition]common.Big{}
+		}
+
+		if b, found := hpbs[h][p]; found {
+			hpbs[h][p] = b.Add(a.Amount())
+		} else {
+			hps[h] = append(hps[h], p)
+			hpbs[h][p] = a.Amount()
+		}
+	}
+
+	for _, p := range s.Design().Policy().Partitions() {
+		sts = append(sts, currencystate.NewStateMergeValue(
+			stostate.StateKeyPartitionBalance(ca, sid, p),
+			stostate.NewPartitionBalanceStateValue(pbs[p]),
+		))
+	}
+
+	kyc := s.Design().Policy().KYC()
+	rule := s.Design().Jurisdictions()
+
+	var js []kyctypes.Jurisdiction
+	counts := map[kyctypes.Jurisdiction]uint64{}
+
+	for _, holder := range holders {
+		h := holder.String()
+
+		if err := checkTokenHolder(kyc, holder, getStateFunc); err != nil {
+			return nil, err
+		}
+
+		j, err := stostate.TokenHolderJurisdiction(kyc, holder, base.GenesisHeight, getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := rule.Check(j); err != nil {
+			return nil, errors.Errorf("tokenholder, %q: %v", holder, err)
+		}
+
+		if _, found := counts[j]; !found {
+			js = append(js, j)
+		}
+
+		counts[j]++
+
+		if maxHolders, capped := rule.Cap(j); capped && counts[j] > maxHolders {
+			return nil, errors.Errorf("tokenholders of jurisdiction, %q over %d", j, maxHolders)
+		}
+
+		if len(j) > 0 {
+			sts = append(sts, currencystate.NewStateMergeValue(
+				stostate.StateKeyTokenHolderJurisdiction(ca, sid, holder),
+				stostate.NewTokenHolderJurisdictionStateValue(j),
+			))
+		}
+
+		sts = append(sts, currencystate.NewStateMergeValue(
+			stostate.StateKeyTokenHolderPartitions(ca, sid, holder),
+			stostate.NewTokenHolderPartitionsStateValue(hps[h]),
+		))
+
+		for _, p := range hps[h] {
+			sts = append(sts, currencystate.NewStateMergeValue(
+				stostate.StateKeyTokenHolderPartitionBalance(ca, sid, holder, p),
+				stostate.NewTokenHolderPartitionBalanceStateValue(hpbs[h][p], p),
+			))
+		}
+	}
+
+	for _, j := range js {
+		sts = append(sts, currencystate.NewStateMergeValue(
+			stostate.StateKeyJurisdictionHoldersCount(ca, sid, j),
+			stostate.NewJurisdictionHoldersCountStateValue(counts[j]),
+		))
+	}
+
+	return sts, nil
+}
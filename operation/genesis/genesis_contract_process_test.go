@@ -0,0 +1,151 @@
+package genesis_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/genesis"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestGenesisContractProcess(t *testing.T) {
+	contract := currencytypes.NewAddress("contract0")
+	owner := currencytypes.NewAddress("owner0")
+	holder := currencytypes.NewAddress("holder0")
+	other := currencytypes.NewAddress("holder1")
+	kycID := currencytypes.ContractID("KYC")
+	stoID := currencytypes.ContractID("STO")
+	partition := stotypes.Partition("PTA")
+
+	kycDesign := kyctypes.NewDesign(kycID, kyctypes.NewPolicy([]kyctypes.Controller{
+		kyctypes.NewController(owner, []kyctypes.Role{kyctypes.RoleReviewer, kyctypes.RoleApprover}),
+	}, 1))
+
+	kycService := func(customers ...base.Address) genesis.GenesisKYCService {
+		cs := make([]genesis.GenesisCustomer, len(customers))
+		for i := range customers {
+			cs[i] = genesis.NewGenesisCustomer(
+				customers[i], kyctypes.NewCustomerInfo(kyctypes.CustomerStatusApproved, "KR", "", "", 0, ""))
+		}
+
+		return genesis.NewGenesisKYCService(kycDesign, cs)
+	}
+
+	securityToken := func(rule stotypes.JurisdictionRule, holders ...base.Address) genesis.GenesisSecurityToken {
+		as := make([]genesis.GenesisAllocation, len(holders))
+		for i := range holders {
+			as[i] = genesis.NewGenesisAllocation(holders[i], partition, common.NewBig(100))
+		}
+
+		kyc := stotypes.NewKYCRequirement(
+			[]stotypes.KYCService{stotypes.NewKYCService(contract, kycID)}, stotypes.KYCModeAnyOf)
+		policy := stotypes.NewPolicy(
+			[]stotypes.Partition{partition},
+			common.NewBig(int64(100*len(holders))),
+			[]base.Address{owner},
+			[]stotypes.Document{},
+			kyc,
+		)
+
+		return genesis.NewGenesisSecurityToken(stotypes.NewDesign(stoID, 1, policy, rule), as)
+	}
+
+	capKR := stotypes.NewJurisdictionRule(nil, nil, []stotypes.JurisdictionCap{stotypes.NewJurisdictionCap("KR", 1)})
+
+	cases := []struct {
+		name   string
+		kyc    genesis.GenesisKYCService
+		sto    genesis.GenesisSecurityToken
+		reason string
+		check  func(*testing.T, map[string]base.StateValue)
+	}{
+		{
+			name: "counted in jurisdiction",
+			kyc:  kycService(holder),
+			sto:  securityToken(stotypes.EmptyJurisdictionRule(), holder),
+			check: func(t *testing.T, sts map[string]base.StateValue) {
+				v, ok := sts[stostate.StateKeyJurisdictionHoldersCount(contract, stoID, "KR")].(stostate.JurisdictionHoldersCountStateValue)
+				if !ok || v.Count != 1 {
+					t.Errorf("expected 1 tokenholder of KR, but %v", sts[stostate.StateKeyJurisdictionHoldersCount(contract, stoID, "KR")])
+				}
+
+				j, ok := sts[stostate.StateKeyTokenHolderJurisdiction(contract, stoID, holder)].(stostate.TokenHolderJurisdictionStateValue)
+				if !ok || j.Jurisdiction != "KR" {
+					t.Errorf("expected tokenholder in KR, but %v", sts[stostate.StateKeyTokenHolderJurisdiction(contract, stoID, holder)])
+				}
+			},
+		},
+		{
+			name:   "not verified",
+			kyc:    kycService(),
+			sto:    securityToken(stotypes.EmptyJurisdictionRule(), holder),
+			reason: "tokenholder not verified",
+		},
+		{
+			name:   "jurisdiction cap",
+			kyc:    kycService(holder, other),
+			sto:    securityToken(capKR, holder, other),
+			reason: "over 1",
+		},
+		{
+			name:   "contract account",
+			kyc:    kycService(contract),
+			sto:    securityToken(stotypes.EmptyJurisdictionRule(), contract),
+			reason: "contract account cannot be tokenholder",
+		},
+	}
+
+	noStates := func(string) (base.State, bool, error) {
+		return nil, false, nil
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			op := genesis.NewGenesisContract(genesis.NewGenesisContractFact(
+				contract, owner, []genesis.GenesisKYCService{c.kyc}, []genesis.GenesisSecurityToken{c.sto},
+			))
+
+			if err := op.Fact().IsValid(nil); err != nil {
+				t.Fatalf("invalid fact: %+v", err)
+			}
+
+			ctx, reason, err := op.PreProcess(context.Background(), noStates)
+			if err != nil {
+				t.Fatalf("failed to preprocess: %+v", err)
+			}
+
+			var mvs []base.StateMergeValue
+			if reason == nil {
+				if mvs, reason, err = op.Process(ctx, noStates); err != nil {
+					t.Fatalf("failed to process: %+v", err)
+				}
+			}
+
+			switch {
+			case len(c.reason) < 1 && reason != nil:
+				t.Fatalf("unexpected reason: %v", reason)
+			case len(c.reason) > 0 && reason == nil:
+				t.Fatalf("expected reason, %q, but processed", c.reason)
+			case len(c.reason) > 0 && !strings.Contains(reason.Error(), c.reason):
+				t.Fatalf("expected reason, %q, but %q", c.reason, reason.Error())
+			}
+
+			if c.check != nil {
+				sts := map[string]base.StateValue{}
+				for i := range mvs {
+					sts[mvs[i].Key()] = mvs[i].Value()
+				}
+
+				c.check(t, sts)
+			}
+		})
+	}
+}
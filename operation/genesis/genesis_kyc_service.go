@@ -0,0 +1,102 @@
+package genesis
+
+import (
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	GenesisCustomerHint   = hint.MustNewHint("mitum-sto-genesis-customer-v0.0.1")
+	GenesisKYCServiceHint = hint.MustNewHint("mitum-sto-genesis-kyc-service-v0.0.1")
+)
+
+type GenesisCustomer struct {
+	hint.BaseHinter
+	customer base.Address
+	info     kyctypes.CustomerInfo
+}
+
+func NewGenesisCustomer(customer base.Address, info kyctypes.CustomerInfo) GenesisCustomer {
+	return GenesisCustomer{
+		BaseHinter: hint.NewBaseHinter(GenesisCustomerHint),
+		customer:   customer,
+		info:       info,
+	}
+}
+
+func (c GenesisCustomer) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		c.customer.Bytes(),
+		c.info.Bytes(),
+	)
+}
+
+func (c GenesisCustomer) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false, c.BaseHinter, c.customer, c.info)
+}
+
+func (c GenesisCustomer) Customer() base.Address {
+	return c.customer
+}
+
+func (c GenesisCustomer) Info() kyctypes.CustomerInfo {
+	return c.info
+}
+
+// GenesisKYCService creates kyc service with its customers in genesis.
+type GenesisKYCService struct {
+	hint.BaseHinter
+	design    kyctypes.Design
+	customers []GenesisCustomer
+}
+
+func NewGenesisKYCService(design kyctypes.Design, customers []GenesisCustomer) GenesisKYCService {
+	return GenesisKYCService{
+		BaseHinter: hint.NewBaseHinter(GenesisKYCServiceHint),
+		design:     design,
+		customers:  customers,
+	}
+}
+
+func (k GenesisKYCService) Bytes() []byte {
+	bs := make([][]byte, len(k.customers))
+	for i, c := range k.customers {
+		bs[i] = c.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		k.design.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (k GenesisKYCService) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, k.BaseHinter, k.design); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, c := range k.customers {
+		if err := c.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[c.customer.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate customer found, %s", c.customer)
+		}
+
+		founds[c.customer.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (k GenesisKYCService) Design() kyctypes.Design {
+	return k.design
+}
+
+func (k GenesisKYCService) Customers() []GenesisCustomer {
+	return k.customers
+}
@@ -0,0 +1,72 @@
+package genesis // nolint:dupl
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (c GenesisCustomer) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    c.Hint().String(),
+			"customer": c.customer,
+			"info":     c.info,
+		},
+	)
+}
+
+type GenesisCustomerBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Customer string   `bson:"customer"`
+	Info     bson.Raw `bson:"info"`
+}
+
+func (c *GenesisCustomer) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisCustomer")
+
+	var u GenesisCustomerBSONUnmarshaler
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, ht, u.Customer, u.Info)
+}
+
+func (k GenesisKYCService) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     k.Hint().String(),
+			"design":    k.design,
+			"customers": k.customers,
+		},
+	)
+}
+
+type GenesisKYCServiceBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Design    bson.Raw `bson:"design"`
+	Customers bson.Raw `bson:"customers"`
+}
+
+func (k *GenesisKYCService) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisKYCService")
+
+	var u GenesisKYCServiceBSONUnmarshaler
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return k.unpack(enc, ht, u.Design, u.Customers)
+}
@@ -0,0 +1,65 @@
+package genesis
+
+import (
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (c *GenesisCustomer) unpack(enc encoder.Encoder, ht hint.Hint, cu string, bi []byte) error {
+	e := util.StringError("failed to unmarshal GenesisCustomer")
+
+	c.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(cu, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		c.customer = a
+	}
+
+	if hinter, err := enc.Decode(bi); err != nil {
+		return e.Wrap(err)
+	} else if info, ok := hinter.(kyctypes.CustomerInfo); !ok {
+		return e.Wrap(errors.Errorf("expected CustomerInfo, not %T", hinter))
+	} else {
+		c.info = info
+	}
+
+	return nil
+}
+
+func (k *GenesisKYCService) unpack(enc encoder.Encoder, ht hint.Hint, bd, bcs []byte) error {
+	e := util.StringError("failed to unmarshal GenesisKYCService")
+
+	k.BaseHinter = hint.NewBaseHinter(ht)
+
+	if hinter, err := enc.Decode(bd); err != nil {
+		return e.Wrap(err)
+	} else if design, ok := hinter.(kyctypes.Design); !ok {
+		return e.Wrap(errors.Errorf("expected kyc Design, not %T", hinter))
+	} else {
+		k.design = design
+	}
+
+	hcs, err := enc.DecodeSlice(bcs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	customers := make([]GenesisCustomer, len(hcs))
+	for i := range hcs {
+		c, ok := hcs[i].(GenesisCustomer)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GenesisCustomer, not %T", hcs[i]))
+		}
+
+		customers[i] = c
+	}
+	k.customers = customers
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package genesis
+
+import (
+	"encoding/json"
+
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type GenesisCustomerJSONMarshaler struct {
+	hint.BaseHinter
+	Customer base.Address          `json:"customer"`
+	Info     kyctypes.CustomerInfo `json:"info"`
+}
+
+func (c GenesisCustomer) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisCustomerJSONMarshaler{
+		BaseHinter: c.BaseHinter,
+		Customer:   c.customer,
+		Info:       c.info,
+	})
+}
+
+type GenesisCustomerJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Customer string          `json:"customer"`
+	Info     json.RawMessage `json:"info"`
+}
+
+func (c *GenesisCustomer) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisCustomer")
+
+	var u GenesisCustomerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, u.Hint, u.Customer, u.Info)
+}
+
+type GenesisKYCServiceJSONMarshaler struct {
+	hint.BaseHinter
+	Design    kyctypes.Design   `json:"design"`
+	Customers []GenesisCustomer `json:"customers"`
+}
+
+func (k GenesisKYCService) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisKYCServiceJSONMarshaler{
+		BaseHinter: k.BaseHinter,
+		Design:     k.design,
+		Customers:  k.customers,
+	})
+}
+
+type GenesisKYCServiceJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Design    json.RawMessage `json:"design"`
+	Customers json.RawMessage `json:"customers"`
+}
+
+func (k *GenesisKYCService) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisKYCService")
+
+	var u GenesisKYCServiceJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return k.unpack(enc, u.Hint, u.Design, u.Customers)
+}
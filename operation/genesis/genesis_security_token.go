@@ -0,0 +1,149 @@
+package genesis
+
+import (
+	"math/big"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	GenesisAllocationHint    = hint.MustNewHint("mitum-sto-genesis-allocation-v0.0.1")
+	GenesisSecurityTokenHint = hint.MustNewHint("mitum-sto-genesis-security-token-v0.0.1")
+)
+
+type GenesisAllocation struct {
+	hint.BaseHinter
+	tokenHolder base.Address
+	partition   stotypes.Partition
+	amount      common.Big
+}
+
+func NewGenesisAllocation(tokenHolder base.Address, partition stotypes.Partition, amount common.Big) GenesisAllocation {
+	return GenesisAllocation{
+		BaseHinter:  hint.NewBaseHinter(GenesisAllocationHint),
+		tokenHolder: tokenHolder,
+		partition:   partition,
+		amount:      amount,
+	}
+}
+
+func (a GenesisAllocation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		a.tokenHolder.Bytes(),
+		a.partition.Bytes(),
+		a.amount.Bytes(),
+	)
+}
+
+func (a GenesisAllocation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, a.BaseHinter, a.tokenHolder, a.partition); err != nil {
+		return err
+	}
+
+	if !a.amount.OverZero() {
+		return util.ErrInvalid.Errorf("amount must be over zero")
+	}
+
+	return nil
+}
+
+func (a GenesisAllocation) TokenHolder() base.Address {
+	return a.tokenHolder
+}
+
+func (a GenesisAllocation) Partition() stotypes.Partition {
+	return a.partition
+}
+
+func (a GenesisAllocation) Amount() common.Big {
+	return a.amount
+}
+
+// GenesisSecurityToken creates sto with the initial partition balances of
+// tokenholders in genesis. The aggregate of design must be the sum of
+// allocations and every partition of design must be allocated.
+type GenesisSecurityToken struct {
+	hint.BaseHinter
+	design      stotypes.Design
+	allocations []GenesisAllocation
+}
+
+func NewGenesisSecurityToken(design stotypes.Design, allocations []GenesisAllocation) GenesisSecurityToken {
+	return GenesisSecurityToken{
+		BaseHinter:  hint.NewBaseHinter(GenesisSecurityTokenHint),
+		design:      design,
+		allocations: allocations,
+	}
+}
+
+func (s GenesisSecurityToken) Bytes() []byte {
+	bs := make([][]byte, len(s.allocations))
+	for i, a := range s.allocations {
+		bs[i] = a.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		s.design.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (s GenesisSecurityToken) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, s.BaseHinter, s.design); err != nil {
+		return err
+	}
+
+	partitions := map[stotypes.Partition]bool{}
+	for _, p := range s.design.Policy().Partitions() {
+		partitions[p] = false
+	}
+
+	if s.design.Granularity() < 1 {
+		return util.ErrInvalid.Errorf("zero granularity, %s", s.design.STO())
+	}
+
+	gn := new(big.Int).SetUint64(s.design.Granularity())
+
+	sum := common.ZeroBig
+	for _, a := range s.allocations {
+		if err := a.IsValid(nil); err != nil {
+			return err
+		}
+
+		if new(big.Int).Mod(a.amount.Int, gn).Sign() > 0 {
+			return util.ErrInvalid.Errorf(
+				"amount unit does not comply with sto granularity rule, %q, %d", a.amount, s.design.Granularity())
+		}
+
+		if _, found := partitions[a.partition]; !found {
+			return util.ErrInvalid.Errorf("partition not in sto design, %q", a.partition)
+		}
+		partitions[a.partition] = true
+
+		sum = sum.Add(a.amount)
+	}
+
+	for p, allocated := range partitions {
+		if !allocated {
+			return util.ErrInvalid.Errorf("partition without allocation, %q", p)
+		}
+	}
+
+	if aggregate := s.design.Policy().Aggregate(); !sum.Equal(aggregate) {
+		return util.ErrInvalid.Errorf("aggregate does not match with allocations, %v != %v", aggregate, sum)
+	}
+
+	return nil
+}
+
+func (s GenesisSecurityToken) Design() stotypes.Design {
+	return s.design
+}
+
+func (s GenesisSecurityToken) Allocations() []GenesisAllocation {
+	return s.allocations
+}
@@ -0,0 +1,74 @@
+package genesis // nolint:dupl
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (a GenesisAllocation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       a.Hint().String(),
+			"tokenholder": a.tokenHolder,
+			"partition":   a.partition,
+			"amount":      a.amount.String(),
+		},
+	)
+}
+
+type GenesisAllocationBSONUnmarshaler struct {
+	Hint        string `bson:"_hint"`
+	TokenHolder string `bson:"tokenholder"`
+	Partition   string `bson:"partition"`
+	Amount      string `bson:"amount"`
+}
+
+func (a *GenesisAllocation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisAllocation")
+
+	var u GenesisAllocationBSONUnmarshaler
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, ht, u.TokenHolder, u.Partition, u.Amount)
+}
+
+func (s GenesisSecurityToken) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       s.Hint().String(),
+			"design":      s.design,
+			"allocations": s.allocations,
+		},
+	)
+}
+
+type GenesisSecurityTokenBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Design      bson.Raw `bson:"design"`
+	Allocations bson.Raw `bson:"allocations"`
+}
+
+func (s *GenesisSecurityToken) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GenesisSecurityToken")
+
+	var u GenesisSecurityTokenBSONUnmarshaler
+	if err := bson.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Design, u.Allocations)
+}
@@ -0,0 +1,65 @@
+package genesis
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (a *GenesisAllocation) unpack(enc encoder.Encoder, ht hint.Hint, th, p, am string) error {
+	e := util.StringError("failed to unmarshal GenesisAllocation")
+
+	a.BaseHinter = hint.NewBaseHinter(ht)
+	a.partition = stotypes.Partition(p)
+
+	switch ad, err := base.DecodeAddress(th, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		a.tokenHolder = ad
+	}
+
+	amount, err := common.NewBigFromString(am)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.amount = amount
+
+	return nil
+}
+
+func (s *GenesisSecurityToken) unpack(enc encoder.Encoder, ht hint.Hint, bd, bas []byte) error {
+	e := util.StringError("failed to unmarshal GenesisSecurityToken")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	if hinter, err := enc.Decode(bd); err != nil {
+		return e.Wrap(err)
+	} else if design, ok := hinter.(stotypes.Design); !ok {
+		return e.Wrap(errors.Errorf("expected sto Design, not %T", hinter))
+	} else {
+		s.design = design
+	}
+
+	has, err := enc.DecodeSlice(bas)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	allocations := make([]GenesisAllocation, len(has))
+	for i := range has {
+		a, ok := has[i].(GenesisAllocation)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GenesisAllocation, not %T", has[i]))
+		}
+
+		allocations[i] = a
+	}
+	s.allocations = allocations
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package genesis
+
+import (
+	"encoding/json"
+
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type GenesisAllocationJSONMarshaler struct {
+	hint.BaseHinter
+	TokenHolder base.Address       `json:"tokenholder"`
+	Partition   stotypes.Partition `json:"partition"`
+	Amount      string             `json:"amount"`
+}
+
+func (a GenesisAllocation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisAllocationJSONMarshaler{
+		BaseHinter:  a.BaseHinter,
+		TokenHolder: a.tokenHolder,
+		Partition:   a.partition,
+		Amount:      a.amount.String(),
+	})
+}
+
+type GenesisAllocationJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	TokenHolder string    `json:"tokenholder"`
+	Partition   string    `json:"partition"`
+	Amount      string    `json:"amount"`
+}
+
+func (a *GenesisAllocation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisAllocation")
+
+	var u GenesisAllocationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, u.Hint, u.TokenHolder, u.Partition, u.Amount)
+}
+
+type GenesisSecurityTokenJSONMarshaler struct {
+	hint.BaseHinter
+	Design      stotypes.Design     `json:"design"`
+	Allocations []GenesisAllocation `json:"allocations"`
+}
+
+func (s GenesisSecurityToken) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisSecurityTokenJSONMarshaler{
+		BaseHinter:  s.BaseHinter,
+		Design:      s.design,
+		Allocations: s.allocations,
+	})
+}
+
+type GenesisSecurityTokenJSONUnmarshaler struct {
+	Hint        hint.Hint       `json:"_hint"`
+	Design      json.RawMessage `json:"design"`
+	Allocations json.RawMessage `json:"allocations"`
+}
+
+func (s *GenesisSecurityToken) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisSecurityToken")
+
+	var u GenesisSecurityTokenJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Design, u.Allocations)
+}
@@ -2,6 +2,7 @@ package kyc
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	AddControllersHint     = hint.MustNewHint("mitum-kyc-add-controllers-operation-v0.0.1")
 )
 
-var MaxAddControllersItems uint = 10
+var MaxAddControllersItems = uint(networktypes.MaxItemsLimit)
 
 type AddControllersFact struct {
 	base.BaseFact
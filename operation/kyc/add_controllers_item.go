@@ -2,6 +2,7 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,13 +14,20 @@ type KYCItem interface {
 	Currency() currencytypes.CurrencyID
 }
 
-var AddControllersItemHint = hint.MustNewHint("mitum-kyc-add-controllers-item-v0.0.1")
+var (
+	AddControllersItemHint = hint.MustNewHint("mitum-kyc-add-controllers-item-v0.0.2")
+	// LegacyAddControllersItemHint is the item without roles; it is decoded
+	// with the legacy hint and kyctypes.LegacyControllerRoles, and keeps the
+	// bytes of the item, so the facts of stored blocks have the same hash.
+	LegacyAddControllersItemHint = hint.MustNewHint("mitum-kyc-add-controllers-item-v0.0.1")
+)
 
 type AddControllersItem struct {
 	hint.BaseHinter
 	contract   base.Address
 	kycID      currencytypes.ContractID
 	controller base.Address
+	roles      []kyctypes.Role
 	currency   currencytypes.CurrencyID
 }
 
@@ -27,6 +35,7 @@ func NewAddControllersItem(
 	contract base.Address,
 	kycID currencytypes.ContractID,
 	controller base.Address,
+	roles []kyctypes.Role,
 	currency currencytypes.CurrencyID,
 ) AddControllersItem {
 	return AddControllersItem{
@@ -34,15 +43,22 @@ func NewAddControllersItem(
 		contract:   contract,
 		kycID:      kycID,
 		controller: controller,
+		roles:      roles,
 		currency:   currency,
 	}
 }
 
 func (it AddControllersItem) Bytes() []byte {
+	var rb []byte
+	if !it.isLegacy() {
+		rb = rolesBytes(it.roles)
+	}
+
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.kycID.Bytes(),
 		it.controller.Bytes(),
+		rb,
 		it.currency.Bytes(),
 	)
 }
@@ -56,9 +72,17 @@ func (it AddControllersItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("contract address is same with controller, %q", it.contract)
 	}
 
+	if err := kyctypes.NewController(it.controller, it.roles).IsValid(nil); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (it AddControllersItem) isLegacy() bool {
+	return it.Hint().Equal(LegacyAddControllersItemHint)
+}
+
 func (it AddControllersItem) KYC() currencytypes.ContractID {
 	return it.kycID
 }
@@ -71,6 +95,10 @@ func (it AddControllersItem) Controller() base.Address {
 	return it.controller
 }
 
+func (it AddControllersItem) Roles() []kyctypes.Role {
+	return it.roles
+}
+
 func (it AddControllersItem) Currency() currencytypes.CurrencyID {
 	return it.currency
 }
@@ -83,3 +111,12 @@ func (it AddControllersItem) Addresses() []base.Address {
 
 	return ad
 }
+
+func rolesBytes(roles []kyctypes.Role) []byte {
+	bs := make([][]byte, len(roles))
+	for i := range roles {
+		bs[i] = roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
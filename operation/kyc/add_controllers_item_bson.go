@@ -8,23 +8,37 @@ import (
 )
 
 func (it AddControllersItem) MarshalBSON() ([]byte, error) {
+	if it.isLegacy() {
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":      it.Hint().String(),
+				"contract":   it.contract,
+				"kycid":      it.kycID,
+				"controller": it.controller,
+				"currency":   it.currency,
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":      it.Hint().String(),
 			"contract":   it.contract,
 			"kycid":      it.kycID,
 			"controller": it.controller,
+			"roles":      it.roles,
 			"currency":   it.currency,
 		},
 	)
 }
 
 type AddControllersItemBSONUnmarshaler struct {
-	Hint       string `bson:"_hint"`
-	Contract   string `bson:"contract"`
-	KYC        string `bson:"kycid"`
-	Controller string `bson:"controller"`
-	Currency   string `bson:"currency"`
+	Hint       string   `bson:"_hint"`
+	Contract   string   `bson:"contract"`
+	KYC        string   `bson:"kycid"`
+	Controller string   `bson:"controller"`
+	Roles      []string `bson:"roles"`
+	Currency   string   `bson:"currency"`
 }
 
 func (it *AddControllersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -40,5 +54,5 @@ func (it *AddControllersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Contract, uit.KYC, uit.Controller, uit.Currency)
+	return it.unpack(enc, ht, uit.Contract, uit.KYC, uit.Controller, uit.Roles, uit.Currency)
 }
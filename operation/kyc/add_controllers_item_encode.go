@@ -2,19 +2,30 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (it *AddControllersItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc, con, cid string) error {
+func (it *AddControllersItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc, con string, rs []string, cid string) error {
 	e := util.StringError("failed to unmarshal AddControllersItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.kycID = currencytypes.ContractID(kyc)
 	it.currency = currencytypes.CurrencyID(cid)
 
+	roles := make([]kyctypes.Role, len(rs))
+	for i := range rs {
+		roles[i] = kyctypes.Role(rs[i])
+	}
+	it.roles = roles
+
+	if ht.Equal(LegacyAddControllersItemHint) {
+		it.roles = kyctypes.LegacyControllerRoles
+	}
+
 	switch a, err := base.DecodeAddress(ca, enc); {
 	case err != nil:
 		return e.Wrap(err)
@@ -2,6 +2,7 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -9,6 +10,15 @@ import (
 )
 
 type AddControllersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract   base.Address             `json:"contract"`
+	KYC        currencytypes.ContractID `json:"kycid"`
+	Controller base.Address             `json:"controller"`
+	Roles      []kyctypes.Role          `json:"roles"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+type LegacyAddControllersItemJSONMarshaler struct {
 	hint.BaseHinter
 	Contract   base.Address             `json:"contract"`
 	KYC        currencytypes.ContractID `json:"kycid"`
@@ -17,11 +27,22 @@ type AddControllersItemJSONMarshaler struct {
 }
 
 func (it AddControllersItem) MarshalJSON() ([]byte, error) {
+	if it.isLegacy() {
+		return util.MarshalJSON(LegacyAddControllersItemJSONMarshaler{
+			BaseHinter: it.BaseHinter,
+			Contract:   it.contract,
+			KYC:        it.kycID,
+			Controller: it.controller,
+			Currency:   it.currency,
+		})
+	}
+
 	return util.MarshalJSON(AddControllersItemJSONMarshaler{
 		BaseHinter: it.BaseHinter,
 		Contract:   it.contract,
 		KYC:        it.kycID,
 		Controller: it.controller,
+		Roles:      it.roles,
 		Currency:   it.currency,
 	})
 }
@@ -31,6 +52,7 @@ type AddControllersItemJSONUnMarshaler struct {
 	Contract   string    `json:"contract"`
 	KYC        string    `json:"kycid"`
 	Controller string    `json:"controller"`
+	Roles      []string  `json:"roles"`
 	Currency   string    `json:"currency"`
 }
 
@@ -42,5 +64,5 @@ func (it *AddControllersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Controller, uit.Currency)
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Controller, uit.Roles, uit.Currency)
 }
@@ -4,13 +4,12 @@ import (
 	"context"
 	"sync"
 
-	"github.com/ProtoconNet/mitum-currency/v3/common"
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -39,7 +38,7 @@ type AddControllersItemProcessor struct {
 	h           util.Hash
 	sender      base.Address
 	item        AddControllersItem
-	controllers *[]base.Address
+	controllers *[]kyctypes.Controller
 }
 
 func (ipp *AddControllersItemProcessor) PreProcess(
@@ -47,23 +46,13 @@ func (ipp *AddControllersItemProcessor) PreProcess(
 ) error {
 	it := ipp.item
 
-	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
-	if err != nil {
-		return err
-	}
-
-	ca, err := extensioncurrency.StateContractAccountValue(st)
-	if err != nil {
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleAdmin); err != nil {
 		return err
 	}
 
-	if !ca.Owner().Equal(ipp.sender) {
-		return errors.Errorf("not contract account owner, %q", it.Contract())
-	}
-
 	for _, ad := range *ipp.controllers {
-		if ad.Equal(it.Controller()) {
-			return errors.Errorf("controller is already in kyc policy controllers, %q", ad)
+		if ad.Address().Equal(it.Controller()) {
+			return errors.Errorf("controller is already in kyc policy controllers, %q", ad.Address())
 		}
 	}
 
@@ -77,7 +66,7 @@ func (ipp *AddControllersItemProcessor) PreProcess(
 func (ipp *AddControllersItemProcessor) Process(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) ([]base.StateMergeValue, error) {
-	*ipp.controllers = append(*ipp.controllers, ipp.item.Controller())
+	*ipp.controllers = append(*ipp.controllers, kyctypes.NewController(ipp.item.Controller(), ipp.item.Roles()))
 	return nil, nil
 }
 
@@ -137,6 +126,15 @@ func (opp *AddControllersProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -149,7 +147,7 @@ func (opp *AddControllersProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
-	controllers := map[string]map[string]*[]base.Address{}
+	controllers := map[string]map[string]*[]kyctypes.Controller{}
 
 	for _, it := range fact.Items() {
 		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
@@ -157,7 +155,12 @@ func (opp *AddControllersProcessor) PreProcess(
 			return nil, base.NewBaseOperationProcessReasonError("failed to get kyc policy, %s-%s: %w", it.Contract(), it.KYC(), err), nil
 		}
 		cons := policy.Controllers()
-		controllers[kycstate.StateKeyDesign(it.Contract(), it.KYC())][it.KYC().String()] = &cons
+
+		k := kycstate.StateKeyDesign(it.Contract(), it.KYC())
+		if _, found := controllers[k]; !found {
+			controllers[k] = map[string]*[]kyctypes.Controller{}
+		}
+		controllers[k][it.KYC().String()] = &cons
 	}
 
 	for _, it := range fact.Items() {
@@ -195,7 +198,8 @@ func (opp *AddControllersProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
-	controllers := map[string]map[string]*[]base.Address{}
+	controllers := map[string]map[string]*[]kyctypes.Controller{}
+	policies := map[string]kyctypes.Policy{}
 
 	for _, it := range fact.Items() {
 		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
@@ -203,7 +207,13 @@ func (opp *AddControllersProcessor) Process( // nolint:dupl
 			return nil, base.NewBaseOperationProcessReasonError("failed to get kyc policy, %s-%s: %w", it.Contract(), it.KYC(), err), nil
 		}
 		cons := policy.Controllers()
-		controllers[kycstate.StateKeyDesign(it.Contract(), it.KYC())][it.KYC().String()] = &cons
+
+		k := kycstate.StateKeyDesign(it.Contract(), it.KYC())
+		if _, found := controllers[k]; !found {
+			controllers[k] = map[string]*[]kyctypes.Controller{}
+		}
+		controllers[k][it.KYC().String()] = &cons
+		policies[k] = policy
 	}
 
 	for _, it := range fact.Items() {
@@ -228,7 +238,7 @@ func (opp *AddControllersProcessor) Process( // nolint:dupl
 
 	for k, m := range controllers {
 		for id, cons := range m {
-			policy := kyctypes.NewPolicy(*cons)
+			policy := policies[k].SetControllers(*cons)
 			design := kyctypes.NewDesign(currencytypes.ContractID(id), policy)
 			if err := design.IsValid(nil); err != nil {
 				return nil, base.NewBaseOperationProcessReasonError("invalid design, %s: %w", k, err), nil
@@ -241,31 +251,17 @@ func (opp *AddControllersProcessor) Process( // nolint:dupl
 		}
 	}
 
-	fitems := fact.Items()
-	items := make([]KYCItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateKYCItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *AddControllersProcessor) Close() error {
@@ -273,33 +269,3 @@ func (opp *AddControllersProcessor) Close() error {
 
 	return nil
 }
-
-func calculateKYCItemsFee(getStateFunc base.GetStateFunc, items []KYCItem) (map[currencytypes.CurrencyID][2]common.Big, error) {
-	required := map[currencytypes.CurrencyID][2]common.Big{}
-
-	for _, item := range items {
-		rq := [2]common.Big{common.ZeroBig, common.ZeroBig}
-
-		if k, found := required[item.Currency()]; found {
-			rq = k
-		}
-
-		policy, err := currencystate.ExistsCurrencyPolicy(item.Currency(), getStateFunc)
-		if err != nil {
-			return nil, err
-		}
-
-		switch k, err := policy.Feeer().Fee(common.ZeroBig); {
-		case err != nil:
-			return nil, err
-		case !k.OverZero():
-			required[item.Currency()] = [2]common.Big{rq[0], rq[1]}
-		default:
-			required[item.Currency()] = [2]common.Big{rq[0].Add(k), rq[1].Add(k)}
-		}
-
-	}
-
-	return required, nil
-
-}
@@ -0,0 +1,114 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestAddControllersProcess(t *testing.T) {
+	item := func(f *fixture, controller base.Address) kyc.AddControllersItem {
+		return kyc.NewAddControllersItem(f.contract, f.kycID, controller, []kyctypes.Role{kyctypes.RoleReviewer}, f.currency)
+	}
+
+	isController := func(f *fixture, addr base.Address) bool {
+		_, found := f.KYC(f.contract, f.kycID).Controller(addr)
+
+		return found
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "by admin",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().AddControllers(f.admin.Address, item(f, f.other.Address))
+			},
+			check: func(f *fixture) {
+				c, found := f.KYC(f.contract, f.kycID).Controller(f.other.Address)
+				if !found {
+					f.t.Fatal("controller not added")
+				}
+
+				if !c.HasRoles(kyctypes.RoleReviewer) || c.HasRoles(kyctypes.RoleApprover) {
+					f.t.Errorf("expected reviewer role, but %v", c.Roles())
+				}
+
+				f.checkBalance(f.admin.Address, balance.Sub(fee))
+				f.checkBalance(f.feeer.Address, fee)
+			},
+		},
+		{
+			name: "by owner",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().AddControllers(f.owner.Address, item(f, f.other.Address))
+			},
+			check: func(f *fixture) {
+				if !isController(f, f.other.Address) {
+					f.t.Error("controller not added")
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().AddControllers(f.admin.Address, item(f, f.other.Address), item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if !isController(f, f.other.Address) || !isController(f, f.customer.Address) {
+					f.t.Error("controllers not added")
+				}
+
+				f.checkBalance(f.admin.Address, balance.Sub(fee.MulInt64(2)))
+				f.checkBalance(f.feeer.Address, fee.MulInt64(2))
+			},
+		},
+		{
+			name: "already controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().AddControllers(f.admin.Address, item(f, f.reviewer.Address))
+			},
+			reason: "controller is already in kyc policy controllers",
+		},
+		{
+			name: "not admin",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddControllers(f.reviewer.Address, item(f, f.other.Address))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "not controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.other.Builder().AddControllers(f.other.Address, item(f, f.customer.Address))
+			},
+			reason: "not contract account owner neither its controller",
+		},
+		{
+			name: "unknown kyc service",
+			op: func(f *fixture) (base.Operation, error) {
+				it := kyc.NewAddControllersItem(
+					f.contract, "NEW", f.other.Address, []kyctypes.Role{kyctypes.RoleReviewer}, f.currency)
+
+				return f.admin.Builder().AddControllers(f.admin.Address, it)
+			},
+			reason: "failed to get kyc policy",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.admin.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().AddControllers(f.admin.Address, item(f, f.other.Address))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if isController(f, f.other.Address) {
+					f.t.Error("controller should not be added")
+				}
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package kyc
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	AddCustomersHint     = hint.MustNewHint("mitum-kyc-add-customers-operation-v0.0.1")
 )
 
-var MaxAddCustomersItems uint = 10
+var MaxAddCustomersItems = uint(networktypes.MaxItemsLimit)
 
 type AddCustomersFact struct {
 	base.BaseFact
@@ -2,19 +2,26 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-var AddCustomersItemHint = hint.MustNewHint("mitum-kyc-add-customers-item-v0.0.1")
+var (
+	AddCustomersItemHint = hint.MustNewHint("mitum-kyc-add-customers-item-v0.0.2")
+	// LegacyAddCustomersItemHint is the item with status only; it is decoded with
+	// the legacy hint and keeps the bytes of the item, so the facts of stored
+	// blocks have the same hash.
+	LegacyAddCustomersItemHint = hint.MustNewHint("mitum-kyc-add-customers-item-v0.0.1")
+)
 
 type AddCustomersItem struct {
 	hint.BaseHinter
 	contract base.Address
 	kycID    currencytypes.ContractID
 	customer base.Address
-	status   bool
+	info     kyctypes.CustomerInfo
 	currency currencytypes.CurrencyID
 }
 
@@ -22,7 +29,7 @@ func NewAddCustomersItem(
 	contract base.Address,
 	kycID currencytypes.ContractID,
 	customer base.Address,
-	status bool,
+	info kyctypes.CustomerInfo,
 	currency currencytypes.CurrencyID,
 ) AddCustomersItem {
 	return AddCustomersItem{
@@ -30,28 +37,38 @@ func NewAddCustomersItem(
 		contract:   contract,
 		kycID:      kycID,
 		customer:   customer,
-		status:     status,
+		info:       info,
 		currency:   currency,
 	}
 }
 
 func (it AddCustomersItem) Bytes() []byte {
-	b := []byte{0}
-	if it.status {
-		b[0] = 1
+	if it.isLegacy() {
+		b := []byte{0}
+		if it.info.IsApproved() {
+			b[0] = 1
+		}
+
+		return util.ConcatBytesSlice(
+			it.contract.Bytes(),
+			it.kycID.Bytes(),
+			it.customer.Bytes(),
+			b,
+			it.currency.Bytes(),
+		)
 	}
 
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.kycID.Bytes(),
 		it.customer.Bytes(),
-		b,
+		it.info.Bytes(),
 		it.currency.Bytes(),
 	)
 }
 
 func (it AddCustomersItem) IsValid([]byte) error {
-	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.currency); err != nil {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.info, it.currency); err != nil {
 		return err
 	}
 
@@ -62,6 +79,10 @@ func (it AddCustomersItem) IsValid([]byte) error {
 	return nil
 }
 
+func (it AddCustomersItem) isLegacy() bool {
+	return it.Hint().Equal(LegacyAddCustomersItemHint)
+}
+
 func (it AddCustomersItem) KYC() currencytypes.ContractID {
 	return it.kycID
 }
@@ -74,8 +95,12 @@ func (it AddCustomersItem) Customer() base.Address {
 	return it.customer
 }
 
-func (it AddCustomersItem) Status() bool {
-	return it.status
+func (it AddCustomersItem) Info() kyctypes.CustomerInfo {
+	return it.info
+}
+
+func (it AddCustomersItem) Status() kyctypes.CustomerStatus {
+	return it.info.Status()
 }
 
 func (it AddCustomersItem) Currency() currencytypes.CurrencyID {
@@ -8,25 +8,39 @@ import (
 )
 
 func (it AddCustomersItem) MarshalBSON() ([]byte, error) {
+	if it.isLegacy() {
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":    it.Hint().String(),
+				"contract": it.contract,
+				"kycid":    it.kycID,
+				"customer": it.customer,
+				"status":   it.info.IsApproved(),
+				"currency": it.currency,
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":    it.Hint().String(),
 			"contract": it.contract,
 			"kycid":    it.kycID,
 			"customer": it.customer,
-			"status":   it.status,
+			"info":     it.info,
 			"currency": it.currency,
 		},
 	)
 }
 
 type AddCustomersItemBSONUnmarshaler struct {
-	Hint     string `bson:"_hint"`
-	Contract string `bson:"contract"`
-	KYC      string `bson:"kycid"`
-	Customer string `bson:"customer"`
-	Status   bool   `bson:"status"`
-	Currency string `bson:"currency"`
+	Hint     string   `bson:"_hint"`
+	Contract string   `bson:"contract"`
+	KYC      string   `bson:"kycid"`
+	Customer string   `bson:"customer"`
+	Info     bson.Raw `bson:"info"`
+	Status   bool     `bson:"status"`
+	Currency string   `bson:"currency"`
 }
 
 func (it *AddCustomersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -42,5 +56,5 @@ func (it *AddCustomersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Contract, uit.KYC, uit.Customer, uit.Status, uit.Currency)
+	return it.unpack(enc, ht, uit.Contract, uit.KYC, uit.Customer, uit.Info, uit.Status, uit.Currency)
 }
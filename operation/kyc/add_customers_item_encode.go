@@ -2,18 +2,19 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
-func (it *AddCustomersItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc, ctm string, st bool, cid string) error {
+func (it *AddCustomersItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc, ctm string, bi []byte, status bool, cid string) error {
 	e := util.StringError("failed to unmarshal AddCustomersItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.kycID = currencytypes.ContractID(kyc)
-	it.status = st
 	it.currency = currencytypes.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(ca, enc); {
@@ -30,5 +31,19 @@ func (it *AddCustomersItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc, c
 		it.customer = a
 	}
 
+	if ht.Equal(LegacyAddCustomersItemHint) {
+		it.info = kyctypes.NewStatusCustomerInfo(status)
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bi); err != nil {
+		return e.Wrap(err)
+	} else if info, ok := hinter.(kyctypes.CustomerInfo); !ok {
+		return e.Wrap(errors.Errorf("expected CustomerInfo, not %T", hinter))
+	} else {
+		it.info = info
+	}
+
 	return nil
 }
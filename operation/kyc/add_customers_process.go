@@ -4,12 +4,13 @@ import (
 	"context"
 	"sync"
 
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/pkg/errors"
@@ -44,40 +45,30 @@ func (ipp *AddCustomersItemProcessor) PreProcess(
 ) error {
 	it := ipp.item
 
-	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
-	if err != nil {
-		return err
+	if it.Status() == kyctypes.CustomerStatusRejected {
+		return errors.Errorf("new customer can not be rejected, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
 	}
 
-	ca, err := extensioncurrency.StateContractAccountValue(st)
-	if err != nil {
+	roles := []kyctypes.Role{kyctypes.RoleReviewer}
+	if it.Info().IsApproved() {
+		roles = append(roles, kyctypes.RoleApprover)
+	}
+
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, roles...); err != nil {
 		return err
 	}
 
-	if !ca.Owner().Equal(ipp.sender) {
-		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
-		if err != nil {
+	if it.Info().IsApproved() {
+		if err := checkDirectApproval(it.Contract(), it.KYC(), getStateFunc); err != nil {
 			return err
 		}
-
-		controllers := policy.Controllers()
-		if len(controllers) == 0 {
-			return errors.Errorf("not contract account owner neither its controller, %s-%s", it.Contract(), it.KYC())
-		}
-
-		for i, con := range controllers {
-			if con.Equal(ipp.sender) {
-				break
-			}
-
-			if i == len(controllers)-1 {
-				return errors.Errorf("not contract account owner neither its controller, %s-%s", it.Contract(), it.KYC())
-			}
-		}
 	}
 
-	if err := currencystate.CheckNotExistsState(kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()), getStateFunc); err != nil {
+	switch _, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc); {
+	case err != nil:
 		return err
+	case found:
+		return errors.Errorf("customer already exists, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
 	}
 
 	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
@@ -94,7 +85,7 @@ func (ipp *AddCustomersItemProcessor) Process(
 
 	v := currencystate.NewStateMergeValue(
 		kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()),
-		kycstate.NewCustomerStateValue(kycstate.Status(it.Status())),
+		kycstate.NewCustomerStateValue(it.Info()),
 	)
 
 	sts := []base.StateMergeValue{v}
@@ -157,6 +148,15 @@ func (opp *AddCustomersProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -224,31 +224,17 @@ func (opp *AddCustomersProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]KYCItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateKYCItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *AddCustomersProcessor) Close() error {
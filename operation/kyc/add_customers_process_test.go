@@ -0,0 +1,110 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestAddCustomersProcess(t *testing.T) {
+	item := func(f *fixture, customer base.Address, status kyctypes.CustomerStatus) kyc.AddCustomersItem {
+		return kyc.NewAddCustomersItem(f.contract, f.kycID, customer, f.info(status, 0), f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "pending by reviewer",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(f.reviewer.Address, item(f, f.customer.Address, kyctypes.CustomerStatusPending))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsPending() {
+					f.t.Error("expected pending customer")
+				}
+
+				f.checkBalance(f.reviewer.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "approved by owner",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().AddCustomers(f.owner.Address, item(f, f.customer.Address, kyctypes.CustomerStatusApproved))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsVerified(f.Height()) {
+					f.t.Error("expected verified customer")
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address, item(f, f.customer.Address, kyctypes.CustomerStatusPending), item(f, f.other.Address, kyctypes.CustomerStatusPending))
+			},
+			check: func(f *fixture) {
+				f.mustCustomer(f.customer.Address)
+				f.mustCustomer(f.other.Address)
+				f.checkBalance(f.reviewer.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "approved by reviewer",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(f.reviewer.Address, item(f, f.customer.Address, kyctypes.CustomerStatusApproved))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "approved with quorum",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().AddCustomers(f.owner.Address, item(f, f.customer.Address, kyctypes.CustomerStatusApproved))
+			},
+			reason: "customer should be approved by 2 approvers",
+		},
+		{
+			name: "already exists",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(f.reviewer.Address, item(f, f.customer.Address, kyctypes.CustomerStatusPending))
+			},
+			reason: "customer already exists",
+		},
+		{
+			name: "not controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.other.Builder().AddCustomers(f.other.Address, item(f, f.customer.Address, kyctypes.CustomerStatusPending))
+			},
+			reason: "not contract account owner neither its controller",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.reviewer.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(f.reviewer.Address, item(f, f.customer.Address, kyctypes.CustomerStatusPending))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if _, found := f.Customer(f.contract, f.kycID, f.customer.Address); found {
+					f.t.Error("customer should not be added")
+				}
+			},
+		},
+		{
+			name: "rejected",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().AddCustomers(f.owner.Address, item(f, f.customer.Address, kyctypes.CustomerStatusRejected))
+			},
+			reason: "new customer can not be rejected",
+		},
+	})
+}
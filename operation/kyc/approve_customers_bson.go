@@ -0,0 +1,78 @@
+package kyc // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact ApproveCustomersFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  fact.Hint().String(),
+			"sender": fact.sender,
+			"items":  fact.items,
+			"hash":   fact.BaseFact.Hash().String(),
+			"token":  fact.BaseFact.Token(),
+		},
+	)
+}
+
+type ApproveCustomersFactBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Sender string   `bson:"sender"`
+	Items  bson.Raw `bson:"items"`
+}
+
+func (fact *ApproveCustomersFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ApproveCustomersFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf ApproveCustomersFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Items)
+}
+
+func (op ApproveCustomers) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *ApproveCustomers) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ApproveCustomers")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
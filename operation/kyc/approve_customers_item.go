@@ -0,0 +1,79 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ApproveCustomersItemHint = hint.MustNewHint("mitum-kyc-approve-customers-item-v0.0.1")
+
+type ApproveCustomersItem struct {
+	hint.BaseHinter
+	contract base.Address
+	kycID    currencytypes.ContractID
+	customer base.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewApproveCustomersItem(
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	customer base.Address,
+	currency currencytypes.CurrencyID,
+) ApproveCustomersItem {
+	return ApproveCustomersItem{
+		BaseHinter: hint.NewBaseHinter(ApproveCustomersItemHint),
+		contract:   contract,
+		kycID:      kycID,
+		customer:   customer,
+		currency:   currency,
+	}
+}
+
+func (it ApproveCustomersItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.kycID.Bytes(),
+		it.customer.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it ApproveCustomersItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.currency); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.customer) {
+		return util.ErrInvalid.Errorf("contract address is same with customer, %q", it.contract)
+	}
+
+	return nil
+}
+
+func (it ApproveCustomersItem) KYC() currencytypes.ContractID {
+	return it.kycID
+}
+
+func (it ApproveCustomersItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it ApproveCustomersItem) Customer() base.Address {
+	return it.customer
+}
+
+func (it ApproveCustomersItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it ApproveCustomersItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.customer
+
+	return ad
+}
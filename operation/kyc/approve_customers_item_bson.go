@@ -0,0 +1,44 @@
+package kyc // nolint:dupl
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it ApproveCustomersItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"contract": it.contract,
+			"kycid":    it.kycID,
+			"customer": it.customer,
+			"currency": it.currency,
+		},
+	)
+}
+
+type ApproveCustomersItemBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+	KYC      string `bson:"kycid"`
+	Customer string `bson:"customer"`
+	Currency string `bson:"currency"`
+}
+
+func (it *ApproveCustomersItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ApproveCustomersItem")
+
+	var uit ApproveCustomersItemBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uit.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, ht, uit.Contract, uit.KYC, uit.Customer, uit.Currency)
+}
@@ -0,0 +1,46 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ApproveCustomersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kycid"`
+	Customer base.Address             `json:"customer"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (it ApproveCustomersItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveCustomersItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		KYC:        it.kycID,
+		Customer:   it.customer,
+		Currency:   it.currency,
+	})
+}
+
+type ApproveCustomersItemJSONUnMarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	KYC      string    `json:"kycid"`
+	Customer string    `json:"customer"`
+	Currency string    `json:"currency"`
+}
+
+func (it *ApproveCustomersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveCustomersItem")
+
+	var uit ApproveCustomersItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Customer, uit.Currency)
+}
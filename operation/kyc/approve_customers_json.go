@@ -0,0 +1,66 @@
+package kyc
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ApproveCustomersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner base.Address           `json:"sender"`
+	Items []ApproveCustomersItem `json:"items"`
+}
+
+func (fact ApproveCustomersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveCustomersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type ApproveCustomersFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner string          `json:"sender"`
+	Items json.RawMessage `json:"items"`
+}
+
+func (fact *ApproveCustomersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveCustomersFact")
+
+	var uf ApproveCustomersFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Items)
+}
+
+type ApproveCustomersMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ApproveCustomers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveCustomersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveCustomers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveCustomers")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
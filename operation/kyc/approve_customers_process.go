@@ -0,0 +1,319 @@
+package kyc
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveCustomersItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveCustomersItemProcessor)
+	},
+}
+
+var approveCustomersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveCustomersProcessor)
+	},
+}
+
+func (ApproveCustomers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ApproveCustomersItemProcessor struct {
+	h      util.Hash
+	height base.Height
+	sender base.Address
+	item   ApproveCustomersItem
+}
+
+func (ipp *ApproveCustomersItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleApprover); err != nil {
+		return err
+	}
+
+	info, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
+	if err != nil {
+		return err
+	} else if !found {
+		return errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if !info.IsPending() {
+		return errors.Errorf("customer not pending, %s-%s-%s, %q", it.Contract(), it.KYC(), it.Customer(), info.Status())
+	}
+
+	if info.IsExpired(ipp.height) {
+		return errors.Errorf("customer record expired, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	// NOTE the approvals in the same block are merged without approving the
+	// customer, so the approver, who already approved, can approve again when
+	// the approvals reach the quorum.
+	switch counted, approved, err := ipp.countApprovals(getStateFunc); {
+	case err != nil:
+		return err
+	case approved && uint64(len(counted)) < policy.Quorum():
+		return errors.Errorf("customer already approved by sender, %s-%s-%s, %q", it.Contract(), it.KYC(), it.Customer(), ipp.sender)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *ApproveCustomersItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	info, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	counted, approved, err := ipp.countApprovals(getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if !approved {
+		counted = append(counted, ipp.sender)
+	}
+
+	// NOTE the approval is merged with the approvals by the other approvers
+	// in the same block.
+	if uint64(len(counted)) < policy.Quorum() {
+		return []base.StateMergeValue{
+			kycstate.NewCustomerApprovalStateMergeValue(
+				kycstate.StateKeyCustomerApprovals(it.Contract(), it.KYC(), it.Customer()),
+				ipp.sender,
+			),
+		}, nil
+	}
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()),
+			kycstate.NewCustomerStateValue(info.SetStatus(kyctypes.CustomerStatusApproved)),
+		),
+		kycstate.NewCustomerApprovalsStateMergeValue(
+			kycstate.StateKeyCustomerApprovals(it.Contract(), it.KYC(), it.Customer()),
+			kycstate.NewCustomerApprovalsStateValue([]base.Address{}),
+		),
+	}, nil
+}
+
+// countApprovals returns the approvals of customer which are counted for the
+// quorum and whether the sender is one of them. The approvals of the removed
+// controllers or the controllers which lost approver role are not counted.
+func (ipp *ApproveCustomersItemProcessor) countApprovals(getStateFunc base.GetStateFunc) ([]base.Address, bool, error) {
+	it := ipp.item
+
+	approvers, err := kycstate.LoadCustomerApprovals(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var approved bool
+	var counted []base.Address // nolint:prealloc
+
+	for _, a := range approvers {
+		if err := checkControllerRoles(it.Contract(), it.KYC(), a, getStateFunc, kyctypes.RoleApprover); err != nil {
+			continue
+		}
+
+		if a.Equal(ipp.sender) {
+			approved = true
+		}
+
+		counted = append(counted, a)
+	}
+
+	return counted, approved, nil
+}
+
+func (ipp *ApproveCustomersItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.height = 0
+	ipp.sender = nil
+	ipp.item = ApproveCustomersItem{}
+
+	approveCustomersItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type ApproveCustomersProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewApproveCustomersProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveCustomersProcessor")
+
+		nopp := approveCustomersProcessorPool.Get()
+		opp, ok := nopp.(*ApproveCustomersProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected ApproveCustomersProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveCustomersProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess ApproveCustomers")
+
+	fact, ok := op.Fact().(ApproveCustomersFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected ApproveCustomersFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot approve customers, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := approveCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*ApproveCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected ApproveCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.height = opp.Height()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess ApproveCustomersItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveCustomersProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process ApproveCustomers")
+
+	fact, ok := op.Fact().(ApproveCustomersFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected ApproveCustomersFact, not %T", op.Fact()))
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := approveCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*ApproveCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected ApproveCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.height = opp.Height()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process ApproveCustomersItem: %w", err), nil
+		}
+
+		sts = append(sts, st...)
+
+		ipc.Close()
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *ApproveCustomersProcessor) Close() error {
+	approveCustomersProcessorPool.Put(opp)
+
+	return nil
+}
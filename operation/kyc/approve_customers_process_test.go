@@ -0,0 +1,194 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestApproveCustomersProcess(t *testing.T) {
+	item := func(f *fixture, customer base.Address) kyc.ApproveCustomersItem {
+		return kyc.NewApproveCustomersItem(f.contract, f.kycID, customer, f.currency)
+	}
+
+	approvals := func(f *fixture, customer base.Address) []base.Address {
+		ap, err := kycstate.LoadCustomerApprovals(f.contract, f.kycID, customer, f.GetStateFunc)
+		if err != nil {
+			f.t.Fatalf("failed to load approvals: %+v", err)
+		}
+
+		return ap
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "approve",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsApproved() {
+					f.t.Error("customer not approved")
+				}
+
+				f.checkBalance(f.approver.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "approval under quorum",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsPending() {
+					f.t.Error("customer should be pending")
+				}
+
+				if ap := approvals(f, f.customer.Address); len(ap) != 1 || !ap[0].Equal(f.approver.Address) {
+					f.t.Errorf("expected approvals, [%s], but %v", f.approver.Address, ap)
+				}
+			},
+		},
+		{
+			name: "approvals reach quorum",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+				f.mustProcess(f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver2.Builder().ApproveCustomers(f.approver2.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsApproved() {
+					f.t.Error("customer not approved")
+				}
+
+				if ap := approvals(f, f.customer.Address); len(ap) != 0 {
+					f.t.Errorf("expected empty approvals, but %v", ap)
+				}
+			},
+		},
+		{
+			name: "already approved by sender",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+				f.mustProcess(f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer already approved by sender",
+		},
+		{
+			name: "approvals in the same block",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+				f.mustProcessBlock(
+					func() (base.Operation, error) {
+						return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+					},
+					func() (base.Operation, error) {
+						return f.approver2.Builder().ApproveCustomers(f.approver2.Address, item(f, f.customer.Address))
+					},
+				)
+
+				if ap := approvals(f, f.customer.Address); len(ap) != 2 {
+					f.t.Errorf("expected 2 approvals, but %v", ap)
+				}
+
+				if !f.mustCustomer(f.customer.Address).IsPending() {
+					f.t.Error("customer should be pending")
+				}
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsApproved() {
+					f.t.Error("customer not approved")
+				}
+
+				if ap := approvals(f, f.customer.Address); len(ap) != 0 {
+					f.t.Errorf("expected empty approvals, but %v", ap)
+				}
+			},
+		},
+		{
+			name: "already approved",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer not pending",
+		},
+		{
+			name: "rejected",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusRejected, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer not pending",
+		},
+		{
+			name: "expired",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, uint64(f.Height())))
+				f.SetHeight(f.Height() + 1)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer record expired",
+		},
+		{
+			name: "unknown customer",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer not found",
+		},
+		{
+			name: "not approver",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().ApproveCustomers(f.reviewer.Address, item(f, f.customer.Address))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+				f.SetBalance(f.approver.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().ApproveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsPending() {
+					f.t.Error("customer should be pending")
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,90 @@
+package kyc
+
+import (
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkControllerRoles checks sender is the owner of contract or the
+// controller of kyc service with every one of roles. The owner is allowed
+// everything.
+func checkControllerRoles(
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	sender base.Address,
+	getStateFunc base.GetStateFunc,
+	roles ...kyctypes.Role,
+) error {
+	policy, err := kycstate.ExistsPolicy(contract, kycID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(contract), "key of contract account", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return err
+	}
+
+	if ca.Owner().Equal(sender) {
+		return nil
+	}
+
+	c, found := policy.Controller(sender)
+	if !found {
+		return errors.Errorf("not contract account owner neither its controller, %s-%s", contract, kycID)
+	}
+
+	if !c.HasRoles(roles...) {
+		return errors.Errorf("controller does not have roles, %v, %s-%s, %q", roles, contract, kycID, sender)
+	}
+
+	return nil
+}
+
+// resetCustomerApprovals returns the state merge value which empties the
+// approvals of customer; nil when nobody approved it.
+func resetCustomerApprovals(
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	customer base.Address,
+	getStateFunc base.GetStateFunc,
+) (base.StateMergeValue, error) {
+	approvers, err := kycstate.LoadCustomerApprovals(contract, kycID, customer, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(approvers) < 1 {
+		return nil, nil
+	}
+
+	return kycstate.NewCustomerApprovalsStateMergeValue(
+		kycstate.StateKeyCustomerApprovals(contract, kycID, customer),
+		kycstate.NewCustomerApprovalsStateValue([]base.Address{}),
+	), nil
+}
+
+// checkDirectApproval checks customer can be approved without
+// ApproveCustomers, which is only when the quorum of kyc policy is 1.
+func checkDirectApproval(contract base.Address, kycID currencytypes.ContractID, getStateFunc base.GetStateFunc) error {
+	policy, err := kycstate.ExistsPolicy(contract, kycID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if q := policy.Quorum(); q > 1 {
+		return errors.Errorf("customer should be approved by %d approvers, %s-%s", q, contract, kycID)
+	}
+
+	return nil
+}
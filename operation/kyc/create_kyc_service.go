@@ -3,6 +3,7 @@ package kyc
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -10,8 +11,17 @@ import (
 )
 
 var (
-	CreateKYCServiceFactHint = hint.MustNewHint("mitum-kyc-create-key-service-operation-fact-v0.0.1")
-	CreateKYCServiceHint     = hint.MustNewHint("mitum-kyc-create-key-service-operation-v0.0.1")
+	CreateKYCServiceFactHint = hint.MustNewHint("mitum-kyc-create-key-service-operation-fact-v0.0.3")
+	CreateKYCServiceHint     = hint.MustNewHint("mitum-kyc-create-key-service-operation-v0.0.3")
+	// LegacyCreateKYCServiceFactHint is the fact with controllers without
+	// roles; they are decoded with kyctypes.LegacyControllerRoles and quorum 1.
+	LegacyCreateKYCServiceFactHint = hint.MustNewHint("mitum-kyc-create-key-service-operation-fact-v0.0.1")
+	LegacyCreateKYCServiceHint     = hint.MustNewHint("mitum-kyc-create-key-service-operation-v0.0.1")
+	// LegacyRolesCreateKYCServiceFactHint is the fact without quorum; it is
+	// decoded with quorum 1. The legacy facts keep their hints and bytes, so
+	// the operations of stored blocks have the same hash.
+	LegacyRolesCreateKYCServiceFactHint = hint.MustNewHint("mitum-kyc-create-key-service-operation-fact-v0.0.2")
+	LegacyRolesCreateKYCServiceHint     = hint.MustNewHint("mitum-kyc-create-key-service-operation-v0.0.2")
 )
 
 type CreateKYCServiceFact struct {
@@ -19,7 +29,8 @@ type CreateKYCServiceFact struct {
 	sender      base.Address
 	contract    base.Address             // contract account
 	kycID       currencytypes.ContractID // kyc id
-	controllers []base.Address
+	controllers []kyctypes.Controller
+	quorum      uint64
 	currency    currencytypes.CurrencyID
 }
 
@@ -28,7 +39,8 @@ func NewCreateKYCServiceFact(
 	sender base.Address,
 	contract base.Address,
 	kycID currencytypes.ContractID,
-	controllers []base.Address,
+	controllers []kyctypes.Controller,
+	quorum uint64,
 	currency currencytypes.CurrencyID,
 ) CreateKYCServiceFact {
 	bf := base.NewBaseFact(CreateKYCServiceFactHint, token)
@@ -38,6 +50,7 @@ func NewCreateKYCServiceFact(
 		contract:    contract,
 		kycID:       kycID,
 		controllers: controllers,
+		quorum:      quorum,
 		currency:    currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -58,6 +71,15 @@ func (fact CreateKYCServiceFact) Bytes() []byte {
 
 	for i, con := range fact.controllers {
 		bc[i] = con.Bytes()
+
+		if fact.Hint().Equal(LegacyCreateKYCServiceFactHint) {
+			bc[i] = con.Address().Bytes()
+		}
+	}
+
+	var qb []byte
+	if !fact.isLegacy() {
+		qb = util.Uint64ToBytes(fact.quorum)
 	}
 
 	return util.ConcatBytesSlice(
@@ -66,6 +88,7 @@ func (fact CreateKYCServiceFact) Bytes() []byte {
 		fact.contract.Bytes(),
 		fact.kycID.Bytes(),
 		util.ConcatBytesSlice(bc...),
+		qb,
 		fact.currency.Bytes(),
 	)
 }
@@ -93,20 +116,37 @@ func (fact CreateKYCServiceFact) IsValid(b []byte) error {
 			return err
 		}
 
-		if con.Equal(fact.sender) {
+		if con.Address().Equal(fact.sender) {
 			return util.ErrInvalid.Errorf("controller address is same with sender, %q", fact.sender)
 		}
 
-		if _, found := founds[con.String()]; found {
-			return util.ErrInvalid.Errorf("duplicate controller found, %q", con)
+		if _, found := founds[con.Address().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate controller found, %q", con.Address())
 		}
 
-		founds[con.String()] = struct{}{}
+		founds[con.Address().String()] = struct{}{}
+	}
+
+	if err := kyctypes.NewPolicy(fact.controllers, fact.quorum).IsValid(nil); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+func (fact CreateKYCServiceFact) isLegacy() bool {
+	return fact.Hint().Equal(LegacyCreateKYCServiceFactHint) || fact.Hint().Equal(LegacyRolesCreateKYCServiceFactHint)
+}
+
+func (fact CreateKYCServiceFact) controllerAddresses() []base.Address {
+	as := make([]base.Address, len(fact.controllers))
+	for i, con := range fact.controllers {
+		as[i] = con.Address()
+	}
+
+	return as
+}
+
 func (fact CreateKYCServiceFact) Token() base.Token {
 	return fact.BaseFact.Token()
 }
@@ -123,8 +163,12 @@ func (fact CreateKYCServiceFact) KYC() currencytypes.ContractID {
 	return fact.kycID
 }
 
-func (fact CreateKYCServiceFact) Controllers() []base.Address {
-	return append([]base.Address{}, fact.controllers...)
+func (fact CreateKYCServiceFact) Controllers() []kyctypes.Controller {
+	return append([]kyctypes.Controller{}, fact.controllers...)
+}
+
+func (fact CreateKYCServiceFact) Quorum() uint64 {
+	return fact.quorum
 }
 
 func (fact CreateKYCServiceFact) Currency() currencytypes.CurrencyID {
@@ -138,7 +182,7 @@ func (fact CreateKYCServiceFact) Addresses() ([]base.Address, error) {
 	as[1] = fact.contract
 
 	for i, con := range fact.controllers {
-		as[i+2] = con
+		as[i+2] = con.Address()
 	}
 
 	return as, nil
@@ -11,6 +11,26 @@ import (
 )
 
 func (fact CreateKYCServiceFact) MarshalBSON() ([]byte, error) {
+	if fact.isLegacy() {
+		var controllers interface{} = fact.controllers
+		if fact.Hint().Equal(LegacyCreateKYCServiceFactHint) {
+			controllers = fact.controllerAddresses()
+		}
+
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":       fact.Hint().String(),
+				"sender":      fact.sender,
+				"contract":    fact.contract,
+				"kycid":       fact.kycID,
+				"controllers": controllers,
+				"currency":    fact.currency,
+				"hash":        fact.BaseFact.Hash().String(),
+				"token":       fact.BaseFact.Token(),
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":       fact.Hint().String(),
@@ -18,6 +38,7 @@ func (fact CreateKYCServiceFact) MarshalBSON() ([]byte, error) {
 			"contract":    fact.contract,
 			"kycid":       fact.kycID,
 			"controllers": fact.controllers,
+			"quorum":      fact.quorum,
 			"currency":    fact.currency,
 			"hash":        fact.BaseFact.Hash().String(),
 			"token":       fact.BaseFact.Token(),
@@ -26,12 +47,13 @@ func (fact CreateKYCServiceFact) MarshalBSON() ([]byte, error) {
 }
 
 type CreateKYCServiceFactBSONUnmarshaler struct {
-	Hint        string   `bson:"_hint"`
-	Sender      string   `bson:"sender"`
-	Contract    string   `bson:"contract"`
-	KYCID       string   `bson:"kycid"`
-	Controllers []string `bson:"controllers"`
-	Currency    string   `bson:"currency"`
+	Hint        string        `bson:"_hint"`
+	Sender      string        `bson:"sender"`
+	Contract    string        `bson:"contract"`
+	KYCID       string        `bson:"kycid"`
+	Controllers bson.RawValue `bson:"controllers"`
+	Quorum      uint64        `bson:"quorum"`
+	Currency    string        `bson:"currency"`
 }
 
 func (fact *CreateKYCServiceFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -55,9 +77,15 @@ func (fact *CreateKYCServiceFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) err
 	if err != nil {
 		return e.Wrap(err)
 	}
-	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	return fact.unpack(enc, uf.Sender, uf.Contract, uf.KYCID, uf.Controllers, uf.Currency)
+	var cons []string
+	if ht.Equal(LegacyCreateKYCServiceFactHint) {
+		if err := uf.Controllers.Unmarshal(&cons); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fact.unpack(enc, ht, uf.Sender, uf.Contract, uf.KYCID, uf.Controllers.Value, cons, uf.Quorum, uf.Currency)
 }
 
 func (op CreateKYCService) MarshalBSON() ([]byte, error) {
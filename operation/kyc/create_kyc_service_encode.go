@@ -2,12 +2,17 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
-func (fact *CreateKYCServiceFact) unpack(enc encoder.Encoder, sa, ca, kycid string, cons []string, cid string) error {
+func (fact *CreateKYCServiceFact) unpack(
+	enc encoder.Encoder, ht hint.Hint, sa, ca, kycid string, bcs []byte, cons []string, quorum uint64, cid string,
+) error {
 	e := util.StringError("failed to unmarshal CreateKYCServiceFact")
 
 	switch a, err := base.DecodeAddress(sa, enc); {
@@ -24,18 +29,45 @@ func (fact *CreateKYCServiceFact) unpack(enc encoder.Encoder, sa, ca, kycid stri
 		fact.contract = a
 	}
 
-	controllers := make([]base.Address, len(cons))
-	for i, con := range cons {
-		if a, err := base.DecodeAddress(con, enc); err != nil {
-			return e.Wrap(err)
-		} else {
-			controllers[i] = a
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+	fact.kycID = currencytypes.ContractID(kycid)
+	fact.quorum = quorum
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	if fact.isLegacy() {
+		fact.quorum = 1
+	}
+
+	if ht.Equal(LegacyCreateKYCServiceFactHint) {
+		controllers := make([]kyctypes.Controller, len(cons))
+		for i := range cons {
+			a, err := base.DecodeAddress(cons[i], enc)
+			if err != nil {
+				return e.Wrap(err)
+			}
+
+			controllers[i] = kyctypes.NewController(a, kyctypes.LegacyControllerRoles)
 		}
+		fact.controllers = controllers
+
+		return nil
 	}
-	fact.controllers = controllers
 
-	fact.kycID = currencytypes.ContractID(kycid)
-	fact.currency = currencytypes.CurrencyID(cid)
+	hcs, err := enc.DecodeSlice(bcs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	controllers := make([]kyctypes.Controller, len(hcs))
+	for i := range hcs {
+		c, ok := hcs[i].(kyctypes.Controller)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected Controller, not %T", hcs[i]))
+		}
+
+		controllers[i] = c
+	}
+	fact.controllers = controllers
 
 	return nil
 }
@@ -1,14 +1,28 @@
 package kyc
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
 type CreateKYCServiceFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner       base.Address             `json:"sender"`
+	Contract    base.Address             `json:"contract"`
+	KYCID       currencytypes.ContractID `json:"kycid"`
+	Controllers []kyctypes.Controller    `json:"controllers"`
+	Quorum      uint64                   `json:"quorum"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
+}
+
+type LegacyCreateKYCServiceFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
 	Owner       base.Address             `json:"sender"`
 	Contract    base.Address             `json:"contract"`
@@ -17,24 +31,57 @@ type CreateKYCServiceFactJSONMarshaler struct {
 	Currency    currencytypes.CurrencyID `json:"currency"`
 }
 
+type LegacyRolesCreateKYCServiceFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner       base.Address             `json:"sender"`
+	Contract    base.Address             `json:"contract"`
+	KYCID       currencytypes.ContractID `json:"kycid"`
+	Controllers []kyctypes.Controller    `json:"controllers"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
+}
+
 func (fact CreateKYCServiceFact) MarshalJSON() ([]byte, error) {
+	switch {
+	case fact.Hint().Equal(LegacyCreateKYCServiceFactHint):
+		return util.MarshalJSON(LegacyCreateKYCServiceFactJSONMarshaler{
+			BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+			Owner:                 fact.sender,
+			Contract:              fact.contract,
+			KYCID:                 fact.kycID,
+			Controllers:           fact.controllerAddresses(),
+			Currency:              fact.currency,
+		})
+	case fact.Hint().Equal(LegacyRolesCreateKYCServiceFactHint):
+		return util.MarshalJSON(LegacyRolesCreateKYCServiceFactJSONMarshaler{
+			BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+			Owner:                 fact.sender,
+			Contract:              fact.contract,
+			KYCID:                 fact.kycID,
+			Controllers:           fact.controllers,
+			Currency:              fact.currency,
+		})
+	}
+
 	return util.MarshalJSON(CreateKYCServiceFactJSONMarshaler{
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Owner:                 fact.sender,
 		Contract:              fact.contract,
 		KYCID:                 fact.kycID,
 		Controllers:           fact.controllers,
+		Quorum:                fact.quorum,
 		Currency:              fact.currency,
 	})
 }
 
 type CreateKYCServiceFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner       string   `json:"sender"`
-	Contract    string   `json:"contract"`
-	KYCID       string   `json:"kycid"`
-	Controllers []string `json:"controllers"`
-	Currency    string   `json:"currency"`
+	Hint        hint.Hint       `json:"_hint"`
+	Owner       string          `json:"sender"`
+	Contract    string          `json:"contract"`
+	KYCID       string          `json:"kycid"`
+	Controllers json.RawMessage `json:"controllers"`
+	Quorum      uint64          `json:"quorum"`
+	Currency    string          `json:"currency"`
 }
 
 func (fact *CreateKYCServiceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -47,7 +94,14 @@ func (fact *CreateKYCServiceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) err
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	return fact.unpack(enc, uf.Owner, uf.Contract, uf.KYCID, uf.Controllers, uf.Currency)
+	var cons []string
+	if uf.Hint.Equal(LegacyCreateKYCServiceFactHint) {
+		if err := enc.Unmarshal(uf.Controllers, &cons); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fact.unpack(enc, uf.Hint, uf.Owner, uf.Contract, uf.KYCID, uf.Controllers, cons, uf.Quorum, uf.Currency)
 }
 
 type CreateKYCServiceMarshaler struct {
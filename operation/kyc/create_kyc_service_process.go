@@ -4,7 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
@@ -77,11 +76,15 @@ func (opp *CreateKYCServiceProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
 
-	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), "key of contract account", getStateFunc)
-	if err != nil {
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("contract account cannot create kyc service, %q: %w", fact.Sender(), err), nil
 	}
 
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", fact.Contract(), err), nil
+	}
+
 	ca, err := extensioncurrency.StateContractAccountValue(st)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", fact.Contract(), err), nil
@@ -113,7 +116,7 @@ func (opp *CreateKYCServiceProcessor) Process(
 		return nil, nil, e.Wrap(errors.Errorf("expected CreateKYCServiceFact, not %T", op.Fact()))
 	}
 
-	policy := kyctypes.NewPolicy(fact.Controllers())
+	policy := kyctypes.NewPolicy(fact.Controllers(), fact.Quorum())
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid kyc policy, %s-%s: %w", fact.Contract(), fact.KYC(), err), nil
 	}
@@ -123,46 +126,24 @@ func (opp *CreateKYCServiceProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("invalid kyc design, %s-%s: %w", fact.Contract(), fact.KYC(), err), nil
 	}
 
-	sts := make([]base.StateMergeValue, 2)
+	sts := make([]base.StateMergeValue, 1)
 
 	sts[0] = currencystate.NewStateMergeValue(
 		kycstate.StateKeyDesign(fact.Contract(), fact.KYC()),
 		kycstate.NewDesignStateValue(design),
 	)
 
-	currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
-	}
-
-	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	st, err := currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.Sender(), err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
-	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
-
-	switch b, err := currency.StateBalanceValue(st); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
-	case b.Big().Compare(fee) < 0:
-		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
-	}
-
-	v, ok := sb.Value().(currency.BalanceStateValue)
-	if !ok {
-		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
-	}
-	sts[1] = currencystate.NewStateMergeValue(
-		sb.Key(),
-		currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
-	)
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *CreateKYCServiceProcessor) Close() error {
@@ -0,0 +1,107 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestCreateKYCServiceProcess(t *testing.T) {
+	controllers := func(f *fixture) []kyctypes.Controller {
+		return []kyctypes.Controller{
+			kyctypes.NewController(f.reviewer.Address, []kyctypes.Role{kyctypes.RoleReviewer}),
+			kyctypes.NewController(f.approver.Address, []kyctypes.Role{kyctypes.RoleApprover}),
+		}
+	}
+
+	create := func(f *fixture, sender test.Account, contract base.Address) (base.Operation, error) {
+		return sender.Builder().CreateKYCService(sender.Address, contract, "NEW", controllers(f), 2, f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "create",
+			op: func(f *fixture) (base.Operation, error) {
+				return create(f, f.owner, f.contract)
+			},
+			check: func(f *fixture) {
+				policy := f.KYC(f.contract, "NEW")
+
+				if q := policy.Quorum(); q != 2 {
+					f.t.Errorf("expected quorum 2, but %d", q)
+				}
+
+				if n := len(policy.Controllers()); n != 2 {
+					f.t.Errorf("expected 2 controllers, but %d", n)
+				}
+
+				f.checkBalance(f.owner.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "created service adds customers",
+			prepare: func(f *fixture) {
+				f.mustProcess(create(f, f.owner, f.contract))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address,
+					kyc.NewAddCustomersItem(f.contract, "NEW", f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0), f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				if _, found := f.Customer(f.contract, "NEW", f.customer.Address); !found {
+					f.t.Error("customer not added")
+				}
+			},
+		},
+		{
+			name: "already exists",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().CreateKYCService(f.owner.Address, f.contract, f.kycID, controllers(f), 1, f.currency)
+			},
+			reason: "kyc service already exists",
+		},
+		{
+			name: "not owner",
+			op: func(f *fixture) (base.Operation, error) {
+				return create(f, f.other, f.contract)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name: "not contract account",
+			op: func(f *fixture) (base.Operation, error) {
+				return create(f, f.owner, f.customer.Address)
+			},
+			reason: "contract account not found",
+		},
+		{
+			name: "contract account sender",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().CreateKYCService(
+					f.NewContractAccount(f.owner.Address), f.contract, "NEW", controllers(f), 1, f.currency)
+			},
+			reason: "contract account cannot create kyc service",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.owner.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return create(f, f.owner, f.contract)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if _, found := f.State(kycstate.StateKeyDesign(f.contract, "NEW")); found {
+					f.t.Error("kyc design should not be stored")
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/fee"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// OperationFees returns the fees of the currency policies of kyc operation of
+// fact, which the processor of operation pays. It returns nil for the fact of
+// other operation.
+func OperationFees(fact base.Fact, getStateFunc base.GetStateFunc) (*fee.Fees, error) {
+	var items []KYCItem
+
+	switch t := fact.(type) {
+	case AddControllersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case RemoveControllersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case AddCustomersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case UpdateCustomersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case RenewCustomersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case RemoveCustomersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case ApproveCustomersFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case CreateKYCServiceFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	case MigrateKYCServiceFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	default:
+		return nil, nil
+	}
+
+	return calculateKYCItemsFee(getStateFunc, items)
+}
+
+// calculateKYCItemsFee collects the fees of the currency policies of items.
+func calculateKYCItemsFee(getStateFunc base.GetStateFunc, items []KYCItem) (*fee.Fees, error) {
+	fees := fee.NewFees()
+
+	for _, item := range items {
+		if err := fees.AddCurrencyFee(item.Currency(), getStateFunc); err != nil {
+			return nil, err
+		}
+	}
+
+	return fees, nil
+}
+
+func currencyFee(cid currencytypes.CurrencyID, getStateFunc base.GetStateFunc) (*fee.Fees, error) {
+	fees := fee.NewFees()
+	if err := fees.AddCurrencyFee(cid, getStateFunc); err != nil {
+		return nil, err
+	}
+
+	return fees, nil
+}
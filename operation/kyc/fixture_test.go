@@ -0,0 +1,190 @@
+package kyc_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+var (
+	fee     = common.NewBig(10)
+	balance = common.NewBig(1000)
+)
+
+// fixture is the kyc service, kycID of contract; every controller has one
+// role and the quorum is 1.
+type fixture struct {
+	*test.States
+	t         *testing.T
+	currency  currencytypes.CurrencyID
+	feeer     test.Account
+	owner     test.Account
+	admin     test.Account
+	reviewer  test.Account
+	approver  test.Account
+	approver2 test.Account
+	customer  test.Account
+	other     test.Account
+	poor      test.Account
+	contract  base.Address
+	kycID     currencytypes.ContractID
+}
+
+func newFixture(t *testing.T) *fixture {
+	s := test.NewStates(t)
+
+	f := &fixture{
+		States:   s,
+		t:        t,
+		currency: currencytypes.CurrencyID("MCC"),
+		kycID:    currencytypes.ContractID("KYC"),
+	}
+
+	am := currencytypes.NewAmount(balance, f.currency)
+
+	f.feeer = s.NewAccount(currencytypes.NewAmount(common.ZeroBig, f.currency))
+	f.owner = s.NewAccount(am)
+	f.admin = s.NewAccount(am)
+	f.reviewer = s.NewAccount(am)
+	f.approver = s.NewAccount(am)
+	f.approver2 = s.NewAccount(am)
+	f.customer = s.NewAccount(am)
+	f.other = s.NewAccount(am)
+	f.poor = s.NewAccount(currencytypes.NewAmount(common.NewBig(5), f.currency))
+	f.contract = s.NewContractAccount(f.owner.Address)
+
+	s.SetCurrency(f.currency, f.feeer.Address, fee)
+	f.setQuorum(1)
+
+	return f
+}
+
+// setQuorum stores the design of kyc service with quorum.
+func (f *fixture) setQuorum(quorum uint64) {
+	f.SetKYC(f.contract, kyctypes.NewDesign(f.kycID, kyctypes.NewPolicy([]kyctypes.Controller{
+		kyctypes.NewController(f.admin.Address, []kyctypes.Role{kyctypes.RoleAdmin}),
+		kyctypes.NewController(f.reviewer.Address, []kyctypes.Role{kyctypes.RoleReviewer}),
+		kyctypes.NewController(f.approver.Address, []kyctypes.Role{kyctypes.RoleApprover}),
+		kyctypes.NewController(f.approver2.Address, []kyctypes.Role{kyctypes.RoleApprover}),
+	}, quorum)))
+}
+
+func (f *fixture) amount(n int64) currencytypes.Amount {
+	return currencytypes.NewAmount(common.NewBig(n), f.currency)
+}
+
+// info is the record of customer in "KR" which expires at expiry; zero expiry
+// never expires.
+func (f *fixture) info(status kyctypes.CustomerStatus, expiry uint64) kyctypes.CustomerInfo {
+	return kyctypes.NewCustomerInfo(status, "KR", "accredited", "individual", expiry, "KYCHASH")
+}
+
+// setCustomer stores the record of customer without operation.
+func (f *fixture) setCustomer(customer base.Address, info kyctypes.CustomerInfo) {
+	f.SetCustomer(f.contract, f.kycID, customer, info)
+}
+
+// mustCustomer returns the record of customer; the test fails when it is not
+// found.
+func (f *fixture) mustCustomer(customer base.Address) kyctypes.CustomerInfo {
+	f.t.Helper()
+
+	info, found := f.Customer(f.contract, f.kycID, customer)
+	if !found {
+		f.t.Fatalf("customer not found, %q", customer)
+	}
+
+	return info
+}
+
+func (f *fixture) mustProcess(op base.Operation, err error) {
+	f.t.Helper()
+
+	if err != nil {
+		f.t.Fatalf("failed to build operation: %+v", err)
+	}
+
+	if reason := f.Process(op); reason != nil {
+		f.t.Fatalf("failed to process operation, %T: %v", op, reason)
+	}
+}
+
+// mustProcessBlock processes the operations built by builds in one block.
+func (f *fixture) mustProcessBlock(builds ...func() (base.Operation, error)) {
+	f.t.Helper()
+
+	ops := make([]base.Operation, len(builds))
+	for i := range builds {
+		op, err := builds[i]()
+		if err != nil {
+			f.t.Fatalf("failed to build operation: %+v", err)
+		}
+
+		ops[i] = op
+	}
+
+	for i, reason := range f.ProcessBlock(ops...) {
+		if reason != nil {
+			f.t.Fatalf("failed to process operation, %T: %v", ops[i], reason)
+		}
+	}
+}
+
+func (f *fixture) checkBalance(addr base.Address, expected common.Big) {
+	f.t.Helper()
+
+	if b := f.Balance(addr, f.currency); !b.Equal(expected) {
+		f.t.Errorf("balance of %q: expected %s, but %s", addr, expected, b)
+	}
+}
+
+// processCase is the operation processed against the new fixture; empty
+// reason means the operation should be processed.
+type processCase struct {
+	name    string
+	prepare func(*fixture)
+	op      func(*fixture) (base.Operation, error)
+	reason  string
+	check   func(*fixture)
+}
+
+func runProcessCases(t *testing.T, cases []processCase) {
+	t.Helper()
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func(t *testing.T) {
+			f := newFixture(t)
+
+			if c.prepare != nil {
+				c.prepare(f)
+			}
+
+			op, err := c.op(f)
+			if err != nil {
+				t.Fatalf("failed to build operation: %+v", err)
+			}
+
+			reason := f.Process(op)
+
+			switch {
+			case len(c.reason) < 1 && reason != nil:
+				t.Fatalf("unexpected reason: %v", reason)
+			case len(c.reason) > 0 && reason == nil:
+				t.Fatalf("expected reason, %q, but processed", c.reason)
+			case len(c.reason) > 0 && !strings.Contains(reason.Error(), c.reason):
+				t.Fatalf("expected reason, %q, but %q", c.reason, reason.Error())
+			}
+
+			if c.check != nil {
+				c.check(f)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	MigrateKYCServiceFactHint = hint.MustNewHint("mitum-kyc-migrate-kyc-service-operation-fact-v0.0.1")
+	MigrateKYCServiceHint     = hint.MustNewHint("mitum-kyc-migrate-kyc-service-operation-v0.0.1")
+)
+
+var MaxMigrateCustomers = uint(networktypes.MaxItemsLimit)
+
+// MigrateKYCServiceFact moves kyc service from contract to newContract with
+// the records of customers. The states of kyc service can not be iterated, so
+// the records of customers which are not listed are left behind.
+type MigrateKYCServiceFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address             // contract account
+	kycID       currencytypes.ContractID // kyc id
+	newContract base.Address             // new contract account
+	customers   []base.Address
+	currency    currencytypes.CurrencyID // fee
+}
+
+func NewMigrateKYCServiceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	newContract base.Address,
+	customers []base.Address,
+	currency currencytypes.CurrencyID,
+) MigrateKYCServiceFact {
+	bf := base.NewBaseFact(MigrateKYCServiceFactHint, token)
+	fact := MigrateKYCServiceFact{
+		BaseFact:    bf,
+		sender:      sender,
+		contract:    contract,
+		kycID:       kycID,
+		newContract: newContract,
+		customers:   customers,
+		currency:    currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MigrateKYCServiceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MigrateKYCServiceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MigrateKYCServiceFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.customers))
+	for i := range fact.customers {
+		bs[i] = fact.customers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.kycID.Bytes(),
+		fact.newContract.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact MigrateKYCServiceFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.kycID, fact.newContract, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.sender.Equal(fact.newContract) {
+		return util.ErrInvalid.Errorf("new contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.contract.Equal(fact.newContract) {
+		return util.ErrInvalid.Errorf("new contract address is same with contract, %q", fact.contract)
+	}
+
+	if n := len(fact.customers); n > int(MaxMigrateCustomers) {
+		return util.ErrInvalid.Errorf("customers, %d over max, %d", n, MaxMigrateCustomers)
+	}
+
+	founds := map[string]struct{}{}
+	for _, c := range fact.customers {
+		if err := c.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[c.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate customer found, %q", c)
+		}
+
+		founds[c.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact MigrateKYCServiceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MigrateKYCServiceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact MigrateKYCServiceFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact MigrateKYCServiceFact) KYC() currencytypes.ContractID {
+	return fact.kycID
+}
+
+func (fact MigrateKYCServiceFact) NewContract() base.Address {
+	return fact.newContract
+}
+
+func (fact MigrateKYCServiceFact) Customers() []base.Address {
+	return fact.customers
+}
+
+func (fact MigrateKYCServiceFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact MigrateKYCServiceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3+len(fact.customers))
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.newContract
+
+	copy(as[3:], fact.customers)
+
+	return as, nil
+}
+
+type MigrateKYCService struct {
+	common.BaseOperation
+}
+
+func NewMigrateKYCService(fact MigrateKYCServiceFact) (MigrateKYCService, error) {
+	return MigrateKYCService{BaseOperation: common.NewBaseOperation(MigrateKYCServiceHint, fact)}, nil
+}
+
+func (op *MigrateKYCService) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *MigrateKYCServiceFact) unpack(enc encoder.Encoder, sa, ca, kycid, nca string, cs []string, cid string) error {
+	e := util.StringError("failed to unmarshal MigrateKYCServiceFact")
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(nca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.newContract = a
+	}
+
+	customers := make([]base.Address, len(cs))
+	for i := range cs {
+		a, err := base.DecodeAddress(cs[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		customers[i] = a
+	}
+	fact.customers = customers
+
+	fact.kycID = currencytypes.ContractID(kycid)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type MigrateKYCServiceFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner       base.Address             `json:"sender"`
+	Contract    base.Address             `json:"contract"`
+	KYCID       currencytypes.ContractID `json:"kycid"`
+	NewContract base.Address             `json:"new_contract"`
+	Customers   []base.Address           `json:"customers"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact MigrateKYCServiceFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateKYCServiceFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		KYCID:                 fact.kycID,
+		NewContract:           fact.newContract,
+		Customers:             fact.customers,
+		Currency:              fact.currency,
+	})
+}
+
+type MigrateKYCServiceFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner       string   `json:"sender"`
+	Contract    string   `json:"contract"`
+	KYCID       string   `json:"kycid"`
+	NewContract string   `json:"new_contract"`
+	Customers   []string `json:"customers"`
+	Currency    string   `json:"currency"`
+}
+
+func (fact *MigrateKYCServiceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigrateKYCServiceFact")
+
+	var uf MigrateKYCServiceFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.KYCID, uf.NewContract, uf.Customers, uf.Currency)
+}
+
+type MigrateKYCServiceMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op MigrateKYCService) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateKYCServiceMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *MigrateKYCService) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigrateKYCService")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package kyc
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var migrateKYCServiceProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MigrateKYCServiceProcessor)
+	},
+}
+
+func (MigrateKYCService) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type MigrateKYCServiceProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewMigrateKYCServiceProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new MigrateKYCServiceProcessor")
+
+		nopp := migrateKYCServiceProcessorPool.Get()
+		opp, ok := nopp.(*MigrateKYCServiceProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected MigrateKYCServiceProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *MigrateKYCServiceProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess MigrateKYCService")
+
+	fact, ok := op.Fact().(MigrateKYCServiceFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("not MigrateKYCServiceFact, %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Customers())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot migrate kyc service, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, ca := range []base.Address{fact.Contract(), fact.NewContract()} {
+		st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(ca), "key of contract account", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", ca, err), nil
+		}
+
+		v, err := extensioncurrency.StateContractAccountValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", ca, err), nil
+		}
+
+		if !v.Owner().Equal(fact.Sender()) {
+			return nil, base.NewBaseOperationProcessReasonError("not contract account owner, %q, %q", ca, fact.Sender()), nil
+		}
+	}
+
+	if _, err := kycstate.ExistsPolicy(fact.Contract(), fact.KYC(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("kyc service not found, %s-%s: %w", fact.Contract(), fact.KYC(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(kycstate.StateKeyDesign(fact.NewContract(), fact.KYC()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("kyc service already exists in new contract account, %s-%s: %w", fact.NewContract(), fact.KYC(), err), nil
+	}
+
+	for _, c := range fact.Customers() {
+		switch _, found, err := kycstate.LoadCustomer(fact.Contract(), fact.KYC(), c, getStateFunc); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to load customer, %s-%s-%s: %w", fact.Contract(), fact.KYC(), c, err), nil
+		case !found:
+			return nil, base.NewBaseOperationProcessReasonError("customer not found, %s-%s-%s", fact.Contract(), fact.KYC(), c), nil
+		}
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *MigrateKYCServiceProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process MigrateKYCService")
+
+	fact, ok := op.Fact().(MigrateKYCServiceFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected MigrateKYCServiceFact, not %T", op.Fact()))
+	}
+
+	keys := []func(base.Address) string{
+		func(ca base.Address) string {
+			return kycstate.StateKeyDesign(ca, fact.KYC())
+		},
+	}
+
+	for i := range fact.Customers() {
+		c := fact.Customers()[i]
+
+		keys = append(keys,
+			func(ca base.Address) string {
+				return kycstate.StateKeyCustomer(ca, fact.KYC(), c)
+			},
+			func(ca base.Address) string {
+				return kycstate.StateKeyCustomerApprovals(ca, fact.KYC(), c)
+			},
+		)
+	}
+
+	// NOTE the approvals are merged with the approvals in the same block
+	mergeValue := func(k string, v base.StateValue) base.StateMergeValue {
+		if kycstate.IsStateCustomerApprovalsKey(k) {
+			return kycstate.NewCustomerApprovalsStateMergeValue(k, v)
+		}
+
+		return currencystate.NewStateMergeValue(k, v)
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, key := range keys {
+		switch st, found, err := getStateFunc(key(fact.Contract())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to get state, %q: %w", key(fact.Contract()), err), nil
+		case found:
+			sts = append(sts,
+				mergeValue(key(fact.NewContract()), st.Value()),
+				mergeValue(key(fact.Contract()), kycstate.NewMigratedStateValue(fact.NewContract())),
+			)
+		}
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *MigrateKYCServiceProcessor) Close() error {
+	migrateKYCServiceProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestMigrateKYCServiceProcess(t *testing.T) {
+	var newContract base.Address
+
+	prepare := func(f *fixture) {
+		newContract = f.NewContractAccount(f.owner.Address)
+		f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+	}
+
+	migrate := func(f *fixture, customers ...base.Address) (base.Operation, error) {
+		return f.owner.Builder().MigrateKYCService(f.owner.Address, f.contract, f.kycID, newContract, customers, f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name:    "migrate",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.customer.Address)
+			},
+			check: func(f *fixture) {
+				if n := len(f.KYC(newContract, f.kycID).Controllers()); n != 4 {
+					f.t.Errorf("expected 4 controllers, but %d", n)
+				}
+
+				if _, found := f.Customer(newContract, f.kycID, f.customer.Address); !found {
+					f.t.Error("customer not migrated")
+				}
+
+				st, found := f.State(kycstate.StateKeyDesign(f.contract, f.kycID))
+				if !found {
+					f.t.Fatal("old design state not found")
+				}
+
+				if m, ok := st.Value().(kycstate.MigratedStateValue); !ok {
+					f.t.Errorf("expected MigratedStateValue, but %T", st.Value())
+				} else if !m.Contract.Equal(newContract) {
+					f.t.Errorf("expected migrated to %q, but %q", newContract, m.Contract)
+				}
+
+				switch ok, err := kycstate.IsVerified(f.contract, f.kycID, f.customer.Address, f.Height(), f.GetStateFunc); {
+				case err != nil:
+					f.t.Fatalf("failed to check customer: %+v", err)
+				case !ok:
+					f.t.Error("customer should be verified through old contract account")
+				}
+
+				f.checkBalance(f.owner.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "new contract account after migration",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.customer.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address,
+					kyc.NewAddCustomersItem(newContract, f.kycID, f.other.Address, f.info(kyctypes.CustomerStatusPending, 0), f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				if _, found := f.Customer(newContract, f.kycID, f.other.Address); !found {
+					f.t.Error("customer not added")
+				}
+			},
+		},
+		{
+			name: "old contract account after migration",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.customer.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address,
+					kyc.NewAddCustomersItem(f.contract, f.kycID, f.other.Address, f.info(kyctypes.CustomerStatusPending, 0), f.currency),
+				)
+			},
+			reason: "kyc service migrated to",
+		},
+		{
+			name:    "unknown customer",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.customer.Address, f.other.Address)
+			},
+			reason: "customer not found",
+		},
+		{
+			name: "kyc service exists in new contract account",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.SetKYC(newContract, kyctypes.NewDesign(f.kycID, kyctypes.NewPolicy([]kyctypes.Controller{}, 1)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.customer.Address)
+			},
+			reason: "kyc service already exists in new contract account",
+		},
+		{
+			name: "new contract account of another owner",
+			prepare: func(f *fixture) {
+				prepare(f)
+				newContract = f.NewContractAccount(f.admin.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.customer.Address)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name:    "not owner",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().MigrateKYCService(
+					f.admin.Address, f.contract, f.kycID, newContract, []base.Address{f.customer.Address}, f.currency)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name:    "unknown kyc service",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().MigrateKYCService(
+					f.owner.Address, f.contract, "NEW", newContract, []base.Address{f.customer.Address}, f.currency)
+			},
+			reason: "kyc service not found",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.SetBalance(f.owner.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.customer.Address)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if _, found := f.State(kycstate.StateKeyDesign(newContract, f.kycID)); found {
+					f.t.Error("kyc service should not be migrated")
+				}
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package kyc
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	RemoveControllersHint     = hint.MustNewHint("mitum-kyc-remove-controllers-operation-v0.0.1")
 )
 
-var MaxRemoveControllersItems uint = 10
+var MaxRemoveControllersItems = uint(networktypes.MaxItemsLimit)
 
 type RemoveControllersFact struct {
 	base.BaseFact
@@ -4,12 +4,12 @@ import (
 	"context"
 	"sync"
 
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -38,7 +38,7 @@ type RemoveControllersItemProcessor struct {
 	h           util.Hash
 	sender      base.Address
 	item        RemoveControllersItem
-	controllers *[]base.Address
+	controllers *[]kyctypes.Controller
 }
 
 func (ipp *RemoveControllersItemProcessor) PreProcess(
@@ -46,31 +46,21 @@ func (ipp *RemoveControllersItemProcessor) PreProcess(
 ) error {
 	it := ipp.item
 
-	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
-	if err != nil {
-		return err
-	}
-
-	ca, err := extensioncurrency.StateContractAccountValue(st)
-	if err != nil {
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleAdmin); err != nil {
 		return err
 	}
 
-	if !ca.Owner().Equal(ipp.sender) {
-		return errors.Errorf("not contract account owner, %q", ipp.sender)
-	}
-
 	if len(*ipp.controllers) == 0 {
 		return errors.Errorf("empty controllers, %s-%s", it.Contract(), it.KYC())
 	}
 
 	for i, ad := range *ipp.controllers {
-		if ad.Equal(it.Controller()) {
+		if ad.Address().Equal(it.Controller()) {
 			break
 		}
 
 		if i == len(*ipp.controllers)-1 {
-			return errors.Errorf("controller not found in kyc policy controllers, %q", ad)
+			return errors.Errorf("controller not found in kyc policy controllers, %q", it.Controller())
 		}
 	}
 
@@ -87,7 +77,7 @@ func (ipp *RemoveControllersItemProcessor) Process(
 	it := ipp.item
 
 	for i, ad := range *ipp.controllers {
-		if ad.Equal(it.Controller()) {
+		if ad.Address().Equal(it.Controller()) {
 			if i < len(*ipp.controllers)-1 {
 				copy((*ipp.controllers)[i:], (*ipp.controllers)[i+1:])
 			}
@@ -159,6 +149,15 @@ func (opp *RemoveControllersProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -171,7 +170,7 @@ func (opp *RemoveControllersProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
 
-	controllers := map[string]*[]base.Address{}
+	controllers := map[string]*[]kyctypes.Controller{}
 
 	for _, it := range fact.Items() {
 		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
@@ -217,7 +216,8 @@ func (opp *RemoveControllersProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
-	controllers := map[string]map[string]*[]base.Address{}
+	controllers := map[string]map[string]*[]kyctypes.Controller{}
+	policies := map[string]kyctypes.Policy{}
 
 	for _, it := range fact.Items() {
 		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
@@ -225,7 +225,13 @@ func (opp *RemoveControllersProcessor) Process( // nolint:dupl
 			return nil, base.NewBaseOperationProcessReasonError("failed to get kyc policy, %s-%s: %w", it.Contract(), it.KYC(), err), nil
 		}
 		cons := policy.Controllers()
-		controllers[kycstate.StateKeyDesign(it.Contract(), it.KYC())][it.KYC().String()] = &cons
+
+		k := kycstate.StateKeyDesign(it.Contract(), it.KYC())
+		if _, found := controllers[k]; !found {
+			controllers[k] = map[string]*[]kyctypes.Controller{}
+		}
+		controllers[k][it.KYC().String()] = &cons
+		policies[k] = policy
 	}
 
 	for _, it := range fact.Items() {
@@ -250,7 +256,7 @@ func (opp *RemoveControllersProcessor) Process( // nolint:dupl
 
 	for k, m := range controllers {
 		for id, cons := range m {
-			policy := kyctypes.NewPolicy(*cons)
+			policy := policies[k].SetControllers(*cons)
 			design := kyctypes.NewDesign(currencytypes.ContractID(id), policy)
 			if err := design.IsValid(nil); err != nil {
 				return nil, base.NewBaseOperationProcessReasonError("invalid design, %s: %w", k, err), nil
@@ -263,31 +269,17 @@ func (opp *RemoveControllersProcessor) Process( // nolint:dupl
 		}
 	}
 
-	fitems := fact.Items()
-	items := make([]KYCItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateKYCItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *RemoveControllersProcessor) Close() error {
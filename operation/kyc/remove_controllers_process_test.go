@@ -0,0 +1,105 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRemoveControllersProcess(t *testing.T) {
+	item := func(f *fixture, controller base.Address) kyc.RemoveControllersItem {
+		return kyc.NewRemoveControllersItem(f.contract, f.kycID, controller, f.currency)
+	}
+
+	isController := func(f *fixture, addr base.Address) bool {
+		_, found := f.KYC(f.contract, f.kycID).Controller(addr)
+
+		return found
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "by admin",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().RemoveControllers(f.admin.Address, item(f, f.reviewer.Address))
+			},
+			check: func(f *fixture) {
+				if isController(f, f.reviewer.Address) {
+					f.t.Error("controller not removed")
+				}
+
+				if n := len(f.KYC(f.contract, f.kycID).Controllers()); n != 3 {
+					f.t.Errorf("expected 3 controllers, but %d", n)
+				}
+
+				f.checkBalance(f.admin.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "multiple items",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().RemoveControllers(
+					f.owner.Address, item(f, f.reviewer.Address), item(f, f.approver.Address))
+			},
+			check: func(f *fixture) {
+				if isController(f, f.reviewer.Address) || isController(f, f.approver.Address) {
+					f.t.Error("controllers not removed")
+				}
+			},
+		},
+		{
+			name: "removed controller loses roles",
+			prepare: func(f *fixture) {
+				f.mustProcess(f.admin.Builder().RemoveControllers(f.admin.Address, item(f, f.reviewer.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address,
+					kyc.NewAddCustomersItem(f.contract, f.kycID, f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0), f.currency),
+				)
+			},
+			reason: "not contract account owner neither its controller",
+		},
+		{
+			name: "not controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().RemoveControllers(f.admin.Address, item(f, f.other.Address))
+			},
+			reason: "controller not found in kyc policy controllers",
+		},
+		{
+			name: "not admin",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveControllers(f.approver.Address, item(f, f.reviewer.Address))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "quorum over approvers",
+			prepare: func(f *fixture) {
+				f.setQuorum(3)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().RemoveControllers(f.admin.Address, item(f, f.approver.Address))
+			},
+			reason: "quorum over approvers",
+			check: func(f *fixture) {
+				if !isController(f, f.approver.Address) {
+					f.t.Error("controller should not be removed")
+				}
+			},
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.admin.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.admin.Builder().RemoveControllers(f.admin.Address, item(f, f.reviewer.Address))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
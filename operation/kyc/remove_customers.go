@@ -0,0 +1,140 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RemoveCustomersFactHint = hint.MustNewHint("mitum-kyc-remove-customers-operation-fact-v0.0.1")
+	RemoveCustomersHint     = hint.MustNewHint("mitum-kyc-remove-customers-operation-v0.0.1")
+)
+
+var MaxRemoveCustomersItems = uint(networktypes.MaxItemsLimit)
+
+type RemoveCustomersFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []RemoveCustomersItem
+}
+
+func NewRemoveCustomersFact(token []byte, sender base.Address, items []RemoveCustomersItem) RemoveCustomersFact {
+	bf := base.NewBaseFact(RemoveCustomersFactHint, token)
+	fact := RemoveCustomersFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RemoveCustomersFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RemoveCustomersFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RemoveCustomersFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RemoveCustomersFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxRemoveCustomersItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxRemoveCustomersItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+		}
+
+		if _, found := founds[it.Customer().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate customer found, %s", it.Customer())
+		}
+
+		founds[it.customer.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact RemoveCustomersFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RemoveCustomersFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RemoveCustomersFact) Items() []RemoveCustomersItem {
+	return fact.items
+}
+
+func (fact RemoveCustomersFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type RemoveCustomers struct {
+	common.BaseOperation
+}
+
+func NewRemoveCustomers(fact RemoveCustomersFact) (RemoveCustomers, error) {
+	return RemoveCustomers{BaseOperation: common.NewBaseOperation(RemoveCustomersHint, fact)}, nil
+}
+
+func (op *RemoveCustomers) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
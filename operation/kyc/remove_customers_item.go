@@ -0,0 +1,79 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var RemoveCustomersItemHint = hint.MustNewHint("mitum-kyc-remove-customers-item-v0.0.1")
+
+type RemoveCustomersItem struct {
+	hint.BaseHinter
+	contract base.Address
+	kycID    currencytypes.ContractID
+	customer base.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewRemoveCustomersItem(
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	customer base.Address,
+	currency currencytypes.CurrencyID,
+) RemoveCustomersItem {
+	return RemoveCustomersItem{
+		BaseHinter: hint.NewBaseHinter(RemoveCustomersItemHint),
+		contract:   contract,
+		kycID:      kycID,
+		customer:   customer,
+		currency:   currency,
+	}
+}
+
+func (it RemoveCustomersItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.kycID.Bytes(),
+		it.customer.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it RemoveCustomersItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.currency); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.customer) {
+		return util.ErrInvalid.Errorf("contract address is same with customer, %q", it.contract)
+	}
+
+	return nil
+}
+
+func (it RemoveCustomersItem) KYC() currencytypes.ContractID {
+	return it.kycID
+}
+
+func (it RemoveCustomersItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it RemoveCustomersItem) Customer() base.Address {
+	return it.customer
+}
+
+func (it RemoveCustomersItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it RemoveCustomersItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.customer
+
+	return ad
+}
@@ -0,0 +1,46 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RemoveCustomersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kycid"`
+	Customer base.Address             `json:"customer"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (it RemoveCustomersItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RemoveCustomersItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		KYC:        it.kycID,
+		Customer:   it.customer,
+		Currency:   it.currency,
+	})
+}
+
+type RemoveCustomersItemJSONUnMarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	KYC      string    `json:"kycid"`
+	Customer string    `json:"customer"`
+	Currency string    `json:"currency"`
+}
+
+func (it *RemoveCustomersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RemoveCustomersItem")
+
+	var uit RemoveCustomersItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Customer, uit.Currency)
+}
@@ -0,0 +1,66 @@
+package kyc
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RemoveCustomersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner base.Address          `json:"sender"`
+	Items []RemoveCustomersItem `json:"items"`
+}
+
+func (fact RemoveCustomersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RemoveCustomersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type RemoveCustomersFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner string          `json:"sender"`
+	Items json.RawMessage `json:"items"`
+}
+
+func (fact *RemoveCustomersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RemoveCustomersFact")
+
+	var uf RemoveCustomersFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Items)
+}
+
+type RemoveCustomersMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RemoveCustomers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RemoveCustomersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RemoveCustomers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RemoveCustomers")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package kyc
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var removeCustomersItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveCustomersItemProcessor)
+	},
+}
+
+var removeCustomersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RemoveCustomersProcessor)
+	},
+}
+
+func (RemoveCustomers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RemoveCustomersItemProcessor struct {
+	h      util.Hash
+	sender base.Address
+	item   RemoveCustomersItem
+}
+
+func (ipp *RemoveCustomersItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleApprover); err != nil {
+		return err
+	}
+
+	switch _, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc); {
+	case err != nil:
+		return err
+	case !found:
+		return errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *RemoveCustomersItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	v := currencystate.NewStateMergeValue(
+		kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()),
+		kycstate.NewRemovedCustomerStateValue(),
+	)
+
+	sts := []base.StateMergeValue{v}
+
+	switch ap, err := resetCustomerApprovals(it.Contract(), it.KYC(), it.Customer(), getStateFunc); {
+	case err != nil:
+		return nil, err
+	case ap != nil:
+		sts = append(sts, ap)
+	}
+
+	return sts, nil
+}
+
+func (ipp *RemoveCustomersItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = RemoveCustomersItem{}
+
+	removeCustomersItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type RemoveCustomersProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRemoveCustomersProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RemoveCustomersProcessor")
+
+		nopp := removeCustomersProcessorPool.Get()
+		opp, ok := nopp.(*RemoveCustomersProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected RemoveCustomersProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RemoveCustomersProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RemoveCustomers")
+
+	fact, ok := op.Fact().(RemoveCustomersFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected RemoveCustomersFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot remove customers, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := removeCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*RemoveCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RemoveCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess RemoveCustomersItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RemoveCustomersProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RemoveCustomers")
+
+	fact, ok := op.Fact().(RemoveCustomersFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected RemoveCustomersFact, not %T", op.Fact()))
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := removeCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*RemoveCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RemoveCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process RemoveCustomersItem: %w", err), nil
+		}
+
+		sts = append(sts, st...)
+
+		ipc.Close()
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *RemoveCustomersProcessor) Close() error {
+	removeCustomersProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRemoveCustomersProcess(t *testing.T) {
+	item := func(f *fixture, customer base.Address) kyc.RemoveCustomersItem {
+		return kyc.NewRemoveCustomersItem(f.contract, f.kycID, customer, f.currency)
+	}
+
+	isCustomer := func(f *fixture, customer base.Address) bool {
+		_, found := f.Customer(f.contract, f.kycID, customer)
+
+		return found
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "remove",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				if isCustomer(f, f.customer.Address) {
+					f.t.Error("customer not removed")
+				}
+
+				if ok, err := kycstate.IsVerified(f.contract, f.kycID, f.customer.Address, f.Height(), f.GetStateFunc); err != nil {
+					f.t.Fatalf("failed to check customer: %+v", err)
+				} else if ok {
+					f.t.Error("removed customer should not be verified")
+				}
+
+				f.checkBalance(f.approver.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "remove pending with approvals",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+				f.mustProcess(f.approver.Builder().ApproveCustomers(
+					f.approver.Address, kyc.NewApproveCustomersItem(f.contract, f.kycID, f.customer.Address, f.currency)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			check: func(f *fixture) {
+				ap, err := kycstate.LoadCustomerApprovals(f.contract, f.kycID, f.customer.Address, f.GetStateFunc)
+				if err != nil {
+					f.t.Fatalf("failed to load approvals: %+v", err)
+				}
+
+				if len(ap) != 0 {
+					f.t.Errorf("expected empty approvals, but %v", ap)
+				}
+			},
+		},
+		{
+			name: "add after remove",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+				f.mustProcess(f.approver.Builder().RemoveCustomers(f.approver.Address, item(f, f.customer.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().AddCustomers(
+					f.reviewer.Address,
+					kyc.NewAddCustomersItem(f.contract, f.kycID, f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0), f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsPending() {
+					f.t.Error("expected pending customer")
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+				f.setCustomer(f.other.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveCustomers(
+					f.approver.Address, item(f, f.customer.Address), item(f, f.other.Address))
+			},
+			check: func(f *fixture) {
+				if isCustomer(f, f.customer.Address) || isCustomer(f, f.other.Address) {
+					f.t.Error("customers not removed")
+				}
+
+				f.checkBalance(f.approver.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "unknown customer",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "customer not found",
+		},
+		{
+			name: "not approver",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().RemoveCustomers(f.reviewer.Address, item(f, f.customer.Address))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+				f.SetBalance(f.approver.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RemoveCustomers(f.approver.Address, item(f, f.customer.Address))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if !isCustomer(f, f.customer.Address) {
+					f.t.Error("customer should not be removed")
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,91 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var RenewCustomersItemHint = hint.MustNewHint("mitum-kyc-renew-customers-item-v0.0.1")
+
+type RenewCustomersItem struct {
+	hint.BaseHinter
+	contract base.Address
+	kycID    currencytypes.ContractID
+	customer base.Address
+	expiry   uint64
+	currency currencytypes.CurrencyID
+}
+
+func NewRenewCustomersItem(
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	customer base.Address,
+	expiry uint64,
+	currency currencytypes.CurrencyID,
+) RenewCustomersItem {
+	return RenewCustomersItem{
+		BaseHinter: hint.NewBaseHinter(RenewCustomersItemHint),
+		contract:   contract,
+		kycID:      kycID,
+		customer:   customer,
+		expiry:     expiry,
+		currency:   currency,
+	}
+}
+
+func (it RenewCustomersItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.kycID.Bytes(),
+		it.customer.Bytes(),
+		util.Uint64ToBytes(it.expiry),
+		it.currency.Bytes(),
+	)
+}
+
+func (it RenewCustomersItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.currency); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.customer) {
+		return util.ErrInvalid.Errorf("contract address is same with customer, %q", it.contract)
+	}
+
+	if it.expiry < 1 {
+		return util.ErrInvalid.Errorf("zero expiry height")
+	}
+
+	return nil
+}
+
+func (it RenewCustomersItem) KYC() currencytypes.ContractID {
+	return it.kycID
+}
+
+func (it RenewCustomersItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it RenewCustomersItem) Customer() base.Address {
+	return it.customer
+}
+
+func (it RenewCustomersItem) Expiry() uint64 {
+	return it.expiry
+}
+
+func (it RenewCustomersItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it RenewCustomersItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.customer
+
+	return ad
+}
@@ -0,0 +1,49 @@
+package kyc
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RenewCustomersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kycid"`
+	Customer base.Address             `json:"customer"`
+	Expiry   uint64                   `json:"expiry_height"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (it RenewCustomersItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewCustomersItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		KYC:        it.kycID,
+		Customer:   it.customer,
+		Expiry:     it.expiry,
+		Currency:   it.currency,
+	})
+}
+
+type RenewCustomersItemJSONUnMarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	KYC      string    `json:"kycid"`
+	Customer string    `json:"customer"`
+	Expiry   uint64    `json:"expiry_height"`
+	Currency string    `json:"currency"`
+}
+
+func (it *RenewCustomersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RenewCustomersItem")
+
+	var uit RenewCustomersItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Customer, uit.Expiry, uit.Currency)
+}
@@ -0,0 +1,66 @@
+package kyc
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RenewCustomersFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner base.Address         `json:"sender"`
+	Items []RenewCustomersItem `json:"items"`
+}
+
+func (fact RenewCustomersFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewCustomersFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type RenewCustomersFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner string          `json:"sender"`
+	Items json.RawMessage `json:"items"`
+}
+
+func (fact *RenewCustomersFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RenewCustomersFact")
+
+	var uf RenewCustomersFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Items)
+}
+
+type RenewCustomersMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RenewCustomers) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenewCustomersMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RenewCustomers) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RenewCustomers")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
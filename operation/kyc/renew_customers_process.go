@@ -0,0 +1,256 @@
+package kyc
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var renewCustomersItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewCustomersItemProcessor)
+	},
+}
+
+var renewCustomersProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenewCustomersProcessor)
+	},
+}
+
+func (RenewCustomers) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RenewCustomersItemProcessor struct {
+	h      util.Hash
+	height base.Height
+	sender base.Address
+	item   RenewCustomersItem
+}
+
+func (ipp *RenewCustomersItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleApprover); err != nil {
+		return err
+	}
+
+	info, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
+	if err != nil {
+		return err
+	} else if !found {
+		return errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if !info.IsApproved() {
+		return errors.Errorf("customer not approved, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if it.Expiry() <= uint64(ipp.height) {
+		return errors.Errorf("expiry height not over current height, %d <= %d", it.Expiry(), ipp.height)
+	}
+
+	if info.Expiry() == 0 {
+		return errors.Errorf("customer record does not expire, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if it.Expiry() <= info.Expiry() {
+		return errors.Errorf("expiry height not extended, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *RenewCustomersItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	info, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
+	if err != nil {
+		return nil, err
+	} else if !found {
+		return nil, errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	}
+
+	v := currencystate.NewStateMergeValue(
+		kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()),
+		kycstate.NewCustomerStateValue(info.SetExpiry(it.Expiry())),
+	)
+
+	sts := []base.StateMergeValue{v}
+
+	return sts, nil
+}
+
+func (ipp *RenewCustomersItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.height = 0
+	ipp.sender = nil
+	ipp.item = RenewCustomersItem{}
+
+	renewCustomersItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type RenewCustomersProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRenewCustomersProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RenewCustomersProcessor")
+
+		nopp := renewCustomersProcessorPool.Get()
+		opp, ok := nopp.(*RenewCustomersProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected RenewCustomersProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RenewCustomersProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RenewCustomers")
+
+	fact, ok := op.Fact().(RenewCustomersFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected RenewCustomersFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot renew customers, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, it := range fact.Items() {
+		ip := renewCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*RenewCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RenewCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.height = opp.Height()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess RenewCustomersItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RenewCustomersProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RenewCustomers")
+
+	fact, ok := op.Fact().(RenewCustomersFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected RenewCustomersFact, not %T", op.Fact()))
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := renewCustomersItemProcessorPool.Get()
+		ipc, ok := ip.(*RenewCustomersItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RenewCustomersItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.height = opp.Height()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process RenewCustomersItem: %w", err), nil
+		}
+
+		sts = append(sts, st...)
+
+		ipc.Close()
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *RenewCustomersProcessor) Close() error {
+	renewCustomersProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRenewCustomersProcess(t *testing.T) {
+	item := func(f *fixture, customer base.Address, expiry uint64) kyc.RenewCustomersItem {
+		return kyc.NewRenewCustomersItem(f.contract, f.kycID, customer, expiry, f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "renew",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())+10))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+20))
+			},
+			check: func(f *fixture) {
+				if e := f.mustCustomer(f.customer.Address).Expiry(); e != uint64(f.Height())+20 {
+					f.t.Errorf("expected expiry %d, but %d", uint64(f.Height())+20, e)
+				}
+
+				f.checkBalance(f.approver.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "renew expired",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())))
+				f.SetHeight(f.Height() + 5)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+10))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsVerified(f.Height()) {
+					f.t.Error("expected verified customer")
+				}
+			},
+		},
+		{
+			name: "not approved",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, uint64(f.Height())+10))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+20))
+			},
+			reason: "customer not approved",
+		},
+		{
+			name: "does not expire",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+20))
+			},
+			reason: "customer record does not expire",
+		},
+		{
+			name: "not extended",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())+20))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+10))
+			},
+			reason: "expiry height not extended",
+		},
+		{
+			name: "expiry passed",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())+10))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())))
+			},
+			reason: "expiry height not over current height",
+		},
+		{
+			name: "not approver",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())+10))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().RenewCustomers(f.reviewer.Address, item(f, f.customer.Address, uint64(f.Height())+20))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, uint64(f.Height())+10))
+				f.SetBalance(f.approver.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().RenewCustomers(f.approver.Address, item(f, f.customer.Address, uint64(f.Height())+20))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
@@ -2,6 +2,7 @@ package kyc
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	UpdateCustomersHint     = hint.MustNewHint("mitum-kyc-update-customers-operation-v0.0.1")
 )
 
-var MaxUpdateCustomersItems uint = 10
+var MaxUpdateCustomersItems = uint(networktypes.MaxItemsLimit)
 
 type UpdateCustomersFact struct {
 	base.BaseFact
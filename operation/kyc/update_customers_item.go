@@ -2,19 +2,26 @@ package kyc
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-var UpdateCustomersItemHint = hint.MustNewHint("mitum-kyc-update-customers-item-v0.0.1")
+var (
+	UpdateCustomersItemHint = hint.MustNewHint("mitum-kyc-update-customers-item-v0.0.2")
+	// LegacyUpdateCustomersItemHint is the item with status only; it is decoded with
+	// the legacy hint and keeps the bytes of the item, so the facts of stored
+	// blocks have the same hash.
+	LegacyUpdateCustomersItemHint = hint.MustNewHint("mitum-kyc-update-customers-item-v0.0.1")
+)
 
 type UpdateCustomersItem struct {
 	hint.BaseHinter
 	contract base.Address
 	kycID    currencytypes.ContractID
 	customer base.Address
-	status   bool
+	info     kyctypes.CustomerInfo
 	currency currencytypes.CurrencyID
 }
 
@@ -22,7 +29,7 @@ func NewUpdateCustomersItem(
 	contract base.Address,
 	kycID currencytypes.ContractID,
 	customer base.Address,
-	status bool,
+	info kyctypes.CustomerInfo,
 	currency currencytypes.CurrencyID,
 ) UpdateCustomersItem {
 	return UpdateCustomersItem{
@@ -30,28 +37,38 @@ func NewUpdateCustomersItem(
 		contract:   contract,
 		kycID:      kycID,
 		customer:   customer,
-		status:     status,
+		info:       info,
 		currency:   currency,
 	}
 }
 
 func (it UpdateCustomersItem) Bytes() []byte {
-	b := []byte{0}
-	if it.status {
-		b[0] = 1
+	if it.isLegacy() {
+		b := []byte{0}
+		if it.info.IsApproved() {
+			b[0] = 1
+		}
+
+		return util.ConcatBytesSlice(
+			it.contract.Bytes(),
+			it.kycID.Bytes(),
+			it.customer.Bytes(),
+			b,
+			it.currency.Bytes(),
+		)
 	}
 
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.kycID.Bytes(),
 		it.customer.Bytes(),
-		b,
+		it.info.Bytes(),
 		it.currency.Bytes(),
 	)
 }
 
 func (it UpdateCustomersItem) IsValid([]byte) error {
-	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.currency); err != nil {
+	if err := util.CheckIsValiders(nil, false, it.BaseHinter, it.kycID, it.contract, it.customer, it.info, it.currency); err != nil {
 		return err
 	}
 
@@ -62,6 +79,10 @@ func (it UpdateCustomersItem) IsValid([]byte) error {
 	return nil
 }
 
+func (it UpdateCustomersItem) isLegacy() bool {
+	return it.Hint().Equal(LegacyUpdateCustomersItemHint)
+}
+
 func (it UpdateCustomersItem) KYC() currencytypes.ContractID {
 	return it.kycID
 }
@@ -74,8 +95,12 @@ func (it UpdateCustomersItem) Customer() base.Address {
 	return it.customer
 }
 
-func (it UpdateCustomersItem) Status() bool {
-	return it.status
+func (it UpdateCustomersItem) Info() kyctypes.CustomerInfo {
+	return it.info
+}
+
+func (it UpdateCustomersItem) Status() kyctypes.CustomerStatus {
+	return it.info.Status()
 }
 
 func (it UpdateCustomersItem) Currency() currencytypes.CurrencyID {
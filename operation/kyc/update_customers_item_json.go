@@ -1,7 +1,10 @@
 package kyc
 
 import (
+	"encoding/json"
+
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -9,6 +12,15 @@ import (
 )
 
 type UpdateCustomersItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kycid"`
+	Customer base.Address             `json:"customer"`
+	Info     kyctypes.CustomerInfo    `json:"info"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+type LegacyUpdateCustomersItemJSONMarshaler struct {
 	hint.BaseHinter
 	Contract base.Address             `json:"contract"`
 	KYC      currencytypes.ContractID `json:"kycid"`
@@ -18,23 +30,35 @@ type UpdateCustomersItemJSONMarshaler struct {
 }
 
 func (it UpdateCustomersItem) MarshalJSON() ([]byte, error) {
+	if it.isLegacy() {
+		return util.MarshalJSON(LegacyUpdateCustomersItemJSONMarshaler{
+			BaseHinter: it.BaseHinter,
+			Contract:   it.contract,
+			KYC:        it.kycID,
+			Customer:   it.customer,
+			Status:     it.info.IsApproved(),
+			Currency:   it.currency,
+		})
+	}
+
 	return util.MarshalJSON(UpdateCustomersItemJSONMarshaler{
 		BaseHinter: it.BaseHinter,
 		Contract:   it.contract,
 		KYC:        it.kycID,
 		Customer:   it.customer,
-		Status:     it.status,
+		Info:       it.info,
 		Currency:   it.currency,
 	})
 }
 
 type UpdateCustomersItemJSONUnMarshaler struct {
-	Hint     hint.Hint `json:"_hint"`
-	Contract string    `json:"contract"`
-	KYC      string    `json:"kycid"`
-	Customer string    `json:"customer"`
-	Status   bool      `json:"status"`
-	Currency string    `json:"currency"`
+	Hint     hint.Hint       `json:"_hint"`
+	Contract string          `json:"contract"`
+	KYC      string          `json:"kycid"`
+	Customer string          `json:"customer"`
+	Info     json.RawMessage `json:"info"`
+	Status   bool            `json:"status"`
+	Currency string          `json:"currency"`
 }
 
 func (it *UpdateCustomersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -45,5 +69,5 @@ func (it *UpdateCustomersItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Customer, uit.Status, uit.Currency)
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.KYC, uit.Customer, uit.Info, uit.Status, uit.Currency)
 }
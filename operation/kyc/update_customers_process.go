@@ -1,15 +1,17 @@
 package kyc
 
 import (
+	"bytes"
 	"context"
 	"sync"
 
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/pkg/errors"
@@ -44,50 +46,31 @@ func (ipp *UpdateCustomersItemProcessor) PreProcess(
 ) error {
 	it := ipp.item
 
-	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
-	if err != nil {
+	if err := checkControllerRoles(it.Contract(), it.KYC(), ipp.sender, getStateFunc, kyctypes.RoleApprover); err != nil {
 		return err
 	}
 
-	ca, err := extensioncurrency.StateContractAccountValue(st)
+	info, found, err := kycstate.LoadCustomer(it.Contract(), it.KYC(), it.Customer(), getStateFunc)
 	if err != nil {
 		return err
+	} else if !found {
+		return errors.Errorf("customer not found, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
 	}
 
-	if !ca.Owner().Equal(ipp.sender) {
-		policy, err := kycstate.ExistsPolicy(it.Contract(), it.KYC(), getStateFunc)
-		if err != nil {
-			return err
-		}
-
-		controllers := policy.Controllers()
-		if len(controllers) == 0 {
-			return errors.Errorf("not contract account owner neither its controller, %s-%s", it.Contract(), it.KYC())
-		}
-
-		for i, con := range controllers {
-			if con.Equal(ipp.sender) {
-				break
-			}
-
-			if i == len(controllers)-1 {
-				return errors.Errorf("not contract account owner neither its controller, %s-%s", it.Contract(), it.KYC())
-			}
-		}
-	}
-
-	st, err = currencystate.ExistsState(kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()), "key of customer status", getStateFunc)
-	if err != nil {
-		return err
+	if bytes.Equal(info.Bytes(), it.Info().Bytes()) {
+		return errors.Errorf("customer info already reflected, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
 	}
 
-	status, err := kycstate.StateCustomerValue(st)
-	if err != nil {
-		return err
+	// NOTE the customer can not be set back to pending; otherwise the rejected
+	// customer could be approved again by ApproveCustomers.
+	if it.Info().IsPending() {
+		return errors.Errorf("customer can not be set to pending, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
 	}
 
-	if bool(*status) == it.Status() {
-		return errors.Errorf("customer status already reflected, %s-%s-%s", it.Contract(), it.KYC(), it.Customer())
+	if !info.IsApproved() && it.Info().IsApproved() {
+		if err := checkDirectApproval(it.Contract(), it.KYC(), getStateFunc); err != nil {
+			return err
+		}
 	}
 
 	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
@@ -104,11 +87,18 @@ func (ipp *UpdateCustomersItemProcessor) Process(
 
 	v := currencystate.NewStateMergeValue(
 		kycstate.StateKeyCustomer(it.Contract(), it.KYC(), it.Customer()),
-		kycstate.NewCustomerStateValue(kycstate.Status(it.Status())),
+		kycstate.NewCustomerStateValue(it.Info()),
 	)
 
 	sts := []base.StateMergeValue{v}
 
+	switch ap, err := resetCustomerApprovals(it.Contract(), it.KYC(), it.Customer(), getStateFunc); {
+	case err != nil:
+		return nil, err
+	case ap != nil:
+		sts = append(sts, ap)
+	}
+
 	return sts, nil
 }
 
@@ -167,6 +157,15 @@ func (opp *UpdateCustomersProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -234,31 +233,17 @@ func (opp *UpdateCustomersProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]KYCItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateKYCItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *UpdateCustomersProcessor) Close() error {
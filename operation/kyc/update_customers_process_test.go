@@ -0,0 +1,132 @@
+package kyc_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestUpdateCustomersProcess(t *testing.T) {
+	item := func(f *fixture, customer base.Address, info kyctypes.CustomerInfo) kyc.UpdateCustomersItem {
+		return kyc.NewUpdateCustomersItem(f.contract, f.kycID, customer, info, f.currency)
+	}
+
+	us := func(status kyctypes.CustomerStatus) kyctypes.CustomerInfo {
+		return kyctypes.NewCustomerInfo(status, "US", "accredited", "individual", 0, "KYCHASH")
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "update",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, us(kyctypes.CustomerStatusApproved)))
+			},
+			check: func(f *fixture) {
+				if j := f.mustCustomer(f.customer.Address).Jurisdiction(); j != "US" {
+					f.t.Errorf("expected jurisdiction US, but %s", j)
+				}
+
+				f.checkBalance(f.approver.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "approve pending",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0)))
+			},
+			check: func(f *fixture) {
+				if !f.mustCustomer(f.customer.Address).IsApproved() {
+					f.t.Error("customer not approved")
+				}
+			},
+		},
+		{
+			name: "approve pending with quorum",
+			prepare: func(f *fixture) {
+				f.setQuorum(2)
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0)))
+			},
+			reason: "customer should be approved by 2 approvers",
+		},
+		{
+			name: "reject approved",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(
+					f.approver.Address, item(f, f.customer.Address, f.info(kyctypes.CustomerStatusRejected, 0)))
+			},
+			check: func(f *fixture) {
+				if f.mustCustomer(f.customer.Address).Status() != kyctypes.CustomerStatusRejected {
+					f.t.Error("customer not rejected")
+				}
+			},
+		},
+		{
+			name: "set to pending",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusRejected, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(
+					f.approver.Address, item(f, f.customer.Address, f.info(kyctypes.CustomerStatusPending, 0)))
+			},
+			reason: "customer can not be set to pending",
+		},
+		{
+			name: "already reflected",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0)))
+			},
+			reason: "customer info already reflected",
+		},
+		{
+			name: "unknown customer",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, us(kyctypes.CustomerStatusApproved)))
+			},
+			reason: "customer not found",
+		},
+		{
+			name: "not approver",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.reviewer.Builder().UpdateCustomers(f.reviewer.Address, item(f, f.customer.Address, us(kyctypes.CustomerStatusApproved)))
+			},
+			reason: "controller does not have roles",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.setCustomer(f.customer.Address, f.info(kyctypes.CustomerStatusApproved, 0))
+				f.SetBalance(f.approver.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.approver.Builder().UpdateCustomers(f.approver.Address, item(f, f.customer.Address, us(kyctypes.CustomerStatusApproved)))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if j := f.mustCustomer(f.customer.Address).Jurisdiction(); j != "KR" {
+					f.t.Errorf("expected jurisdiction KR, but %s", j)
+				}
+			},
+		},
+	})
+}
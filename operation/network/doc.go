@@ -0,0 +1,4 @@
+/*
+Package network provides the operations of sto network policy.
+*/
+package network
@@ -0,0 +1,131 @@
+package network
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	GenesisNetworkPolicyFactHint = hint.MustNewHint("mitum-sto-genesis-network-policy-fact-v0.0.1")
+	GenesisNetworkPolicyHint     = hint.MustNewHint("mitum-sto-genesis-network-policy-v0.0.1")
+)
+
+type GenesisNetworkPolicyFact struct {
+	base.BaseFact
+	policy networktypes.NetworkPolicy
+}
+
+func NewGenesisNetworkPolicyFact(policy networktypes.NetworkPolicy) GenesisNetworkPolicyFact {
+	fact := GenesisNetworkPolicyFact{
+		BaseFact: base.NewBaseFact(
+			GenesisNetworkPolicyFactHint,
+			base.Token(localtime.New(localtime.Now().UTC()).Bytes()),
+		),
+		policy: policy,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GenesisNetworkPolicyFact) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid GenesisNetworkPolicyFact")
+
+	if err := util.CheckIsValiders(nil, false, fact.BaseFact, fact.policy); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !fact.Hash().Equal(fact.GenerateHash()) {
+		return e.Errorf("hash does not match")
+	}
+
+	return nil
+}
+
+func (fact GenesisNetworkPolicyFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GenesisNetworkPolicyFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GenesisNetworkPolicyFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.policy.Bytes(),
+	)
+}
+
+func (fact GenesisNetworkPolicyFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GenesisNetworkPolicyFact) Policy() networktypes.NetworkPolicy {
+	return fact.policy
+}
+
+// GenesisNetworkPolicy is only used for genesis block.
+type GenesisNetworkPolicy struct {
+	common.BaseOperation
+}
+
+func NewGenesisNetworkPolicy(fact GenesisNetworkPolicyFact) GenesisNetworkPolicy {
+	return GenesisNetworkPolicy{
+		BaseOperation: common.NewBaseOperation(GenesisNetworkPolicyHint, fact),
+	}
+}
+
+func (op GenesisNetworkPolicy) IsValid(networkID []byte) error {
+	e := util.ErrInvalid.Errorf("invalid GenesisNetworkPolicy")
+
+	if err := op.BaseOperation.IsValid(networkID); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(op.Signs()) > 1 {
+		return e.Errorf("multiple signs found")
+	}
+
+	if _, ok := op.Fact().(GenesisNetworkPolicyFact); !ok {
+		return e.Errorf("not GenesisNetworkPolicyFact, %T", op.Fact())
+	}
+
+	return nil
+}
+
+func (GenesisNetworkPolicy) PreProcess(ctx context.Context, getStateFunc base.GetStateFunc) (
+	context.Context, base.OperationProcessReasonError, error,
+) {
+	switch _, found, err := getStateFunc(networkstate.NetworkPolicyStateKey); {
+	case err != nil:
+		return ctx, base.NewBaseOperationProcessReasonError("failed to check sto network policy state; %w", err), nil
+	case found:
+		return ctx, base.NewBaseOperationProcessReasonError("sto network policy state already exists"), nil
+	default:
+		return ctx, nil, nil
+	}
+}
+
+func (op GenesisNetworkPolicy) Process(context.Context, base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact := op.Fact().(GenesisNetworkPolicyFact) //nolint:forcetypeassert //...
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			networkstate.NetworkPolicyStateKey,
+			networkstate.NewNetworkPolicyStateValue(fact.Policy()),
+		),
+	}, nil, nil
+}
@@ -0,0 +1,22 @@
+package network
+
+import (
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *GenesisNetworkPolicyFact) unpack(enc encoder.Encoder, bpo []byte) error {
+	e := util.StringError("failed to unmarshal GenesisNetworkPolicyFact")
+
+	if hinter, err := enc.Decode(bpo); err != nil {
+		return e.Wrap(err)
+	} else if po, ok := hinter.(networktypes.NetworkPolicy); !ok {
+		return e.Wrap(errors.Errorf("expected NetworkPolicy, not %T", hinter))
+	} else {
+		fact.policy = po
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type GenesisNetworkPolicyFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Policy networktypes.NetworkPolicy `json:"policy"`
+}
+
+func (fact GenesisNetworkPolicyFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisNetworkPolicyFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Policy:                fact.policy,
+	})
+}
+
+type GenesisNetworkPolicyFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Policy json.RawMessage `json:"policy"`
+}
+
+func (fact *GenesisNetworkPolicyFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisNetworkPolicyFact")
+
+	var uf GenesisNetworkPolicyFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Policy)
+}
+
+type GenesisNetworkPolicyMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op GenesisNetworkPolicy) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GenesisNetworkPolicyMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *GenesisNetworkPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GenesisNetworkPolicy")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package network
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateNetworkPolicyFactHint = hint.MustNewHint("mitum-sto-update-network-policy-operation-fact-v0.0.1")
+	UpdateNetworkPolicyHint     = hint.MustNewHint("mitum-sto-update-network-policy-operation-v0.0.1")
+)
+
+type UpdateNetworkPolicyFact struct {
+	base.BaseFact
+	policy networktypes.NetworkPolicy
+}
+
+func NewUpdateNetworkPolicyFact(token []byte, policy networktypes.NetworkPolicy) UpdateNetworkPolicyFact {
+	fact := UpdateNetworkPolicyFact{
+		BaseFact: base.NewBaseFact(UpdateNetworkPolicyFactHint, token),
+		policy:   policy,
+	}
+
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateNetworkPolicyFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateNetworkPolicyFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.policy.Bytes(),
+	)
+}
+
+func (fact UpdateNetworkPolicyFact) IsValid(b []byte) error {
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.policy); err != nil {
+		return util.ErrInvalid.Errorf("invalid fact: %v", err)
+	}
+
+	return nil
+}
+
+func (fact UpdateNetworkPolicyFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateNetworkPolicyFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateNetworkPolicyFact) Policy() networktypes.NetworkPolicy {
+	return fact.policy
+}
+
+// UpdateNetworkPolicy replaces the sto network policy. It must be signed by
+// suffrage nodes over threshold.
+type UpdateNetworkPolicy struct {
+	common.BaseNodeOperation
+}
+
+func NewUpdateNetworkPolicy(fact UpdateNetworkPolicyFact) (UpdateNetworkPolicy, error) {
+	return UpdateNetworkPolicy{
+		BaseNodeOperation: common.NewBaseNodeOperation(UpdateNetworkPolicyHint, fact),
+	}, nil
+}
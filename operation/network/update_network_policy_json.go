@@ -0,0 +1,64 @@
+package network
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateNetworkPolicyFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Policy networktypes.NetworkPolicy `json:"policy"`
+}
+
+func (fact UpdateNetworkPolicyFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateNetworkPolicyFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Policy:                fact.policy,
+	})
+}
+
+type UpdateNetworkPolicyFactJSONUnmarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Policy json.RawMessage `json:"policy"`
+}
+
+func (fact *UpdateNetworkPolicyFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateNetworkPolicyFact")
+
+	var uf UpdateNetworkPolicyFactJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Policy)
+}
+
+type UpdateNetworkPolicyMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateNetworkPolicy) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateNetworkPolicyMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateNetworkPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateNetworkPolicy")
+
+	var ubo common.BaseNodeOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseNodeOperation = ubo
+
+	return nil
+}
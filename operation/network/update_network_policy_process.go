@@ -0,0 +1,130 @@
+package network
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/isaac"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateNetworkPolicyProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateNetworkPolicyProcessor)
+	},
+}
+
+func (UpdateNetworkPolicy) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateNetworkPolicyProcessor struct {
+	*base.BaseOperationProcessor
+	suffrage  base.Suffrage
+	threshold base.Threshold
+}
+
+func NewUpdateNetworkPolicyProcessor(threshold base.Threshold) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateNetworkPolicyProcessor")
+
+		nopp := updateNetworkPolicyProcessorPool.Get()
+		opp, ok := nopp.(*UpdateNetworkPolicyProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected UpdateNetworkPolicyProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.threshold = threshold
+
+		switch i, found, err := getStateFunc(isaac.SuffrageStateKey); {
+		case err != nil:
+			return nil, e.Wrap(err)
+		case !found, i == nil:
+			return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("empty state"))
+		default:
+			sufstv := i.Value().(base.SuffrageNodesStateValue) //nolint:forcetypeassert //...
+
+			suf, err := sufstv.Suffrage()
+			if err != nil {
+				return nil, e.Wrap(isaac.ErrStopProcessingRetry.Errorf("failed to get suffrage from state"))
+			}
+
+			opp.suffrage = suf
+		}
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateNetworkPolicyProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateNetworkPolicy")
+
+	nop, ok := op.(UpdateNetworkPolicy)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected UpdateNetworkPolicy, not %T", op))
+	}
+
+	fact, ok := op.Fact().(UpdateNetworkPolicyFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected UpdateNetworkPolicyFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := base.CheckFactSignsBySuffrage(opp.suffrage, opp.threshold, nop.NodeSigns()); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not enough signs"), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateNetworkPolicyProcessor) Process(
+	_ context.Context, op base.Operation, _ base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process UpdateNetworkPolicy")
+
+	fact, ok := op.Fact().(UpdateNetworkPolicyFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected UpdateNetworkPolicyFact, not %T", op.Fact()))
+	}
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			networkstate.NetworkPolicyStateKey,
+			networkstate.NewNetworkPolicyStateValue(fact.Policy()),
+		),
+	}, nil, nil
+}
+
+func (opp *UpdateNetworkPolicyProcessor) Close() error {
+	opp.suffrage = nil
+	opp.threshold = 0
+
+	updateNetworkPolicyProcessorPool.Put(opp)
+
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
 	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"github.com/pkg/errors"
 )
@@ -14,6 +16,7 @@ import (
 const (
 	DuplicationTypeSender   currencytypes.DuplicationType = "sender"
 	DuplicationTypeCurrency currencytypes.DuplicationType = "currency"
+	DuplicationTypeNetwork  currencytypes.DuplicationType = "network"
 )
 
 func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Operation) error {
@@ -83,6 +86,13 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 		did = fact.Currency().String()
 		didtype = DuplicationTypeCurrency
 	case currency.Mint:
+	case sto.AuthorizeGlobalOperators:
+		fact, ok := t.Fact().(sto.AuthorizeGlobalOperatorsFact)
+		if !ok {
+			return errors.Errorf("expected AuthorizeGlobalOperatorsFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
 	case sto.AuthorizeOperators:
 		fact, ok := t.Fact().(sto.AuthorizeOperatorsFact)
 		if !ok {
@@ -111,6 +121,13 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+	case sto.RevokeGlobalOperators:
+		fact, ok := t.Fact().(sto.RevokeGlobalOperatorsFact)
+		if !ok {
+			return errors.Errorf("expected RevokeGlobalOperatorsFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
 	case sto.RevokeOperators:
 		fact, ok := t.Fact().(sto.RevokeOperatorsFact)
 		if !ok {
@@ -125,6 +142,34 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+	case sto.UpdateJurisdictionRule:
+		fact, ok := t.Fact().(sto.UpdateJurisdictionRuleFact)
+		if !ok {
+			return errors.Errorf("expected UpdateJurisdictionRuleFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case sto.UpdateFeeSchedule:
+		fact, ok := t.Fact().(sto.UpdateFeeScheduleFact)
+		if !ok {
+			return errors.Errorf("expected UpdateFeeScheduleFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case sto.MigrateSecurityTokens:
+		fact, ok := t.Fact().(sto.MigrateSecurityTokensFact)
+		if !ok {
+			return errors.Errorf("expected MigrateSecurityTokensFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case sto.RecoverTokenHolder:
+		fact, ok := t.Fact().(sto.RecoverTokenHolderFact)
+		if !ok {
+			return errors.Errorf("expected RecoverTokenHolderFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
 	case sto.TransferSecurityTokensPartition:
 		fact, ok := t.Fact().(sto.TransferSecurityTokensPartitionFact)
 		if !ok {
@@ -167,6 +212,40 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 		}
 		did = fact.Sender().String()
 		didtype = DuplicationTypeSender
+	case kyc.RenewCustomers:
+		fact, ok := t.Fact().(kyc.RenewCustomersFact)
+		if !ok {
+			return errors.Errorf("expected RenewCustomersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case kyc.ApproveCustomers:
+		fact, ok := t.Fact().(kyc.ApproveCustomersFact)
+		if !ok {
+			return errors.Errorf("expected ApproveCustomersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case kyc.RemoveCustomers:
+		fact, ok := t.Fact().(kyc.RemoveCustomersFact)
+		if !ok {
+			return errors.Errorf("expected RemoveCustomersFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case kyc.MigrateKYCService:
+		fact, ok := t.Fact().(kyc.MigrateKYCServiceFact)
+		if !ok {
+			return errors.Errorf("expected MigrateKYCServiceFact, not %T", t.Fact())
+		}
+		did = fact.Sender().String()
+		didtype = DuplicationTypeSender
+	case network.UpdateNetworkPolicy:
+		if _, ok := t.Fact().(network.UpdateNetworkPolicyFact); !ok {
+			return errors.Errorf("expected UpdateNetworkPolicyFact, not %T", t.Fact())
+		}
+		did = networkstate.NetworkPolicyStateKey
+		didtype = DuplicationTypeNetwork
 	default:
 		return nil
 	}
@@ -178,6 +257,8 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 				return errors.Errorf("violates only one sender in proposal")
 			case DuplicationTypeCurrency:
 				return errors.Errorf("duplicate currency id, %q found in proposal", did)
+			case DuplicationTypeNetwork:
+				return errors.Errorf("violates only one network policy update in proposal")
 			default:
 				return errors.Errorf("violates duplication in proposal")
 			}
@@ -212,13 +293,20 @@ func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op mitumbase.Ope
 		currency.RegisterCurrency,
 		currency.UpdateCurrency,
 		currency.Mint,
+		sto.AuthorizeGlobalOperators,
 		sto.AuthorizeOperators,
 		sto.CreateSecurityTokens,
 		sto.IssueSecurityTokens,
 		sto.RedeemTokens,
+		sto.RevokeGlobalOperators,
 		sto.RevokeOperators,
 		sto.SetDocument,
-		sto.TransferSecurityTokensPartition:
+		sto.TransferSecurityTokensPartition,
+		sto.UpdateJurisdictionRule,
+		sto.UpdateFeeSchedule,
+		sto.MigrateSecurityTokens,
+		sto.RecoverTokenHolder,
+		network.UpdateNetworkPolicy:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
@@ -0,0 +1,42 @@
+package processor
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProcessorInfo struct {
+	Hint      hint.Hint
+	Processor currencytypes.GetNewProcessor
+}
+
+// STOProcessors returns the processors of sto and kyc operations; the
+// processor of network policy depends on the node and is not included.
+func STOProcessors() []ProcessorInfo {
+	return []ProcessorInfo{
+		{sto.AuthorizeGlobalOperatorsHint, sto.NewAuthorizeGlobalOperatorsProcessor()},
+		{sto.AuthorizeOperatorsHint, sto.NewAuthorizeOperatorsProcessor()},
+		{sto.CreateSecurityTokensHint, sto.NewCreateSecurityTokensProcessor()},
+		{sto.IssueSecurityTokensHint, sto.NewIssueSecurityTokensProcessor()},
+		{sto.RedeemTokensHint, sto.NewRedeemTokensProcessor()},
+		{sto.RevokeGlobalOperatorsHint, sto.NewRevokeGlobalOperatorsProcessor()},
+		{sto.RevokeOperatorsHint, sto.NewRevokeOperatorsProcessor()},
+		{sto.SetDocumentHint, sto.NewSetDocumentProcessor()},
+		{sto.TransferSecurityTokensPartitionHint, sto.NewTransferSecurityTokensPartitionProcessor()},
+		{sto.UpdateJurisdictionRuleHint, sto.NewUpdateJurisdictionRuleProcessor()},
+		{sto.UpdateFeeScheduleHint, sto.NewUpdateFeeScheduleProcessor()},
+		{sto.MigrateSecurityTokensHint, sto.NewMigrateSecurityTokensProcessor()},
+		{sto.RecoverTokenHolderHint, sto.NewRecoverTokenHolderProcessor()},
+		{kyc.AddControllersHint, kyc.NewAddControllersProcessor()},
+		{kyc.AddCustomersHint, kyc.NewAddCustomersProcessor()},
+		{kyc.CreateKYCServiceHint, kyc.NewCreateKYCServiceProcessor()},
+		{kyc.RemoveControllersHint, kyc.NewRemoveControllersProcessor()},
+		{kyc.UpdateCustomersHint, kyc.NewUpdateCustomersProcessor()},
+		{kyc.RenewCustomersHint, kyc.NewRenewCustomersProcessor()},
+		{kyc.ApproveCustomersHint, kyc.NewApproveCustomersProcessor()},
+		{kyc.RemoveCustomersHint, kyc.NewRemoveCustomersProcessor()},
+		{kyc.MigrateKYCServiceHint, kyc.NewMigrateKYCServiceProcessor()},
+	}
+}
@@ -0,0 +1,140 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	AuthorizeGlobalOperatorsFactHint = hint.MustNewHint("mitum-sto-authorize-global-operator-operation-fact-v0.0.1")
+	AuthorizeGlobalOperatorsHint     = hint.MustNewHint("mitum-sto-authorize-global-operator-operation-v0.0.1")
+)
+
+var MaxAuthorizeGlobalOperatorsItems = uint(networktypes.MaxItemsLimit)
+
+type AuthorizeGlobalOperatorsFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []AuthorizeGlobalOperatorsItem
+}
+
+func NewAuthorizeGlobalOperatorsFact(token []byte, sender base.Address, items []AuthorizeGlobalOperatorsItem) AuthorizeGlobalOperatorsFact {
+	bf := base.NewBaseFact(AuthorizeGlobalOperatorsFactHint, token)
+	fact := AuthorizeGlobalOperatorsFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AuthorizeGlobalOperatorsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact AuthorizeGlobalOperatorsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxAuthorizeGlobalOperatorsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxAuthorizeGlobalOperatorsItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+		}
+
+		if _, found := founds[it.Operator().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate operator found, %s", it.Operator())
+		}
+
+		founds[it.operator.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Items() []AuthorizeGlobalOperatorsItem {
+	return fact.items
+}
+
+func (fact AuthorizeGlobalOperatorsFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type AuthorizeGlobalOperators struct {
+	common.BaseOperation
+}
+
+func NewAuthorizeGlobalOperators(fact AuthorizeGlobalOperatorsFact) (AuthorizeGlobalOperators, error) {
+	return AuthorizeGlobalOperators{BaseOperation: common.NewBaseOperation(AuthorizeGlobalOperatorsHint, fact)}, nil
+}
+
+func (op *AuthorizeGlobalOperators) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package sto // nolint:dupl
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it AuthorizeGlobalOperatorsItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    it.Hint().String(),
+			"contract": it.contract,
+			"stoid":    it.stoID,
+			"operator": it.operator,
+			"currency": it.currency,
+		},
+	)
+}
+
+type AuthorizeGlobalOperatorsItemBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+	STO      string `bson:"stoid"`
+	Operator string `bson:"operator"`
+	Currency string `bson:"currency"`
+}
+
+func (it *AuthorizeGlobalOperatorsItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of AuthorizeGlobalOperatorsItem")
+
+	var uit AuthorizeGlobalOperatorsItemBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uit.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, ht, uit.Contract, uit.STO, uit.Operator, uit.Currency)
+}
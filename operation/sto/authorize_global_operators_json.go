@@ -0,0 +1,66 @@
+package sto
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AuthorizeGlobalOperatorsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner base.Address                   `json:"sender"`
+	Items []AuthorizeGlobalOperatorsItem `json:"items"`
+}
+
+func (fact AuthorizeGlobalOperatorsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuthorizeGlobalOperatorsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type AuthorizeGlobalOperatorsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner string          `json:"sender"`
+	Items json.RawMessage `json:"items"`
+}
+
+func (fact *AuthorizeGlobalOperatorsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of AuthorizeGlobalOperatorsFact")
+
+	var uf AuthorizeGlobalOperatorsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Items)
+}
+
+type AuthorizeGlobalOperatorsMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op AuthorizeGlobalOperators) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuthorizeGlobalOperatorsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AuthorizeGlobalOperators) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of AuthorizeGlobalOperators")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
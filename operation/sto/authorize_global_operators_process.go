@@ -0,0 +1,321 @@
+package sto
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var authorizeGlobalOperatorsItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuthorizeGlobalOperatorsItemProcessor)
+	},
+}
+
+var authorizeGlobalOperatorsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AuthorizeGlobalOperatorsProcessor)
+	},
+}
+
+func (AuthorizeGlobalOperators) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AuthorizeGlobalOperatorsItemProcessor struct {
+	h             util.Hash
+	sender        base.Address
+	item          AuthorizeGlobalOperatorsItem
+	operators     *[]base.Address
+	relations     *operatorRelations
+	networkPolicy networktypes.NetworkPolicy
+}
+
+func (ipp *AuthorizeGlobalOperatorsItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), getStateFunc); err != nil {
+		return err
+	}
+
+	if _, err := stostate.ExistsDesign(it.Contract(), it.STO(), getStateFunc); err != nil {
+		return err
+	}
+
+	for _, ad := range *ipp.operators {
+		if ad.Equal(it.Operator()) {
+			return errors.Errorf("operator is already in tokenholder global operators, %q", ad)
+		}
+	}
+
+	switch authorized, err := ipp.relations.isAuthorized(
+		newGlobalOperatorPair(it.Contract(), it.STO(), it.Operator(), ipp.sender), getStateFunc,
+	); {
+	case err != nil:
+		return err
+	case authorized:
+		return errors.Errorf("sender is already in global operator tokenholders, %q", ipp.sender)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *AuthorizeGlobalOperatorsItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	*ipp.operators = append(*ipp.operators, it.Operator())
+	if n := len(*ipp.operators); uint64(n) > ipp.networkPolicy.MaxOperatorsInOperators() {
+		return nil, errors.Errorf("tokenholder global operators over %d, %d", ipp.networkPolicy.MaxOperatorsInOperators(), n)
+	}
+
+	if err := ipp.relations.authorize(
+		newGlobalOperatorPair(it.Contract(), it.STO(), it.Operator(), ipp.sender),
+		ipp.networkPolicy.MaxTokenHoldersInHolders(),
+		getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (ipp *AuthorizeGlobalOperatorsItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = AuthorizeGlobalOperatorsItem{}
+	ipp.operators = nil
+	ipp.relations = nil
+	ipp.networkPolicy = networktypes.NetworkPolicy{}
+
+	authorizeGlobalOperatorsItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type AuthorizeGlobalOperatorsProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewAuthorizeGlobalOperatorsProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AuthorizeGlobalOperatorsProcessor")
+
+		nopp := authorizeGlobalOperatorsProcessorPool.Get()
+		opp, ok := nopp.(*AuthorizeGlobalOperatorsProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected AuthorizeGlobalOperatorsProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *AuthorizeGlobalOperatorsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess AuthorizeGlobalOperators")
+
+	fact, ok := op.Fact().(AuthorizeGlobalOperatorsFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected AuthorizeGlobalOperatorsFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot set its operators, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	operators := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
+
+	for _, it := range fact.Items() {
+		k := stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.Sender())
+		if _, found := operators[k]; !found {
+			ops, err := loadTokenHolderOperators(k, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			operators[k] = ops
+		}
+	}
+
+	for _, it := range fact.Items() {
+		ip := authorizeGlobalOperatorsItemProcessorPool.Get()
+		ipc, ok := ip.(*AuthorizeGlobalOperatorsItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected AuthorizeGlobalOperatorsItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.operators = operators[stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.sender)]
+		ipc.relations = relations
+		ipc.networkPolicy = networkPolicy
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AuthorizeGlobalOperatorsItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AuthorizeGlobalOperatorsProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process AuthorizeGlobalOperators")
+
+	fact, ok := op.Fact().(AuthorizeGlobalOperatorsFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected AuthorizeGlobalOperatorsFact, not %T", op.Fact()))
+	}
+
+	operators := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
+
+	for _, it := range fact.Items() {
+		k := stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.Sender())
+		if _, found := operators[k]; !found {
+			ops, err := loadTokenHolderOperators(k, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			operators[k] = ops
+		}
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	ipcs := make([]*AuthorizeGlobalOperatorsItemProcessor, len(fact.items))
+	for i, it := range fact.Items() {
+		ip := authorizeGlobalOperatorsItemProcessorPool.Get()
+		ipc, ok := ip.(*AuthorizeGlobalOperatorsItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected AuthorizeGlobalOperatorsItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.operators = operators[stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.sender)]
+		ipc.relations = relations
+		ipc.networkPolicy = networkPolicy
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process AuthorizeGlobalOperatorsItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipcs[i] = ipc
+	}
+
+	for k, v := range operators {
+		sts = append(sts, currencystate.NewStateMergeValue(
+			k,
+			stostate.NewTokenHolderOperatorsStateValue(*v),
+		))
+	}
+
+	sts = append(sts, relations.stateMergeValues()...)
+
+	for _, ipc := range ipcs {
+		ipc.Close()
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *AuthorizeGlobalOperatorsProcessor) Close() error {
+	authorizeGlobalOperatorsProcessorPool.Put(opp)
+
+	return nil
+}
+
+func loadTokenHolderOperators(k string, getStateFunc base.GetStateFunc) (*[]base.Address, error) {
+	ops := []base.Address{}
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Errorf("failed to find tokenholder global operators, %s: %v", k, err)
+	case found:
+		ops, err = stostate.StateTokenHolderOperatorsValue(st)
+		if err != nil {
+			return nil, errors.Errorf("failed to get tokenholder global operators, %s: %v", k, err)
+		}
+	}
+
+	return &ops, nil
+}
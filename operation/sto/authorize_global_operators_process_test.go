@@ -0,0 +1,119 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestAuthorizeGlobalOperatorsProcess(t *testing.T) {
+	item := func(f *fixture, operator base.Address) sto.AuthorizeGlobalOperatorsItem {
+		return sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, operator, f.currency)
+	}
+
+	isGlobalOperator := func(f *fixture, operator base.Address) bool {
+		ok, err := stostate.IsTokenHolderGlobalOperator(f.contract, f.stoID, f.holder.Address, operator, f.GetStateFunc)
+		if err != nil {
+			f.t.Fatalf("failed to check global operator: %+v", err)
+		}
+
+		return ok
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "authorize",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				if !isGlobalOperator(f, f.operator.Address) {
+					f.t.Error("global operator not authorized")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "global operator transfers any partition",
+			prepare: func(f *fixture) {
+				f.mustProcess(f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.holder.Address, common.NewBig(100), "PTB", f.currency),
+				))
+				f.mustProcess(f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, item(f, f.operator.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						f.contract, f.stoID, f.holder.Address, f.receiver.Address, "PTB", common.NewBig(30), f.currency,
+					),
+				)
+			},
+			check: func(f *fixture) {
+				if b := f.TokenHolderBalance(f.contract, f.stoID, f.receiver.Address, "PTB"); !b.Equal(common.NewBig(30)) {
+					f.t.Errorf("expected tokenholder balance 30, but %s", b)
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeGlobalOperators(
+					f.holder.Address, item(f, f.operator.Address), item(f, f.receiver.Address))
+			},
+			check: func(f *fixture) {
+				if !isGlobalOperator(f, f.operator.Address) || !isGlobalOperator(f, f.receiver.Address) {
+					f.t.Error("global operators not authorized")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "already authorized",
+			prepare: func(f *fixture) {
+				f.mustProcess(f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, item(f, f.operator.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "operator is already in tokenholder global operators",
+		},
+		{
+			name: "operators over network policy",
+			prepare: func(f *fixture) {
+				items := make([]sto.AuthorizeGlobalOperatorsItem, 10)
+				for i := range items {
+					items[i] = item(f, f.NewAddress())
+				}
+
+				f.mustProcess(f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, items...))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "tokenholder global operators over 10",
+		},
+		{
+			name: "unknown sto",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewAuthorizeGlobalOperatorsItem(f.contract, "STB", f.operator.Address, f.currency)
+
+				return f.holder.Builder().AuthorizeGlobalOperators(f.holder.Address, it)
+			},
+			reason: "sto not found",
+		},
+		{
+			name: "insufficient balance",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().AuthorizeGlobalOperators(f.poor.Address, item(f, f.operator.Address))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
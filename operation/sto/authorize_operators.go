@@ -2,6 +2,7 @@ package sto
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	AuthorizeOperatorsHint     = hint.MustNewHint("mitum-sto-authorize-operator-operation-v0.0.1")
 )
 
-var MaxAuthorizeOperatorsItems uint = 10
+var MaxAuthorizeOperatorsItems = uint(networktypes.MaxItemsLimit)
 
 type AuthorizeOperatorsFact struct {
 	base.BaseFact
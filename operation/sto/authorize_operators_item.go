@@ -1,6 +1,7 @@
 package sto
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -16,6 +17,8 @@ type AuthorizeOperatorsItem struct {
 	stoID     currencytypes.ContractID // token id
 	operator  base.Address             // initial controllers
 	partition stotypes.Partition       // partition
+	allowance common.Big               // maximum amount operator may spend, unlimited if not over nil
+	expiry    base.Height              // last height operator may act, no expiry if zero
 	currency  currencytypes.CurrencyID // fee
 }
 
@@ -24,6 +27,8 @@ func NewAuthorizeOperatorsItem(
 	stoID currencytypes.ContractID,
 	operator base.Address,
 	partition stotypes.Partition,
+	allowance common.Big,
+	expiry base.Height,
 	currency currencytypes.CurrencyID,
 ) AuthorizeOperatorsItem {
 	return AuthorizeOperatorsItem{
@@ -32,18 +37,31 @@ func NewAuthorizeOperatorsItem(
 		stoID:      stoID,
 		operator:   operator,
 		partition:  partition,
+		allowance:  allowance,
+		expiry:     expiry,
 		currency:   currency,
 	}
 }
 
 func (it AuthorizeOperatorsItem) Bytes() []byte {
-	return util.ConcatBytesSlice(
+	bs := [][]byte{
 		it.contract.Bytes(),
 		it.stoID.Bytes(),
 		it.operator.Bytes(),
 		it.partition.Bytes(),
-		it.currency.Bytes(),
-	)
+	}
+
+	if it.allowance.OverNil() {
+		bs = append(bs, it.allowance.Bytes())
+	}
+
+	if it.expiry > 0 {
+		bs = append(bs, it.expiry.Bytes())
+	}
+
+	bs = append(bs, it.currency.Bytes())
+
+	return util.ConcatBytesSlice(bs...)
 }
 
 func (it AuthorizeOperatorsItem) IsValid([]byte) error {
@@ -55,6 +73,14 @@ func (it AuthorizeOperatorsItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("contract address is same with operator, %q", it.contract)
 	}
 
+	if it.allowance.OverNil() && !it.allowance.OverZero() {
+		return util.ErrInvalid.Errorf("allowance must be over zero")
+	}
+
+	if it.expiry < 0 {
+		return util.ErrInvalid.Errorf("invalid expiry height, %d", it.expiry)
+	}
+
 	return nil
 }
 
@@ -74,6 +100,14 @@ func (it AuthorizeOperatorsItem) Partition() stotypes.Partition {
 	return it.partition
 }
 
+func (it AuthorizeOperatorsItem) Allowance() common.Big {
+	return it.allowance
+}
+
+func (it AuthorizeOperatorsItem) Expiry() base.Height {
+	return it.expiry
+}
+
 func (it AuthorizeOperatorsItem) Currency() currencytypes.CurrencyID {
 	return it.currency
 }
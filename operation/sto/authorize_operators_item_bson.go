@@ -2,6 +2,7 @@ package sto // nolint:dupl
 
 import (
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -15,18 +16,22 @@ func (it AuthorizeOperatorsItem) MarshalBSON() ([]byte, error) {
 			"stoid":     it.stoID,
 			"operator":  it.operator,
 			"partition": it.partition,
+			"allowance": it.allowance.String(),
+			"expiry":    it.expiry,
 			"currency":  it.currency,
 		},
 	)
 }
 
 type AuthorizeOperatorsItemBSONUnmarshaler struct {
-	Hint      string `bson:"_hint"`
-	Contract  string `bson:"contract"`
-	STO       string `bson:"stoid"`
-	Operator  string `bson:"operator"`
-	Partition string `bson:"partition"`
-	Currency  string `bson:"currency"`
+	Hint      string      `bson:"_hint"`
+	Contract  string      `bson:"contract"`
+	STO       string      `bson:"stoid"`
+	Operator  string      `bson:"operator"`
+	Partition string      `bson:"partition"`
+	Allowance string      `bson:"allowance"`
+	Expiry    base.Height `bson:"expiry"`
+	Currency  string      `bson:"currency"`
 }
 
 func (it *AuthorizeOperatorsItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -42,5 +47,5 @@ func (it *AuthorizeOperatorsItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) err
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Contract, uit.STO, uit.Operator, uit.Partition, uit.Currency)
+	return it.unpack(enc, ht, uit.Contract, uit.STO, uit.Operator, uit.Partition, uit.Allowance, uit.Expiry, uit.Currency)
 }
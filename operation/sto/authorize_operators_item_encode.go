@@ -1,6 +1,7 @@
 package sto
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -9,12 +10,13 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-func (it *AuthorizeOperatorsItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, sto, op, pt, cid string) error {
+func (it *AuthorizeOperatorsItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, sto, op, pt, al string, ex base.Height, cid string) error {
 	e := util.StringError("failed to unmarshal AuthorizeOperatorsItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.stoID = currencytypes.ContractID(sto)
 	it.partition = stotypes.Partition(pt)
+	it.expiry = ex
 	it.currency = currencytypes.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(ca, enc); {
@@ -31,5 +33,15 @@ func (it *AuthorizeOperatorsItem) unpack(enc encoder.Encoder, ht hint.Hint, ca,
 		it.operator = a
 	}
 
+	if len(al) == 0 {
+		it.allowance = common.NilBig
+	} else {
+		allowance, err := common.NewBigFromString(al)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		it.allowance = allowance
+	}
+
 	return nil
 }
@@ -15,6 +15,8 @@ type AuthorizeOperatorsItemJSONMarshaler struct {
 	STO       currencytypes.ContractID `json:"stoid"`
 	Operator  base.Address             `json:"operator"`
 	Partition stotypes.Partition       `json:"partition"`
+	Allowance string                   `json:"allowance"`
+	Expiry    base.Height              `json:"expiry"`
 	Currency  currencytypes.CurrencyID `json:"currency"`
 }
 
@@ -25,17 +27,21 @@ func (it AuthorizeOperatorsItem) MarshalJSON() ([]byte, error) {
 		STO:        it.stoID,
 		Operator:   it.operator,
 		Partition:  it.partition,
+		Allowance:  it.allowance.String(),
+		Expiry:     it.expiry,
 		Currency:   it.currency,
 	})
 }
 
 type AuthorizeOperatorsItemJSONUnMarshaler struct {
-	Hint      hint.Hint `json:"_hint"`
-	Contract  string    `json:"contract"`
-	STO       string    `json:"stoid"`
-	Operator  string    `json:"operator"`
-	Partition string    `json:"partition"`
-	Currency  string    `json:"currency"`
+	Hint      hint.Hint   `json:"_hint"`
+	Contract  string      `json:"contract"`
+	STO       string      `json:"stoid"`
+	Operator  string      `json:"operator"`
+	Partition string      `json:"partition"`
+	Allowance string      `json:"allowance"`
+	Expiry    base.Height `json:"expiry"`
+	Currency  string      `json:"currency"`
 }
 
 func (it *AuthorizeOperatorsItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -46,5 +52,5 @@ func (it *AuthorizeOperatorsItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) err
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Contract, uit.STO, uit.Operator, uit.Partition, uit.Currency)
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.STO, uit.Operator, uit.Partition, uit.Allowance, uit.Expiry, uit.Currency)
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 	"sync"
 
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/pkg/errors"
@@ -34,11 +36,13 @@ func (AuthorizeOperators) Process(
 }
 
 type AuthorizeOperatorsItemProcessor struct {
-	h            util.Hash
-	sender       base.Address
-	item         AuthorizeOperatorsItem
-	operators    *[]base.Address
-	tokenHolders *[]base.Address
+	h             util.Hash
+	sender        base.Address
+	item          AuthorizeOperatorsItem
+	operators     *[]base.Address
+	relations     *operatorRelations
+	networkPolicy networktypes.NetworkPolicy
+	height        base.Height
 }
 
 func (ipp *AuthorizeOperatorsItemProcessor) PreProcess(
@@ -50,7 +54,7 @@ func (ipp *AuthorizeOperatorsItemProcessor) PreProcess(
 		return err
 	}
 
-	if err := currencystate.CheckExistsState(stostate.StateKeyDesign(it.Contract(), it.STO()), getStateFunc); err != nil {
+	if _, err := stostate.ExistsDesign(it.Contract(), it.STO(), getStateFunc); err != nil {
 		return err
 	}
 
@@ -83,10 +87,17 @@ func (ipp *AuthorizeOperatorsItemProcessor) PreProcess(
 		}
 	}
 
-	for _, ad := range *ipp.tokenHolders {
-		if ad.Equal(ipp.sender) {
-			return errors.Errorf("sender is already in operator tokenholders, %q", ad)
-		}
+	switch authorized, err := ipp.relations.isAuthorized(
+		newOperatorPair(it.Contract(), it.STO(), it.Operator(), it.Partition(), ipp.sender), getStateFunc,
+	); {
+	case err != nil:
+		return err
+	case authorized:
+		return errors.Errorf("sender is already in operator tokenholders, %q", ipp.sender)
+	}
+
+	if it.Expiry() > 0 && it.Expiry() <= ipp.height {
+		return errors.Errorf("expiry height already passed, %d <= %d", it.Expiry(), ipp.height)
 	}
 
 	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
@@ -104,11 +115,26 @@ func (ipp *AuthorizeOperatorsItemProcessor) Process(
 	it := ipp.item
 
 	*ipp.operators = append(*ipp.operators, it.Operator())
-	holders := append(*ipp.tokenHolders, ipp.sender)
+	if n := len(*ipp.operators); uint64(n) > ipp.networkPolicy.MaxOperatorsInOperators() {
+		return nil, errors.Errorf("tokenholder partition operators over %d, %d", ipp.networkPolicy.MaxOperatorsInOperators(), n)
+	}
+
+	if err := ipp.relations.authorize(
+		newOperatorPair(it.Contract(), it.STO(), it.Operator(), it.Partition(), ipp.sender),
+		ipp.networkPolicy.MaxTokenHoldersInHolders(),
+		getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
+	allowance := it.Allowance()
+	if !allowance.OverNil() {
+		allowance = common.NilBig
+	}
 
 	sts[0] = currencystate.NewStateMergeValue(
-		stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition()),
-		stostate.NewOperatorTokenHoldersStateValue(holders),
+		stostate.StateKeyOperatorAllowance(it.Contract(), it.STO(), ipp.sender, it.Partition(), it.Operator()),
+		stostate.NewOperatorAllowanceStateValue(allowance, it.Expiry()),
 	)
 
 	return sts, nil
@@ -119,7 +145,9 @@ func (ipp *AuthorizeOperatorsItemProcessor) Close() error {
 	ipp.sender = nil
 	ipp.item = AuthorizeOperatorsItem{}
 	ipp.operators = nil
-	ipp.tokenHolders = nil
+	ipp.relations = nil
+	ipp.networkPolicy = networktypes.NetworkPolicy{}
+	ipp.height = 0
 
 	authorizeOperatorsItemProcessorPool.Put(ipp)
 
@@ -171,6 +199,15 @@ func (opp *AuthorizeOperatorsProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -184,10 +221,10 @@ func (opp *AuthorizeOperatorsProcessor) PreProcess(
 	}
 
 	operators := map[string]*[]base.Address{}
-	holders := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
 
 	for _, it := range fact.Items() {
-		var ops, hds []base.Address
+		var ops []base.Address
 
 		k := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())
 		if _, found := operators[k]; !found {
@@ -205,22 +242,6 @@ func (opp *AuthorizeOperatorsProcessor) PreProcess(
 			operators[k] = &ops
 		}
 
-		k = stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())
-		if _, found := holders[k]; !found {
-			switch st, found, err := getStateFunc(k); {
-			case err != nil:
-				return nil, base.NewBaseOperationProcessReasonError("failed to find operator tokenholders, %s: %w", k, err), nil
-			case found:
-				hds, err = stostate.StateOperatorTokenHoldersValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to get operator tokenholders, %s: %w", k, err), nil
-				}
-			default:
-				hds = []base.Address{}
-			}
-
-			holders[k] = &hds
-		}
 	}
 
 	for _, it := range fact.Items() {
@@ -234,7 +255,9 @@ func (opp *AuthorizeOperatorsProcessor) PreProcess(
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.operators = operators[stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())]
-		ipc.tokenHolders = holders[stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())]
+		ipc.relations = relations
+		ipc.networkPolicy = networkPolicy
+		ipc.height = opp.Height()
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess AuthorizeOperatorsItem: %w", err), nil
@@ -257,13 +280,18 @@ func (opp *AuthorizeOperatorsProcessor) Process( // nolint:dupl
 		return nil, nil, e.Wrap(errors.Errorf("expected AuthorizeOperatorsFact, not %T", op.Fact()))
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
 	var sts []base.StateMergeValue // nolint:prealloc
 
 	operators := map[string]*[]base.Address{}
-	holders := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
 
 	for _, it := range fact.Items() {
-		var ops, hds []base.Address
+		var ops []base.Address
 
 		k := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())
 		if _, found := operators[k]; !found {
@@ -281,22 +309,6 @@ func (opp *AuthorizeOperatorsProcessor) Process( // nolint:dupl
 			operators[k] = &ops
 		}
 
-		k = stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())
-		if _, found := holders[k]; !found {
-			switch st, found, err := getStateFunc(k); {
-			case err != nil:
-				return nil, base.NewBaseOperationProcessReasonError("failed to find operator tokenholders, %s: %w", k, err), nil
-			case found:
-				hds, err = stostate.StateOperatorTokenHoldersValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to get operator tokenholders, %s: %w", k, err), nil
-				}
-			default:
-				hds = []base.Address{}
-			}
-
-			holders[k] = &hds
-		}
 	}
 
 	ipcs := make([]*AuthorizeOperatorsItemProcessor, len(fact.items))
@@ -311,7 +323,9 @@ func (opp *AuthorizeOperatorsProcessor) Process( // nolint:dupl
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.operators = operators[stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())]
-		ipc.tokenHolders = holders[stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())]
+		ipc.relations = relations
+		ipc.networkPolicy = networkPolicy
+		ipc.height = opp.Height()
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -329,35 +343,23 @@ func (opp *AuthorizeOperatorsProcessor) Process( // nolint:dupl
 		))
 	}
 
+	sts = append(sts, relations.stateMergeValues()...)
+
 	for _, ipc := range ipcs {
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *AuthorizeOperatorsProcessor) Close() error {
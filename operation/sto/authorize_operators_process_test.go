@@ -0,0 +1,220 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestAuthorizeOperatorsProcess(t *testing.T) {
+	item := func(f *fixture, operator base.Address) sto.AuthorizeOperatorsItem {
+		return sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, operator, f.partition, common.NilBig, 0, f.currency)
+	}
+
+	isOperator := func(f *fixture, operator base.Address) bool {
+		ok, err := stostate.IsTokenHolderOperator(f.contract, f.stoID, f.holder.Address, f.partition, operator, f.GetStateFunc)
+		if err != nil {
+			f.t.Fatalf("failed to check operator: %+v", err)
+		}
+
+		return ok
+	}
+
+	allowance := func(f *fixture, operator base.Address) stostate.OperatorAllowanceStateValue {
+		st, found := f.State(stostate.StateKeyOperatorAllowance(f.contract, f.stoID, f.holder.Address, f.partition, operator))
+		if !found {
+			f.t.Fatal("operator allowance not found")
+		}
+
+		v, err := stostate.StateOperatorAllowanceValue(st)
+		if err != nil {
+			f.t.Fatalf("invalid operator allowance: %+v", err)
+		}
+
+		return v
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "authorize",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				if !isOperator(f, f.operator.Address) {
+					f.t.Error("operator not authorized")
+				}
+
+				if allowance(f, f.operator.Address).IsLimited() {
+					f.t.Error("expected unlimited allowance")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "allowance and expiry",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewAuthorizeOperatorsItem(
+					f.contract, f.stoID, f.operator.Address, f.partition, common.NewBig(50), f.Height()+5, f.currency,
+				)
+
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, it)
+			},
+			check: func(f *fixture) {
+				a := allowance(f, f.operator.Address)
+
+				if !a.Amount.Equal(common.NewBig(50)) {
+					f.t.Errorf("expected allowance 50, but %s", a.Amount)
+				}
+
+				if a.Expiry != f.Height()+4 {
+					f.t.Errorf("expected expiry %d, but %d", f.Height()+4, a.Expiry)
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeOperators(
+					f.holder.Address, item(f, f.operator.Address), item(f, f.receiver.Address))
+			},
+			check: func(f *fixture) {
+				if !isOperator(f, f.operator.Address) || !isOperator(f, f.receiver.Address) {
+					f.t.Error("operators not authorized")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "tokenholders in the same block",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+				f.issue(f.owner.Address, 100)
+				f.mustProcessBlock(
+					func() (base.Operation, error) {
+						return f.holder.Builder().AuthorizeOperators(f.holder.Address, item(f, f.operator.Address))
+					},
+					func() (base.Operation, error) {
+						return f.receiver.Builder().AuthorizeOperators(f.receiver.Address, item(f, f.operator.Address))
+					},
+				)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().AuthorizeOperators(f.owner.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				st, found := f.State(stostate.StateKeyOperatorTokenHoldersCount(
+					f.contract, f.stoID, f.operator.Address, f.partition))
+				if !found {
+					f.t.Fatal("operator tokenholders count not found")
+				}
+
+				if count, err := stostate.StateOperatorTokenHoldersCountValue(st); err != nil || count != 3 {
+					f.t.Errorf("expected 3 tokenholders of operator, but %d, %v", count, err)
+				}
+			},
+		},
+		{
+			name: "already authorized",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "operator is already in tokenholder operators",
+		},
+		{
+			name: "operators over network policy",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+
+				items := make([]sto.AuthorizeOperatorsItem, 10)
+				for i := range items {
+					items[i] = item(f, f.NewAddress())
+				}
+
+				f.mustProcess(f.holder.Builder().AuthorizeOperators(f.holder.Address, items...))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "tokenholder partition operators over 10",
+		},
+		{
+			name: "not tokenholder",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "tokenholder partitions not found",
+		},
+		{
+			name: "partition not held",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.receiver.Address, common.NewBig(100), "PTB", f.currency),
+				))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, f.operator.Address, "PTB", common.NilBig, 0, f.currency)
+
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, it)
+			},
+			reason: "partition not in tokenholder partitions",
+		},
+		{
+			name: "unknown partition",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, f.operator.Address, "PTB", common.NilBig, 0, f.currency)
+
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, it)
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "expiry passed",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewAuthorizeOperatorsItem(
+					f.contract, f.stoID, f.operator.Address, f.partition, common.NilBig, f.Height(), f.currency,
+				)
+
+				return f.holder.Builder().AuthorizeOperators(f.holder.Address, it)
+			},
+			reason: "expiry height already passed",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.issue(f.poor.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().AuthorizeOperators(f.poor.Address, item(f, f.operator.Address))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
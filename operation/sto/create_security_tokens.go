@@ -5,6 +5,7 @@ import (
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -22,7 +23,7 @@ var (
 	CreateSecurityTokensHint     = hint.MustNewHint("mitum-sto-create-security-tokenss-operation-v0.0.1")
 )
 
-var MaxCreateSecurityTokensItems uint = 10
+var MaxCreateSecurityTokensItems = uint(networktypes.MaxItemsLimit)
 
 type CreateSecurityTokensFact struct {
 	base.BaseFact
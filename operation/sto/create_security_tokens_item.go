@@ -8,7 +8,13 @@ import (
 	"github.com/ProtoconNet/mitum2/util/hint"
 )
 
-var CreateSecurityTokensItemHint = hint.MustNewHint("mitum-sto-create-security-tokens-item-v0.0.1")
+var (
+	CreateSecurityTokensItemHint = hint.MustNewHint("mitum-sto-create-security-tokens-item-v0.0.2")
+	// LegacyCreateSecurityTokensItemHint is the item without kyc requirement;
+	// it is decoded with the legacy hint and keeps the bytes of the item, so
+	// the facts of stored blocks have the same hash.
+	LegacyCreateSecurityTokensItemHint = hint.MustNewHint("mitum-sto-create-security-tokens-item-v0.0.1")
+)
 
 type CreateSecurityTokensItem struct {
 	hint.BaseHinter
@@ -17,6 +23,7 @@ type CreateSecurityTokensItem struct {
 	granularity      uint64                   // token granulariry
 	defaultPartition stotypes.Partition       // default partitions
 	controllers      []base.Address           // initial controllers
+	kyc              stotypes.KYCRequirement  // kyc services of tokenholders
 	currency         currencytypes.CurrencyID // fee
 }
 
@@ -26,6 +33,7 @@ func NewCreateSecurityTokensItem(
 	granularity uint64,
 	partition stotypes.Partition,
 	controllers []base.Address,
+	kyc stotypes.KYCRequirement,
 	currency currencytypes.CurrencyID,
 ) CreateSecurityTokensItem {
 	return CreateSecurityTokensItem{
@@ -35,6 +43,7 @@ func NewCreateSecurityTokensItem(
 		granularity:      granularity,
 		defaultPartition: partition,
 		controllers:      controllers,
+		kyc:              kyc,
 		currency:         currency,
 	}
 }
@@ -46,12 +55,18 @@ func (it CreateSecurityTokensItem) Bytes() []byte {
 		bc[i] = con.Bytes()
 	}
 
+	var kb []byte
+	if !it.isLegacy() {
+		kb = it.kyc.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.stoID.Bytes(),
 		util.Uint64ToBytes(it.granularity),
 		it.defaultPartition.Bytes(),
 		util.ConcatBytesSlice(bc...),
+		kb,
 		it.currency.Bytes(),
 	)
 }
@@ -62,6 +77,7 @@ func (it CreateSecurityTokensItem) IsValid([]byte) error {
 		it.contract,
 		it.stoID,
 		it.defaultPartition,
+		it.kyc,
 		it.currency,
 	); err != nil {
 		return err
@@ -91,6 +107,10 @@ func (it CreateSecurityTokensItem) IsValid([]byte) error {
 	return nil
 }
 
+func (it CreateSecurityTokensItem) isLegacy() bool {
+	return it.Hint().Equal(LegacyCreateSecurityTokensItemHint)
+}
+
 func (it CreateSecurityTokensItem) Contract() base.Address {
 	return it.contract
 }
@@ -111,6 +131,10 @@ func (it CreateSecurityTokensItem) Controllers() []base.Address {
 	return it.controllers
 }
 
+func (it CreateSecurityTokensItem) KYC() stotypes.KYCRequirement {
+	return it.kyc
+}
+
 func (it CreateSecurityTokensItem) Currency() currencytypes.CurrencyID {
 	return it.currency
 }
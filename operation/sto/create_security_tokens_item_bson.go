@@ -8,6 +8,20 @@ import (
 )
 
 func (it CreateSecurityTokensItem) MarshalBSON() ([]byte, error) {
+	if it.isLegacy() {
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":             it.Hint().String(),
+				"contract":          it.contract,
+				"stoid":             it.stoID,
+				"granularity":       it.granularity,
+				"default_partition": it.defaultPartition,
+				"controllers":       it.controllers,
+				"currency":          it.currency,
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":             it.Hint().String(),
@@ -16,6 +30,7 @@ func (it CreateSecurityTokensItem) MarshalBSON() ([]byte, error) {
 			"granularity":       it.granularity,
 			"default_partition": it.defaultPartition,
 			"controllers":       it.controllers,
+			"kyc":               it.kyc,
 			"currency":          it.currency,
 		},
 	)
@@ -28,6 +43,7 @@ type CreateSecurityTokensItemBSONUnmarshaler struct {
 	Granularity      uint64   `bson:"granularity"`
 	DefaultPartition string   `bson:"default_partition"`
 	Controllers      []string `bson:"controllers"`
+	KYC              bson.Raw `bson:"kyc"`
 	Currency         string   `bson:"currency"`
 }
 
@@ -44,5 +60,5 @@ func (it *CreateSecurityTokensItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, ht, uit.Contract, uit.STO, uit.Granularity, uit.DefaultPartition, uit.Controllers, uit.Currency)
+	return it.unpack(enc, ht, uit.Contract, uit.STO, uit.Granularity, uit.DefaultPartition, uit.Controllers, uit.KYC, uit.Currency)
 }
@@ -7,9 +7,10 @@ import (
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
-func (it *CreateSecurityTokensItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, sto string, granularity uint64, partition string, bcs []string, cid string) error {
+func (it *CreateSecurityTokensItem) unpack(enc encoder.Encoder, ht hint.Hint, ca, sto string, granularity uint64, partition string, bcs []string, bk []byte, cid string) error {
 	e := util.StringError("failed to unmarshal CreateSecurityTokensItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
@@ -35,5 +36,19 @@ func (it *CreateSecurityTokensItem) unpack(enc encoder.Encoder, ht hint.Hint, ca
 	}
 	it.controllers = controllers
 
+	if ht.Equal(LegacyCreateSecurityTokensItemHint) {
+		it.kyc = stotypes.EmptyKYCRequirement()
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bk); err != nil {
+		return e.Wrap(err)
+	} else if kyc, ok := hinter.(stotypes.KYCRequirement); !ok {
+		return e.Wrap(errors.Errorf("expected KYCRequirement, not %T", hinter))
+	} else {
+		it.kyc = kyc
+	}
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package sto
 
 import (
+	"encoding/json"
+
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -10,6 +12,17 @@ import (
 )
 
 type CreateSecurityTokensItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract         base.Address             `json:"contract"`
+	STO              currencytypes.ContractID `json:"stoid"`
+	Granularity      uint64                   `json:"granularity"`
+	DefaultPartition stotypes.Partition       `json:"default_partition"`
+	Controllers      []base.Address           `json:"controllers"`
+	KYC              stotypes.KYCRequirement  `json:"kyc"`
+	Currency         currencytypes.CurrencyID `json:"currency"`
+}
+
+type LegacyCreateSecurityTokensItemJSONMarshaler struct {
 	hint.BaseHinter
 	Contract         base.Address             `json:"contract"`
 	STO              currencytypes.ContractID `json:"stoid"`
@@ -20,6 +33,18 @@ type CreateSecurityTokensItemJSONMarshaler struct {
 }
 
 func (it CreateSecurityTokensItem) MarshalJSON() ([]byte, error) {
+	if it.isLegacy() {
+		return util.MarshalJSON(LegacyCreateSecurityTokensItemJSONMarshaler{
+			BaseHinter:       it.BaseHinter,
+			Contract:         it.contract,
+			STO:              it.stoID,
+			Granularity:      it.granularity,
+			DefaultPartition: it.defaultPartition,
+			Controllers:      it.controllers,
+			Currency:         it.currency,
+		})
+	}
+
 	return util.MarshalJSON(CreateSecurityTokensItemJSONMarshaler{
 		BaseHinter:       it.BaseHinter,
 		Contract:         it.contract,
@@ -27,18 +52,20 @@ func (it CreateSecurityTokensItem) MarshalJSON() ([]byte, error) {
 		Granularity:      it.granularity,
 		DefaultPartition: it.defaultPartition,
 		Controllers:      it.controllers,
+		KYC:              it.kyc,
 		Currency:         it.currency,
 	})
 }
 
 type CreateSecurityTokensItemJSONUnMarshaler struct {
-	Hint             hint.Hint `json:"_hint"`
-	Contract         string    `json:"contract"`
-	STO              string    `json:"stoid"`
-	Granularity      uint64    `json:"granularity"`
-	DefaultPartition string    `json:"default_partition"`
-	Controllers      []string  `json:"controllers"`
-	Currency         string    `json:"currency"`
+	Hint             hint.Hint       `json:"_hint"`
+	Contract         string          `json:"contract"`
+	STO              string          `json:"stoid"`
+	Granularity      uint64          `json:"granularity"`
+	DefaultPartition string          `json:"default_partition"`
+	Controllers      []string        `json:"controllers"`
+	KYC              json.RawMessage `json:"kyc"`
+	Currency         string          `json:"currency"`
 }
 
 func (it *CreateSecurityTokensItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -49,5 +76,5 @@ func (it *CreateSecurityTokensItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) e
 		return e.Wrap(err)
 	}
 
-	return it.unpack(enc, uit.Hint, uit.Contract, uit.STO, uit.Granularity, uit.DefaultPartition, uit.Controllers, uit.Currency)
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.STO, uit.Granularity, uit.DefaultPartition, uit.Controllers, uit.KYC, uit.Currency)
 }
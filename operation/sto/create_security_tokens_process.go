@@ -5,11 +5,11 @@ import (
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -64,6 +64,10 @@ func (ipp *CreateSecurityTokensItemProcessor) PreProcess(
 		}
 	}
 
+	if err := checkKYCServices(it.KYC(), getStateFunc); err != nil {
+		return err
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
 		return err
 	}
@@ -82,8 +86,8 @@ func (ipp *CreateSecurityTokensItemProcessor) Process(
 	partitions := []stotypes.Partition{partition}
 	documents := []stotypes.Document{}
 
-	policy := stotypes.NewPolicy(partitions, common.NewBig(0), it.Controllers(), documents)
-	design := stotypes.NewDesign(it.STO(), it.Granularity(), policy)
+	policy := stotypes.NewPolicy(partitions, common.NewBig(0), it.Controllers(), documents, it.KYC())
+	design := stotypes.NewDesign(it.STO(), it.Granularity(), policy, stotypes.EmptyJurisdictionRule())
 
 	if err := design.IsValid(nil); err != nil {
 		return nil, err
@@ -156,6 +160,21 @@ func (opp *CreateSecurityTokensProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	for _, it := range fact.Items() {
+		if err := networkPolicy.CheckPartition(it.DefaultPartition().String()); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -222,31 +241,17 @@ func (opp *CreateSecurityTokensProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *CreateSecurityTokensProcessor) Close() error {
@@ -254,33 +259,3 @@ func (opp *CreateSecurityTokensProcessor) Close() error {
 
 	return nil
 }
-
-func calculateSTOItemsFee(getStateFunc base.GetStateFunc, items []STOItem) (map[currencytypes.CurrencyID][2]common.Big, error) {
-	required := map[currencytypes.CurrencyID][2]common.Big{}
-
-	for _, item := range items {
-		rq := [2]common.Big{common.ZeroBig, common.ZeroBig}
-
-		if k, found := required[item.Currency()]; found {
-			rq = k
-		}
-
-		policy, err := currencystate.ExistsCurrencyPolicy(item.Currency(), getStateFunc)
-		if err != nil {
-			return nil, err
-		}
-
-		switch k, err := policy.Feeer().Fee(common.ZeroBig); {
-		case err != nil:
-			return nil, err
-		case !k.OverZero():
-			required[item.Currency()] = [2]common.Big{rq[0], rq[1]}
-		default:
-			required[item.Currency()] = [2]common.Big{rq[0].Add(k), rq[1].Add(k)}
-		}
-
-	}
-
-	return required, nil
-
-}
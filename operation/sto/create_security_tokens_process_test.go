@@ -0,0 +1,153 @@
+package sto_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestCreateSecurityTokensProcess(t *testing.T) {
+	item := func(f *fixture, stoID currencytypes.ContractID) sto.CreateSecurityTokensItem {
+		return sto.NewCreateSecurityTokensItem(
+			f.contract, stoID, 1, stotypes.Partition("PTA"), []base.Address{f.controller.Address}, stotypes.EmptyKYCRequirement(), f.currency,
+		)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "create",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, item(f, "NEW"))
+			},
+			check: func(f *fixture) {
+				design := f.STO(f.contract, "NEW")
+				if n := len(design.Policy().Partitions()); n != 1 {
+					f.t.Errorf("expected 1 partition, but %d", n)
+				}
+
+				if b := f.PartitionBalance(f.contract, "NEW", "PTA"); !b.IsZero() {
+					f.t.Errorf("expected zero partition balance, but %s", b)
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "multiple items",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, item(f, "STA"), item(f, "STB"))
+			},
+			check: func(f *fixture) {
+				f.STO(f.contract, "STA")
+				f.STO(f.contract, "STB")
+				f.checkBalance(f.holder.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "items over network policy",
+			op: func(f *fixture) (base.Operation, error) {
+				items := make([]sto.CreateSecurityTokensItem, 11)
+				for i := range items {
+					items[i] = item(f, currencytypes.ContractID(fmt.Sprintf("S%02d", i)))
+				}
+
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, items...)
+			},
+			reason: "over max",
+		},
+		{
+			name: "sto already exists",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, item(f, f.stoID))
+			},
+			reason: "already exists",
+		},
+		{
+			name: "not contract account",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewCreateSecurityTokensItem(
+					f.receiver.Address, "NEW", 1, "PTA", []base.Address{f.controller.Address}, stotypes.EmptyKYCRequirement(), f.currency,
+				)
+
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, it)
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "unknown controller",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewCreateSecurityTokensItem(
+					f.contract, "NEW", 1, "PTA", []base.Address{f.NewAddress()}, stotypes.EmptyKYCRequirement(), f.currency,
+				)
+
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, it)
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "unknown kyc service",
+			op: func(f *fixture) (base.Operation, error) {
+				kyc := stotypes.NewKYCRequirement(
+					[]stotypes.KYCService{stotypes.NewKYCService(f.contract, "KYC")}, stotypes.KYCModeAnyOf)
+				it := sto.NewCreateSecurityTokensItem(
+					f.contract, "NEW", 1, "PTA", []base.Address{f.controller.Address}, kyc, f.currency,
+				)
+
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, it)
+			},
+			reason: "kyc service not found",
+		},
+		{
+			name: "unknown currency",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewCreateSecurityTokensItem(
+					f.contract, "NEW", 1, "PTA", []base.Address{f.controller.Address}, stotypes.EmptyKYCRequirement(), "XXX",
+				)
+
+				return f.holder.Builder().CreateSecurityTokens(f.holder.Address, it)
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "unknown sender",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().CreateSecurityTokens(f.NewAddress(), item(f, "NEW"))
+			},
+			reason: "sender not found",
+		},
+		{
+			name: "contract account sender",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().CreateSecurityTokens(f.NewContractAccount(f.owner.Address), item(f, "NEW"))
+			},
+			reason: "contract account cannot create security tokens",
+		},
+		{
+			name: "signed by other account",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.receiver.Builder().CreateSecurityTokens(f.holder.Address, item(f, "NEW"))
+			},
+			reason: "invalid signing",
+		},
+		{
+			name: "insufficient balance",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().CreateSecurityTokens(f.poor.Address, item(f, "NEW"))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkBalance(f.poor.Address, common.NewBig(5))
+
+				if _, found := f.State(stostate.StateKeyDesign(f.contract, "NEW")); found {
+					f.t.Error("sto design should not be stored")
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,105 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/fee"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// OperationFees returns the fees of sto operation of fact, the fees of the
+// currency policies and the service fees of sto, which the processor of
+// operation pays. It returns nil for the fact of other operation.
+func OperationFees(fact base.Fact, getStateFunc base.GetStateFunc) (*fee.Fees, error) {
+	var items []STOItem
+	var operation stotypes.FeeOperation
+
+	switch t := fact.(type) {
+	case CreateSecurityTokensFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+	case IssueSecurityTokensFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationIssue
+	case TransferSecurityTokensPartitionFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationTransfer
+	case RedeemTokensFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationRedeem
+	case AuthorizeOperatorsFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationAuthorizeOperator
+	case RevokeOperatorsFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationRevokeOperator
+	case AuthorizeGlobalOperatorsFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationAuthorizeGlobalOperator
+	case RevokeGlobalOperatorsFact:
+		for _, it := range t.Items() {
+			items = append(items, it)
+		}
+		operation = stotypes.FeeOperationRevokeGlobalOperator
+	case SetDocumentFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	case UpdateJurisdictionRuleFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	case UpdateFeeScheduleFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	case MigrateSecurityTokensFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	case RecoverTokenHolderFact:
+		return currencyFee(t.Currency(), getStateFunc)
+	default:
+		return nil, nil
+	}
+
+	fees, err := calculateSTOItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(operation) > 0 {
+		if err := addServiceFees(fees, operation, items, getStateFunc); err != nil {
+			return nil, err
+		}
+	}
+
+	return fees, nil
+}
+
+// calculateSTOItemsFee collects the fees of the currency policies of items.
+func calculateSTOItemsFee(getStateFunc base.GetStateFunc, items []STOItem) (*fee.Fees, error) {
+	fees := fee.NewFees()
+
+	for _, item := range items {
+		if err := fees.AddCurrencyFee(item.Currency(), getStateFunc); err != nil {
+			return nil, err
+		}
+	}
+
+	return fees, nil
+}
+
+func currencyFee(cid currencytypes.CurrencyID, getStateFunc base.GetStateFunc) (*fee.Fees, error) {
+	fees := fee.NewFees()
+	if err := fees.AddCurrencyFee(cid, getStateFunc); err != nil {
+		return nil, err
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,242 @@
+package sto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+var (
+	fee     = common.NewBig(10)
+	balance = common.NewBig(1000)
+)
+
+// fixture is the sto, stoID of contract, which has one partition and one
+// controller, without kyc requirement.
+type fixture struct {
+	*test.States
+	t          *testing.T
+	currency   currencytypes.CurrencyID
+	feeer      test.Account
+	owner      test.Account
+	controller test.Account
+	holder     test.Account
+	receiver   test.Account
+	operator   test.Account
+	poor       test.Account
+	contract   base.Address
+	stoID      currencytypes.ContractID
+	partition  stotypes.Partition
+}
+
+func newFixture(t *testing.T) *fixture {
+	s := test.NewStates(t)
+
+	f := &fixture{
+		States:    s,
+		t:         t,
+		currency:  currencytypes.CurrencyID("MCC"),
+		stoID:     currencytypes.ContractID("STO"),
+		partition: stotypes.Partition("PTA"),
+	}
+
+	am := currencytypes.NewAmount(balance, f.currency)
+
+	f.feeer = s.NewAccount(currencytypes.NewAmount(common.ZeroBig, f.currency))
+	f.owner = s.NewAccount(am)
+	f.controller = s.NewAccount(am)
+	f.holder = s.NewAccount(am)
+	f.receiver = s.NewAccount(am)
+	f.operator = s.NewAccount(am)
+	f.poor = s.NewAccount(currencytypes.NewAmount(common.NewBig(5), f.currency))
+	f.contract = s.NewContractAccount(f.owner.Address)
+
+	s.SetCurrency(f.currency, f.feeer.Address, fee)
+	s.SetSTO(f.contract, f.design(stotypes.EmptyKYCRequirement(), stotypes.EmptyJurisdictionRule()))
+
+	return f
+}
+
+func (f *fixture) design(kyc stotypes.KYCRequirement, rule stotypes.JurisdictionRule) stotypes.Design {
+	return f.stoDesign(f.stoID, 1, kyc, rule)
+}
+
+// stoDesign is the design of sto, stoID with the partition and the controller
+// of fixture.
+func (f *fixture) stoDesign(
+	stoID currencytypes.ContractID, granularity uint64, kyc stotypes.KYCRequirement, rule stotypes.JurisdictionRule,
+) stotypes.Design {
+	return stotypes.NewDesign(
+		stoID,
+		granularity,
+		stotypes.NewPolicy(
+			[]stotypes.Partition{f.partition},
+			common.ZeroBig,
+			[]base.Address{f.controller.Address},
+			[]stotypes.Document{},
+			kyc,
+		),
+		rule,
+	)
+}
+
+func (f *fixture) amount(n int64) currencytypes.Amount {
+	return currencytypes.NewAmount(common.NewBig(n), f.currency)
+}
+
+// requireKYC makes the sto require kyc service, "KYC" of new contract account,
+// which verifies customers; the contract account is returned.
+func (f *fixture) requireKYC(rule stotypes.JurisdictionRule, customers map[string]kyctypes.Jurisdiction) base.Address {
+	kycContract := f.NewContractAccount(f.owner.Address)
+	kycID := currencytypes.ContractID("KYC")
+
+	f.SetKYC(kycContract, kyctypes.NewDesign(kycID, kyctypes.NewPolicy([]kyctypes.Controller{
+		kyctypes.NewController(f.controller.Address, []kyctypes.Role{kyctypes.RoleReviewer, kyctypes.RoleApprover}),
+	}, 1)))
+
+	for _, a := range []test.Account{f.owner, f.controller, f.holder, f.receiver, f.operator, f.poor} {
+		j, found := customers[a.Address.String()]
+		if !found {
+			continue
+		}
+
+		f.SetCustomer(kycContract, kycID, a.Address, kyctypes.NewCustomerInfo(kyctypes.CustomerStatusApproved, j, "", "", 0, ""))
+	}
+
+	f.SetSTO(f.contract, f.design(
+		stotypes.NewKYCRequirement([]stotypes.KYCService{stotypes.NewKYCService(kycContract, kycID)}, stotypes.KYCModeAnyOf),
+		rule,
+	))
+
+	return kycContract
+}
+
+// issue issues amount of tokens of partition to holder by controller.
+func (f *fixture) issue(holder base.Address, amount int64) {
+	f.t.Helper()
+
+	f.mustProcess(f.controller.Builder().IssueSecurityTokens(
+		f.controller.Address,
+		sto.NewIssueSecurityTokensItem(f.contract, f.stoID, holder, common.NewBig(amount), f.partition, f.currency),
+	))
+}
+
+// authorize authorizes operator for the partition of holder.
+func (f *fixture) authorize(holder, operator test.Account) {
+	f.t.Helper()
+
+	f.mustProcess(holder.Builder().AuthorizeOperators(
+		holder.Address,
+		sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, operator.Address, f.partition, common.NilBig, 0, f.currency),
+	))
+}
+
+func (f *fixture) mustProcess(op base.Operation, err error) {
+	f.t.Helper()
+
+	if err != nil {
+		f.t.Fatalf("failed to build operation: %+v", err)
+	}
+
+	if reason := f.Process(op); reason != nil {
+		f.t.Fatalf("failed to process operation, %T: %v", op, reason)
+	}
+}
+
+// mustProcessBlock processes the operations built by builds in one block.
+func (f *fixture) mustProcessBlock(builds ...func() (base.Operation, error)) {
+	f.t.Helper()
+
+	ops := make([]base.Operation, len(builds))
+	for i := range builds {
+		op, err := builds[i]()
+		if err != nil {
+			f.t.Fatalf("failed to build operation: %+v", err)
+		}
+
+		ops[i] = op
+	}
+
+	for i, reason := range f.ProcessBlock(ops...) {
+		if reason != nil {
+			f.t.Fatalf("failed to process operation, %T: %v", ops[i], reason)
+		}
+	}
+}
+
+func (f *fixture) checkBalance(addr base.Address, expected common.Big) {
+	f.t.Helper()
+
+	if b := f.Balance(addr, f.currency); !b.Equal(expected) {
+		f.t.Errorf("balance of %q: expected %s, but %s", addr, expected, b)
+	}
+}
+
+func (f *fixture) checkTokenHolderBalance(holder base.Address, expected int64) {
+	f.t.Helper()
+
+	if b := f.TokenHolderBalance(f.contract, f.stoID, holder, f.partition); !b.Equal(common.NewBig(expected)) {
+		f.t.Errorf("tokenholder balance of %q: expected %d, but %s", holder, expected, b)
+	}
+}
+
+func (f *fixture) checkPartitionBalance(expected int64) {
+	f.t.Helper()
+
+	if b := f.PartitionBalance(f.contract, f.stoID, f.partition); !b.Equal(common.NewBig(expected)) {
+		f.t.Errorf("partition balance: expected %d, but %s", expected, b)
+	}
+}
+
+// processCase is the operation processed against the new fixture; empty
+// reason means the operation should be processed.
+type processCase struct {
+	name    string
+	prepare func(*fixture)
+	op      func(*fixture) (base.Operation, error)
+	reason  string
+	check   func(*fixture)
+}
+
+func runProcessCases(t *testing.T, cases []processCase) {
+	t.Helper()
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func(t *testing.T) {
+			f := newFixture(t)
+
+			if c.prepare != nil {
+				c.prepare(f)
+			}
+
+			op, err := c.op(f)
+			if err != nil {
+				t.Fatalf("failed to build operation: %+v", err)
+			}
+
+			reason := f.Process(op)
+
+			switch {
+			case len(c.reason) < 1 && reason != nil:
+				t.Fatalf("unexpected reason: %v", reason)
+			case len(c.reason) > 0 && reason == nil:
+				t.Fatalf("expected reason, %q, but processed", c.reason)
+			case len(c.reason) > 0 && !strings.Contains(reason.Error(), c.reason):
+				t.Fatalf("expected reason, %q, but %q", c.reason, reason.Error())
+			}
+
+			if c.check != nil {
+				c.check(f)
+			}
+		})
+	}
+}
@@ -4,13 +4,14 @@ import (
 	"fmt"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/valuehash"
 )
 
-var MaxIssueSecurityTokensItems uint = 10
+var MaxIssueSecurityTokensItems = uint(networktypes.MaxItemsLimit)
 var (
 	IssueSecurityTokensFactHint = hint.MustNewHint("mitum-sto-issue-security-tokens-operation-fact-v0.0.1")
 	IssueSecurityTokensHint     = hint.MustNewHint("mitum-sto-issue-security-tokens-operation-v0.0.1")
@@ -6,11 +6,11 @@ import (
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -37,9 +37,11 @@ func (IssueSecurityTokens) Process(
 }
 
 type IssueSecurityTokensItemProcessor struct {
-	h      util.Hash
-	sender base.Address
-	item   IssueSecurityTokensItem
+	h       util.Hash
+	height  base.Height
+	sender  base.Address
+	item    IssueSecurityTokensItem
+	holders *jurisdictionHolders
 }
 
 func (ipp *IssueSecurityTokensItemProcessor) PreProcess(
@@ -86,6 +88,14 @@ func (ipp *IssueSecurityTokensItemProcessor) PreProcess(
 		}
 	}
 
+	if err := stostate.CheckTokenHolderKYC(policy.KYC(), it.Receiver(), ipp.height, getStateFunc); err != nil {
+		return err
+	}
+
+	if err := checkTokenHolderJurisdiction(it.Contract(), design, it.Receiver(), ipp.height, getStateFunc); err != nil {
+		return err
+	}
+
 	gn := new(big.Int)
 	gn.SetUint64(design.Granularity())
 
@@ -131,15 +141,29 @@ func (ipp *IssueSecurityTokensItemProcessor) Process(
 		pb = pb.Add(it.Amount())
 	default:
 		pb = it.Amount()
+	}
+
+	// NOTE the partition redeemed to zero is removed from policy, but its
+	// balance state remains; it is added to policy again by issue.
+	var inPolicy bool
+	for _, dp := range dps {
+		if dp == it.Partition() {
+			inPolicy = true
+
+			break
+		}
+	}
+
+	if !inPolicy {
 		dps = append(dps, it.Partition())
 	}
 
-	policy := stotypes.NewPolicy(dps, it.Amount().Add(p.Aggregate()), p.Controllers(), p.Documents())
+	policy := stotypes.NewPolicy(dps, it.Amount().Add(p.Aggregate()), p.Controllers(), p.Documents(), p.KYC())
 	if err := policy.IsValid(nil); err != nil {
 		return nil, err
 	}
 
-	design = stotypes.NewDesign(design.STO(), design.Granularity(), policy)
+	design = design.SetPolicy(policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -167,6 +191,21 @@ func (ipp *IssueSecurityTokensItemProcessor) Process(
 		ps = []stotypes.Partition{}
 	}
 
+	j, err := stostate.TokenHolderJurisdiction(p.KYC(), it.Receiver(), ipp.height, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ps) == 0 {
+		err = ipp.holders.join(it.Contract(), it.STO(), it.Receiver(), j, design.Jurisdictions(), getStateFunc)
+	} else {
+		err = ipp.holders.recount(it.Contract(), it.STO(), it.Receiver(), j, design.Jurisdictions(), getStateFunc)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
 	if len(ps) == 0 {
 		ps = append(ps, it.Partition())
 	} else {
@@ -211,8 +250,10 @@ func (ipp *IssueSecurityTokensItemProcessor) Process(
 
 func (ipp *IssueSecurityTokensItemProcessor) Close() error {
 	ipp.h = nil
+	ipp.height = 0
 	ipp.sender = nil
 	ipp.item = IssueSecurityTokensItem{}
+	ipp.holders = nil
 
 	issueSecurityTokensItemProcessorPool.Put(ipp)
 
@@ -264,6 +305,21 @@ func (opp *IssueSecurityTokensProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	for _, it := range fact.Items() {
+		if err := networkPolicy.CheckPartition(it.Partition().String()); err != nil {
+			return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -284,8 +340,10 @@ func (opp *IssueSecurityTokensProcessor) PreProcess(
 		}
 
 		ipc.h = op.Hash()
+		ipc.height = opp.Height()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.holders = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess IssueSecurityTokensItem: %w", err), nil
@@ -310,6 +368,8 @@ func (opp *IssueSecurityTokensProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
+	holders := newJurisdictionHolders()
+
 	for _, item := range fact.Items() {
 		ip := issueSecurityTokensItemProcessorPool.Get()
 		ipc, ok := ip.(*IssueSecurityTokensItemProcessor)
@@ -318,8 +378,10 @@ func (opp *IssueSecurityTokensProcessor) Process( // nolint:dupl
 		}
 
 		ipc.h = op.Hash()
+		ipc.height = opp.Height()
 		ipc.sender = fact.Sender()
 		ipc.item = item
+		ipc.holders = holders
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -330,31 +392,19 @@ func (opp *IssueSecurityTokensProcessor) Process( // nolint:dupl
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
+	sts = append(sts, holders.stateMergeValues()...)
 
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *IssueSecurityTokensProcessor) Close() error {
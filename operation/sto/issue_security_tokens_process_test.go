@@ -0,0 +1,194 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestIssueSecurityTokensProcess(t *testing.T) {
+	item := func(f *fixture, receiver base.Address, amount int64) sto.IssueSecurityTokensItem {
+		return sto.NewIssueSecurityTokensItem(f.contract, f.stoID, receiver, common.NewBig(amount), f.partition, f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "issue",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.holder.Address, 100))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 100)
+				f.checkPartitionBalance(100)
+				f.checkBalance(f.controller.Address, balance.Sub(fee))
+
+				if a := f.STO(f.contract, f.stoID).Policy().Aggregate(); !a.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected aggregate 100, but %s", a)
+				}
+
+				ps, err := stostate.ExistsTokenHolderPartitions(f.contract, f.stoID, f.holder.Address, f.GetStateFunc)
+				if err != nil {
+					f.t.Fatalf("tokenholder partitions not found: %+v", err)
+				}
+
+				if len(ps) != 1 || ps[0] != f.partition {
+					f.t.Errorf("expected partitions, [%s], but %v", f.partition, ps)
+				}
+			},
+		},
+		{
+			name: "new partition",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.holder.Address, common.NewBig(100), "PTB", f.currency)
+
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, it)
+			},
+			check: func(f *fixture) {
+				if n := len(f.STO(f.contract, f.stoID).Policy().Partitions()); n != 2 {
+					f.t.Errorf("expected 2 partitions, but %d", n)
+				}
+
+				if b := f.PartitionBalance(f.contract, f.stoID, "PTB"); !b.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected partition balance 100, but %s", b)
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.SetSTO(f.contract, f.stoDesign("STB", 1, stotypes.EmptyKYCRequirement(), stotypes.EmptyJurisdictionRule()))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					item(f, f.holder.Address, 100),
+					sto.NewIssueSecurityTokensItem(f.contract, "STB", f.receiver.Address, common.NewBig(30), f.partition, f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 100)
+
+				if b := f.TokenHolderBalance(f.contract, "STB", f.receiver.Address, f.partition); !b.Equal(common.NewBig(30)) {
+					f.t.Errorf("expected tokenholder balance 30, but %s", b)
+				}
+
+				f.checkBalance(f.controller.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "not controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().IssueSecurityTokens(f.holder.Address, item(f, f.holder.Address, 100))
+			},
+			reason: "sender is not controller of sto",
+		},
+		{
+			name: "unknown sto",
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewIssueSecurityTokensItem(f.contract, "STB", f.holder.Address, common.NewBig(100), f.partition, f.currency)
+
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, it)
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "unknown receiver",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.NewAddress(), 100))
+			},
+			reason: "does not exist",
+		},
+		{
+			name: "contract account receiver",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address, item(f, f.NewContractAccount(f.owner.Address), 100))
+			},
+			reason: "already exists",
+		},
+		{
+			name: "granularity",
+			prepare: func(f *fixture) {
+				f.SetSTO(f.contract, f.stoDesign(f.stoID, 10, stotypes.EmptyKYCRequirement(), stotypes.EmptyJurisdictionRule()))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.holder.Address, 15))
+			},
+			reason: "granularity",
+		},
+		{
+			name: "verified receiver",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "KR"})
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.holder.Address, 100))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 100)
+			},
+		},
+		{
+			name: "receiver not verified",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "KR"})
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.receiver.Address, 100))
+			},
+			reason: "not verified",
+		},
+		{
+			name: "jurisdiction denied",
+			prepare: func(f *fixture) {
+				f.requireKYC(
+					stotypes.NewJurisdictionRule(nil, []kyctypes.Jurisdiction{"US"}, nil),
+					map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "US"},
+				)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.holder.Address, 100))
+			},
+			reason: "jurisdiction denied",
+		},
+		{
+			name: "jurisdiction cap",
+			prepare: func(f *fixture) {
+				f.requireKYC(
+					stotypes.NewJurisdictionRule(nil, nil, []stotypes.JurisdictionCap{stotypes.NewJurisdictionCap("KR", 1)}),
+					map[string]kyctypes.Jurisdiction{
+						f.holder.Address.String():   "KR",
+						f.receiver.Address.String(): "KR",
+					},
+				)
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.receiver.Address, 100))
+			},
+			reason: "over 1",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.receiver.Address, 0)
+			},
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.controller.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(f.controller.Address, item(f, f.holder.Address, 100))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 0)
+				f.checkPartitionBalance(0)
+			},
+		},
+	})
+}
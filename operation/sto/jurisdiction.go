@@ -0,0 +1,276 @@
+package sto
+
+import (
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkTokenHolderJurisdiction checks holder can receive tokens of sto under
+// the jurisdiction rule of design. The cap is only checked for the holder
+// which is not counted yet; the final count is checked while processing.
+func checkTokenHolderJurisdiction(
+	contract base.Address, design stotypes.Design, holder base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) error {
+	rule := design.Jurisdictions()
+	if rule.IsEmpty() {
+		return nil
+	}
+
+	j, err := stostate.TokenHolderJurisdiction(design.Policy().KYC(), holder, height, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if err := rule.Check(j); err != nil {
+		return errors.Errorf("tokenholder, %q: %v", holder, err)
+	}
+
+	maxHolders, capped := rule.Cap(j)
+	if !capped {
+		return nil
+	}
+
+	holders := newJurisdictionHolders()
+
+	switch counted, err := holders.jurisdiction(
+		stostate.StateKeyTokenHolderJurisdiction(contract, design.STO(), holder), getStateFunc); {
+	case err != nil:
+		return err
+	case len(counted) > 0:
+		return nil
+	}
+
+	switch count, err := holders.count(
+		stostate.StateKeyJurisdictionHoldersCount(contract, design.STO(), j), getStateFunc); {
+	case err != nil:
+		return err
+	case count >= maxHolders:
+		return errors.Errorf("tokenholders of jurisdiction, %q over %d", j, maxHolders)
+	}
+
+	return nil
+}
+
+// checkJurisdictionCaps checks the tokenholders counted in each jurisdiction
+// of sto are within the caps of rule. Caps can not be set while tokenholders
+// without jurisdiction exist, because they are not counted in any
+// jurisdiction yet.
+func checkJurisdictionCaps(
+	contract base.Address, stoID currencytypes.ContractID, rule stotypes.JurisdictionRule, getStateFunc base.GetStateFunc,
+) error {
+	caps := rule.Caps()
+	if len(caps) < 1 {
+		return nil
+	}
+
+	holders := newJurisdictionHolders()
+
+	switch count, err := holders.count(stostate.StateKeyJurisdictionHoldersCount(contract, stoID, ""), getStateFunc); {
+	case err != nil:
+		return err
+	case count > 0:
+		return errors.Errorf("%d tokenholders without jurisdiction", count)
+	}
+
+	for i := range caps {
+		j := caps[i].Jurisdiction()
+
+		switch count, err := holders.count(stostate.StateKeyJurisdictionHoldersCount(contract, stoID, j), getStateFunc); {
+		case err != nil:
+			return err
+		case count > caps[i].MaxHolders():
+			return errors.Errorf("tokenholders of jurisdiction, %q, %d over %d", j, count, caps[i].MaxHolders())
+		}
+	}
+
+	return nil
+}
+
+// jurisdictionHolders keeps the jurisdictions of tokenholders and the
+// tokenholders counts per jurisdiction changed while processing an operation.
+// The counts read before the changes are kept, so the counts changed by
+// operations in the same block are merged by their differences.
+type jurisdictionHolders struct {
+	holders map[string]kyctypes.Jurisdiction
+	counts  map[string]uint64
+	reads   map[string]uint64
+}
+
+func newJurisdictionHolders() *jurisdictionHolders {
+	return &jurisdictionHolders{
+		holders: map[string]kyctypes.Jurisdiction{},
+		counts:  map[string]uint64{},
+		reads:   map[string]uint64{},
+	}
+}
+
+func (r *jurisdictionHolders) jurisdiction(hk string, getStateFunc base.GetStateFunc) (kyctypes.Jurisdiction, error) {
+	if j, found := r.holders[hk]; found {
+		return j, nil
+	}
+
+	switch st, found, err := getStateFunc(hk); {
+	case err != nil:
+		return "", err
+	case !found:
+		return "", nil
+	default:
+		return stostate.StateTokenHolderJurisdictionValue(st)
+	}
+}
+
+func (r *jurisdictionHolders) count(ck string, getStateFunc base.GetStateFunc) (uint64, error) {
+	if count, found := r.counts[ck]; found {
+		return count, nil
+	}
+
+	var count uint64
+
+	switch st, found, err := getStateFunc(ck); {
+	case err != nil:
+		return 0, err
+	case found:
+		i, err := stostate.StateJurisdictionHoldersCountValue(st)
+		if err != nil {
+			return 0, err
+		}
+
+		count = i
+	}
+
+	r.reads[ck] = count
+
+	return count, nil
+}
+
+// join counts holder, which starts holding tokens of sto, in its jurisdiction
+// within the cap of rule. Holders without jurisdiction are counted in the
+// empty jurisdiction until recount knows their jurisdiction.
+func (r *jurisdictionHolders) join(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	holder base.Address,
+	j kyctypes.Jurisdiction,
+	rule stotypes.JurisdictionRule,
+	getStateFunc base.GetStateFunc,
+) error {
+	hk := stostate.StateKeyTokenHolderJurisdiction(contract, stoID, holder)
+
+	switch counted, err := r.jurisdiction(hk, getStateFunc); {
+	case err != nil:
+		return err
+	case len(counted) > 0:
+		return nil
+	}
+
+	return r.add(contract, stoID, hk, j, rule, getStateFunc)
+}
+
+// recount moves holder, which already holds tokens of sto without
+// jurisdiction, from the empty jurisdiction to its jurisdiction within the cap
+// of rule.
+func (r *jurisdictionHolders) recount(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	holder base.Address,
+	j kyctypes.Jurisdiction,
+	rule stotypes.JurisdictionRule,
+	getStateFunc base.GetStateFunc,
+) error {
+	if len(j) < 1 {
+		return nil
+	}
+
+	hk := stostate.StateKeyTokenHolderJurisdiction(contract, stoID, holder)
+
+	switch counted, err := r.jurisdiction(hk, getStateFunc); {
+	case err != nil:
+		return err
+	case len(counted) > 0:
+		return nil
+	}
+
+	if err := r.sub(stostate.StateKeyJurisdictionHoldersCount(contract, stoID, ""), getStateFunc); err != nil {
+		return err
+	}
+
+	return r.add(contract, stoID, hk, j, rule, getStateFunc)
+}
+
+// leave uncounts holder, which stops holding tokens of sto, from the
+// jurisdiction it was counted in.
+func (r *jurisdictionHolders) leave(
+	contract base.Address, stoID currencytypes.ContractID, holder base.Address, getStateFunc base.GetStateFunc,
+) error {
+	hk := stostate.StateKeyTokenHolderJurisdiction(contract, stoID, holder)
+
+	j, err := r.jurisdiction(hk, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if len(j) > 0 {
+		r.holders[hk] = ""
+	}
+
+	return r.sub(stostate.StateKeyJurisdictionHoldersCount(contract, stoID, j), getStateFunc)
+}
+
+func (r *jurisdictionHolders) add(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	hk string,
+	j kyctypes.Jurisdiction,
+	rule stotypes.JurisdictionRule,
+	getStateFunc base.GetStateFunc,
+) error {
+	ck := stostate.StateKeyJurisdictionHoldersCount(contract, stoID, j)
+
+	count, err := r.count(ck, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if maxHolders, capped := rule.Cap(j); capped && count >= maxHolders {
+		return errors.Errorf("tokenholders of jurisdiction, %q over %d", j, maxHolders)
+	}
+
+	r.holders[hk] = j
+	r.counts[ck] = count + 1
+
+	return nil
+}
+
+func (r *jurisdictionHolders) sub(ck string, getStateFunc base.GetStateFunc) error {
+	count, err := r.count(ck, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		r.counts[ck] = count - 1
+	} else {
+		r.counts[ck] = 0
+	}
+
+	return nil
+}
+
+func (r *jurisdictionHolders) stateMergeValues() []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, 0, len(r.holders)+len(r.counts))
+
+	for k, v := range r.holders {
+		sts = append(sts, currencystate.NewStateMergeValue(k, stostate.NewTokenHolderJurisdictionStateValue(v)))
+	}
+
+	for k, v := range r.counts {
+		sts = append(sts, stostate.NewJurisdictionHoldersCountStateMergeValue(k, r.reads[k], v))
+	}
+
+	return sts
+}
@@ -0,0 +1,20 @@
+package sto
+
+import (
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// checkKYCServices checks the kyc services of requirement exist.
+func checkKYCServices(kyc stotypes.KYCRequirement, getStateFunc base.GetStateFunc) error {
+	for _, s := range kyc.Services() {
+		if err := currencystate.CheckExistsState(kycstate.StateKeyDesign(s.Contract(), s.KYC()), getStateFunc); err != nil {
+			return errors.Errorf("kyc service not found, %s: %v", s, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	MigrateSecurityTokensFactHint = hint.MustNewHint("mitum-sto-migrate-security-tokens-operation-fact-v0.0.1")
+	MigrateSecurityTokensHint     = hint.MustNewHint("mitum-sto-migrate-security-tokens-operation-v0.0.1")
+)
+
+var MaxMigrateTokenHolders = uint(networktypes.MaxItemsLimit)
+
+type MigrationMode string
+
+const (
+	// MigrationModeMigrate moves the tokenholders to the new contract
+	// account.
+	MigrationModeMigrate MigrationMode = "migrate"
+	// MigrationModeFinish moves the design and the partitions to the new
+	// contract account when the balances of every partition are migrated.
+	MigrationModeFinish MigrationMode = "finish"
+	// MigrationModeAbort moves the migrated tokenholders back to the contract
+	// account; the design is restored with the last tokenholders.
+	MigrationModeAbort MigrationMode = "abort"
+)
+
+func (m MigrationMode) Bytes() []byte {
+	return []byte(m)
+}
+
+func (m MigrationMode) String() string {
+	return string(m)
+}
+
+func (m MigrationMode) IsValid([]byte) error {
+	switch m {
+	case MigrationModeMigrate, MigrationModeFinish, MigrationModeAbort:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown migration mode, %q", m)
+	}
+}
+
+// MigrateSecurityTokensFact moves sto from contract to newContract. The
+// tokenholders of large sto are migrated with tokenHolders over several
+// operations, and the sto can not be used until the migration is finished or
+// aborted by the operation of each mode; the states of sto can not be
+// iterated, so every tokenholder, including the tokenholders without balance
+// which have operators, should be migrated before finish.
+type MigrateSecurityTokensFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address             // contract account
+	stoID        currencytypes.ContractID // token id
+	newContract  base.Address             // new contract account
+	mode         MigrationMode
+	tokenHolders []base.Address           // empty for finish
+	currency     currencytypes.CurrencyID // fee
+}
+
+func NewMigrateSecurityTokensFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	newContract base.Address,
+	mode MigrationMode,
+	tokenHolders []base.Address,
+	currency currencytypes.CurrencyID,
+) MigrateSecurityTokensFact {
+	bf := base.NewBaseFact(MigrateSecurityTokensFactHint, token)
+	fact := MigrateSecurityTokensFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		stoID:        stoID,
+		newContract:  newContract,
+		mode:         mode,
+		tokenHolders: tokenHolders,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact MigrateSecurityTokensFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact MigrateSecurityTokensFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact MigrateSecurityTokensFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.tokenHolders))
+	for i := range fact.tokenHolders {
+		bs[i] = fact.tokenHolders[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.stoID.Bytes(),
+		fact.newContract.Bytes(),
+		fact.mode.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact MigrateSecurityTokensFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(
+		nil, false, fact.sender, fact.contract, fact.stoID, fact.newContract, fact.mode, fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.sender.Equal(fact.newContract) {
+		return util.ErrInvalid.Errorf("new contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.contract.Equal(fact.newContract) {
+		return util.ErrInvalid.Errorf("new contract address is same with contract, %q", fact.contract)
+	}
+
+	switch n := len(fact.tokenHolders); {
+	case fact.mode == MigrationModeFinish && n > 0:
+		return util.ErrInvalid.Errorf("tokenholders not empty for finish")
+	case fact.mode != MigrationModeFinish && n < 1:
+		return util.ErrInvalid.Errorf("empty tokenholders for %s", fact.mode)
+	case n > int(MaxMigrateTokenHolders):
+		return util.ErrInvalid.Errorf("tokenholders, %d over max, %d", n, MaxMigrateTokenHolders)
+	}
+
+	founds := map[string]struct{}{}
+	for _, h := range fact.tokenHolders {
+		if err := h.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[h.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate tokenholder found, %q", h)
+		}
+
+		founds[h.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact MigrateSecurityTokensFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact MigrateSecurityTokensFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact MigrateSecurityTokensFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact MigrateSecurityTokensFact) STO() currencytypes.ContractID {
+	return fact.stoID
+}
+
+func (fact MigrateSecurityTokensFact) NewContract() base.Address {
+	return fact.newContract
+}
+
+func (fact MigrateSecurityTokensFact) Mode() MigrationMode {
+	return fact.mode
+}
+
+func (fact MigrateSecurityTokensFact) TokenHolders() []base.Address {
+	return fact.tokenHolders
+}
+
+func (fact MigrateSecurityTokensFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact MigrateSecurityTokensFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3+len(fact.tokenHolders))
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.newContract
+
+	copy(as[3:], fact.tokenHolders)
+
+	return as, nil
+}
+
+type MigrateSecurityTokens struct {
+	common.BaseOperation
+}
+
+func NewMigrateSecurityTokens(fact MigrateSecurityTokensFact) (MigrateSecurityTokens, error) {
+	return MigrateSecurityTokens{BaseOperation: common.NewBaseOperation(MigrateSecurityTokensHint, fact)}, nil
+}
+
+func (op *MigrateSecurityTokens) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
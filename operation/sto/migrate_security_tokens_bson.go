@@ -0,0 +1,88 @@
+package sto // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact MigrateSecurityTokensFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        fact.Hint().String(),
+			"sender":       fact.sender,
+			"contract":     fact.contract,
+			"stoid":        fact.stoID,
+			"new_contract": fact.newContract,
+			"mode":         fact.mode,
+			"tokenholders": fact.tokenHolders,
+			"currency":     fact.currency,
+			"hash":         fact.BaseFact.Hash().String(),
+			"token":        fact.BaseFact.Token(),
+		},
+	)
+}
+
+type MigrateSecurityTokensFactBSONUnmarshaler struct {
+	Hint         string   `bson:"_hint"`
+	Sender       string   `bson:"sender"`
+	Contract     string   `bson:"contract"`
+	STOID        string   `bson:"stoid"`
+	NewContract  string   `bson:"new_contract"`
+	Mode         string   `bson:"mode"`
+	TokenHolders []string `bson:"tokenholders"`
+	Currency     string   `bson:"currency"`
+}
+
+func (fact *MigrateSecurityTokensFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MigrateSecurityTokensFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf MigrateSecurityTokensFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.STOID, uf.NewContract, uf.Mode, uf.TokenHolders, uf.Currency)
+}
+
+func (op MigrateSecurityTokens) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *MigrateSecurityTokens) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MigrateSecurityTokens")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *MigrateSecurityTokensFact) unpack(enc encoder.Encoder, sa, ca, stoid, nca, mode string, hs []string, cid string) error {
+	e := util.StringError("failed to unmarshal MigrateSecurityTokensFact")
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(nca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.newContract = a
+	}
+
+	holders := make([]base.Address, len(hs))
+	for i := range hs {
+		a, err := base.DecodeAddress(hs[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		holders[i] = a
+	}
+	fact.tokenHolders = holders
+
+	fact.stoID = currencytypes.ContractID(stoid)
+	fact.mode = MigrationMode(mode)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
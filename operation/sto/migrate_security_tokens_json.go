@@ -0,0 +1,80 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type MigrateSecurityTokensFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner        base.Address             `json:"sender"`
+	Contract     base.Address             `json:"contract"`
+	STOID        currencytypes.ContractID `json:"stoid"`
+	NewContract  base.Address             `json:"new_contract"`
+	Mode         MigrationMode            `json:"mode"`
+	TokenHolders []base.Address           `json:"tokenholders"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact MigrateSecurityTokensFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateSecurityTokensFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		STOID:                 fact.stoID,
+		NewContract:           fact.newContract,
+		Mode:                  fact.mode,
+		TokenHolders:          fact.tokenHolders,
+		Currency:              fact.currency,
+	})
+}
+
+type MigrateSecurityTokensFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner        string   `json:"sender"`
+	Contract     string   `json:"contract"`
+	STOID        string   `json:"stoid"`
+	NewContract  string   `json:"new_contract"`
+	Mode         string   `json:"mode"`
+	TokenHolders []string `json:"tokenholders"`
+	Currency     string   `json:"currency"`
+}
+
+func (fact *MigrateSecurityTokensFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigrateSecurityTokensFact")
+
+	var uf MigrateSecurityTokensFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.STOID, uf.NewContract, uf.Mode, uf.TokenHolders, uf.Currency)
+}
+
+type MigrateSecurityTokensMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op MigrateSecurityTokens) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigrateSecurityTokensMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *MigrateSecurityTokens) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigrateSecurityTokens")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
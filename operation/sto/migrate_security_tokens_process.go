@@ -0,0 +1,777 @@
+package sto
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var migrateSecurityTokensProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(MigrateSecurityTokensProcessor)
+	},
+}
+
+func (MigrateSecurityTokens) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type MigrateSecurityTokensProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewMigrateSecurityTokensProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new MigrateSecurityTokensProcessor")
+
+		nopp := migrateSecurityTokensProcessorPool.Get()
+		opp, ok := nopp.(*MigrateSecurityTokensProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected MigrateSecurityTokensProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *MigrateSecurityTokensProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess MigrateSecurityTokens")
+
+	fact, ok := op.Fact().(MigrateSecurityTokensFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("not MigrateSecurityTokensFact, %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.TokenHolders())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot migrate security tokens, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	for _, ca := range []base.Address{fact.Contract(), fact.NewContract()} {
+		st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(ca), "key of contract account", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", ca, err), nil
+		}
+
+		v, err := extensioncurrency.StateContractAccountValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", ca, err), nil
+		}
+
+		if !v.Owner().Equal(fact.Sender()) {
+			return nil, base.NewBaseOperationProcessReasonError("not contract account owner, %q, %q", ca, fact.Sender()), nil
+		}
+	}
+
+	if _, err := checkMigration(fact, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *MigrateSecurityTokensProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process MigrateSecurityTokens")
+
+	fact, ok := op.Fact().(MigrateSecurityTokensFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected MigrateSecurityTokensFact, not %T", op.Fact()))
+	}
+
+	migration, err := checkMigration(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	switch fact.Mode() {
+	case MigrationModeMigrate:
+		m := newSTOMigration(fact.Contract(), fact.NewContract(), fact.STO(), getStateFunc)
+		if sts, err = m.migrate(migration.Design, fact.TokenHolders()); err != nil {
+			break
+		}
+
+		sts = append(sts, currencystate.NewStateMergeValue(stostate.StateKeyDesign(fact.Contract(), fact.STO()), migration))
+	case MigrationModeAbort:
+		m := newSTOMigration(fact.NewContract(), fact.Contract(), fact.STO(), getStateFunc)
+		if sts, err = m.migrate(migration.Design, fact.TokenHolders()); err != nil {
+			break
+		}
+
+		var v base.StateValue = migration
+		if migration.Holders < 1 {
+			v = stostate.NewDesignStateValue(migration.Design)
+		}
+
+		sts = append(sts, currencystate.NewStateMergeValue(stostate.StateKeyDesign(fact.Contract(), fact.STO()), v))
+	default:
+		sts, err = newSTOMigration(fact.Contract(), fact.NewContract(), fact.STO(), getStateFunc).finish(migration.Design)
+	}
+
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to %s sto migration, %s-%s: %w", fact.Mode(), fact.Contract(), fact.STO(), err), nil
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *MigrateSecurityTokensProcessor) Close() error {
+	migrateSecurityTokensProcessorPool.Put(opp)
+
+	return nil
+}
+
+// loadMigration returns the migration of sto to the new contract account of
+// fact. The sto which is not migrating yet starts the migration without
+// migrated tokenholders; only the tokenholders can be migrated then.
+func loadMigration(fact MigrateSecurityTokensFact, getStateFunc base.GetStateFunc) (stostate.MigratingStateValue, error) {
+	st, err := currencystate.ExistsState(stostate.StateKeyDesign(fact.Contract(), fact.STO()), "key of sto design", getStateFunc)
+	if err != nil {
+		return stostate.MigratingStateValue{}, errors.Errorf("sto design not found, %s-%s: %v", fact.Contract(), fact.STO(), err)
+	}
+
+	if m, ok := st.Value().(stostate.MigratingStateValue); ok {
+		if !m.Contract.Equal(fact.NewContract()) {
+			return stostate.MigratingStateValue{}, errors.Errorf("sto migrating to another contract account, %q", m.Contract)
+		}
+
+		return m, nil
+	}
+
+	design, err := stostate.StateDesignValue(st)
+	if err != nil {
+		return stostate.MigratingStateValue{}, err
+	}
+
+	if fact.Mode() != MigrationModeMigrate {
+		return stostate.MigratingStateValue{}, errors.Errorf("sto not migrating, %s-%s", fact.Contract(), fact.STO())
+	}
+
+	migrated := make([]common.Big, len(design.Policy().Partitions()))
+	for i := range migrated {
+		migrated[i] = common.ZeroBig
+	}
+
+	return stostate.NewMigratingStateValue(fact.NewContract(), design, migrated, 0), nil
+}
+
+// checkMigration checks fact against the migration of sto and returns the
+// migration after fact. The migrated tokenholders add their balances to the
+// migrated balances, which can not go over the balance of each partition, and
+// the aborted tokenholders take them back. The migration can be finished only
+// when the migrated balances reach every partition balance.
+func checkMigration(fact MigrateSecurityTokensFact, getStateFunc base.GetStateFunc) (stostate.MigratingStateValue, error) {
+	m, err := loadMigration(fact, getStateFunc)
+	if err != nil {
+		return stostate.MigratingStateValue{}, err
+	}
+
+	if fact.Mode() != MigrationModeAbort {
+		if err := currencystate.CheckNotExistsState(stostate.StateKeyDesign(fact.NewContract(), fact.STO()), getStateFunc); err != nil {
+			return stostate.MigratingStateValue{}, errors.Errorf(
+				"sto already exists in new contract account, %s-%s: %v", fact.NewContract(), fact.STO(), err)
+		}
+	}
+
+	partitions := m.Design.Policy().Partitions()
+
+	totals, err := partitionBalances(fact.Contract(), m.Design, getStateFunc)
+	if err != nil {
+		return stostate.MigratingStateValue{}, err
+	}
+
+	migrated := make([]common.Big, len(partitions))
+	holders := uint64(len(fact.TokenHolders()))
+
+	switch fact.Mode() {
+	case MigrationModeMigrate:
+		if err := checkTokenHoldersMigrated(fact.Contract(), fact.STO(), fact.TokenHolders(), nil, getStateFunc); err != nil {
+			return stostate.MigratingStateValue{}, err
+		}
+
+		sums, err := tokenHoldersBalances(fact.Contract(), m.Design, fact.TokenHolders(), getStateFunc)
+		if err != nil {
+			return stostate.MigratingStateValue{}, err
+		}
+
+		for i := range partitions {
+			migrated[i] = m.Migrated[i].Add(sums[i])
+
+			if migrated[i].Compare(totals[i]) > 0 {
+				return stostate.MigratingStateValue{}, errors.Errorf(
+					"tokenholders hold over partition balance, %s-%s-%s, %s > %s",
+					fact.Contract(), fact.STO(), partitions[i], migrated[i], totals[i],
+				)
+			}
+		}
+
+		return stostate.NewMigratingStateValue(m.Contract, m.Design, migrated, m.Holders+holders), nil
+	case MigrationModeAbort:
+		if err := checkTokenHoldersMigrated(
+			fact.Contract(), fact.STO(), fact.TokenHolders(), fact.NewContract(), getStateFunc,
+		); err != nil {
+			return stostate.MigratingStateValue{}, err
+		}
+
+		if holders > m.Holders {
+			return stostate.MigratingStateValue{}, errors.Errorf(
+				"tokenholders over migrated tokenholders, %d > %d", holders, m.Holders)
+		}
+
+		sums, err := tokenHoldersBalances(fact.NewContract(), m.Design, fact.TokenHolders(), getStateFunc)
+		if err != nil {
+			return stostate.MigratingStateValue{}, err
+		}
+
+		for i := range partitions {
+			migrated[i] = m.Migrated[i].Sub(sums[i])
+
+			if !migrated[i].OverNil() {
+				return stostate.MigratingStateValue{}, errors.Errorf(
+					"tokenholders hold over migrated balance, %s-%s-%s, %s > %s",
+					fact.NewContract(), fact.STO(), partitions[i], sums[i], m.Migrated[i],
+				)
+			}
+		}
+
+		return stostate.NewMigratingStateValue(m.Contract, m.Design, migrated, m.Holders-holders), nil
+	default:
+		for i := range partitions {
+			if !m.Migrated[i].Equal(totals[i]) {
+				return stostate.MigratingStateValue{}, errors.Errorf(
+					"partition balance not migrated, %s-%s-%s, %s != %s",
+					fact.Contract(), fact.STO(), partitions[i], m.Migrated[i], totals[i],
+				)
+			}
+		}
+
+		return m, nil
+	}
+}
+
+// checkTokenHoldersMigrated checks holders are migrated to to; holders should
+// not be migrated yet when to is nil.
+func checkTokenHoldersMigrated(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	holders []base.Address,
+	to base.Address,
+	getStateFunc base.GetStateFunc,
+) error {
+	for _, h := range holders {
+		var migrated stostate.MigratedStateValue
+		var ok bool
+
+		switch st, found, err := getStateFunc(stostate.StateKeyTokenHolderPartitions(contract, stoID, h)); {
+		case err != nil:
+			return err
+		case found:
+			migrated, ok = st.Value().(stostate.MigratedStateValue)
+		}
+
+		switch {
+		case to == nil && ok:
+			return errors.Errorf("tokenholder already migrated, %q", h)
+		case to != nil && (!ok || !migrated.Contract.Equal(to)):
+			return errors.Errorf("tokenholder not migrated, %q", h)
+		}
+	}
+
+	return nil
+}
+
+func partitionBalances(
+	contract base.Address, design stotypes.Design, getStateFunc base.GetStateFunc,
+) ([]common.Big, error) {
+	partitions := design.Policy().Partitions()
+	totals := make([]common.Big, len(partitions))
+
+	for i, p := range partitions {
+		totals[i] = common.ZeroBig
+
+		switch st, found, err := getStateFunc(stostate.StateKeyPartitionBalance(contract, design.STO(), p)); {
+		case err != nil:
+			return nil, err
+		case found:
+			b, err := stostate.StatePartitionBalanceValue(st)
+			if err != nil {
+				return nil, err
+			}
+			totals[i] = b
+		}
+	}
+
+	return totals, nil
+}
+
+// tokenHoldersBalances returns the sums of balances of holders in contract by
+// the partitions of design.
+func tokenHoldersBalances(
+	contract base.Address, design stotypes.Design, holders []base.Address, getStateFunc base.GetStateFunc,
+) ([]common.Big, error) {
+	partitions := design.Policy().Partitions()
+	sums := make([]common.Big, len(partitions))
+
+	for i, p := range partitions {
+		sums[i] = common.ZeroBig
+
+		for _, h := range holders {
+			switch st, found, err := getStateFunc(stostate.StateKeyTokenHolderPartitionBalance(contract, design.STO(), h, p)); {
+			case err != nil:
+				return nil, err
+			case found:
+				b, err := stostate.StateTokenHolderPartitionBalanceValue(st)
+				if err != nil {
+					return nil, err
+				}
+				sums[i] = sums[i].Add(b)
+			}
+		}
+	}
+
+	return sums, nil
+}
+
+// stoMigration moves the states of tokenholders of sto from one contract
+// account to the other and replaces the moved states with MigratedStateValue;
+// the migration is aborted by moving them back. Every relation of the
+// tokenholders is moved regardless of their balances. The tokenholders counts
+// of operators and jurisdictions are not moved but each moved tokenholder is
+// counted off in the source and counted in the target, so the counts are
+// right in both contract accounts.
+type stoMigration struct {
+	from         base.Address
+	to           base.Address
+	stoID        currencytypes.ContractID
+	getStateFunc base.GetStateFunc
+	moved        map[string]struct{}
+	counts       map[string]uint64
+	reads        map[string]uint64
+	sts          []base.StateMergeValue
+}
+
+func newSTOMigration(
+	from, to base.Address, stoID currencytypes.ContractID, getStateFunc base.GetStateFunc,
+) *stoMigration {
+	return &stoMigration{
+		from:         from,
+		to:           to,
+		stoID:        stoID,
+		getStateFunc: getStateFunc,
+		moved:        map[string]struct{}{},
+		counts:       map[string]uint64{},
+		reads:        map[string]uint64{},
+	}
+}
+
+// move copies the state of key to the target contract account; key returns
+// the state key of contract account. The source state is returned to follow
+// the states depending on it.
+func (m *stoMigration) move(key func(base.Address) string) (base.State, bool, error) {
+	k := key(m.from)
+	if _, found := m.moved[k]; found {
+		return nil, false, nil
+	}
+
+	st, found, err := m.getStateFunc(k)
+	switch {
+	case err != nil:
+		return nil, false, err
+	case !found:
+		return nil, false, nil
+	}
+
+	// NOTE the state moved back by the former abort is not found; the
+	// tokenholders are checked not to be moved already
+	if _, ok := st.Value().(stostate.MigratedStateValue); ok {
+		return nil, false, nil
+	}
+
+	m.moved[k] = struct{}{}
+
+	m.sts = append(m.sts,
+		currencystate.NewStateMergeValue(key(m.to), st.Value()),
+		currencystate.NewStateMergeValue(k, stostate.NewMigratedStateValue(m.to)),
+	)
+
+	return st, true, nil
+}
+
+// count counts one tokenholder off from the tokenholders count of key in the
+// source contract account and counts it in the target. The count of partition
+// operator authorized before the count states is the length of the former
+// operator tokenholders list of legacy.
+func (m *stoMigration) count(key, legacy func(base.Address) string) error {
+	from, err := m.readCount(key(m.from), legacy, m.from)
+	if err != nil {
+		return err
+	}
+
+	if from > 0 {
+		m.counts[key(m.from)] = from - 1
+	}
+
+	to, err := m.readCount(key(m.to), legacy, m.to)
+	if err != nil {
+		return err
+	}
+
+	m.counts[key(m.to)] = to + 1
+
+	return nil
+}
+
+func (m *stoMigration) readCount(k string, legacy func(base.Address) string, ca base.Address) (uint64, error) {
+	if count, found := m.counts[k]; found {
+		return count, nil
+	}
+
+	var count uint64
+
+	switch st, found, err := m.getStateFunc(k); {
+	case err != nil:
+		return 0, err
+	case found:
+		switch v := st.Value().(type) {
+		case stostate.OperatorTokenHoldersCountStateValue:
+			count = v.Count
+		case stostate.JurisdictionHoldersCountStateValue:
+			count = v.Count
+		default:
+			return 0, errors.Errorf("expected tokenholders count, not %T", v)
+		}
+	case legacy != nil:
+		switch lst, found, err := m.getStateFunc(legacy(ca)); {
+		case err != nil:
+			return 0, err
+		case found:
+			holders, err := stostate.StateOperatorTokenHoldersValue(lst)
+			if err != nil {
+				return 0, err
+			}
+
+			count = uint64(len(holders))
+		}
+	}
+
+	m.reads[k] = count
+	m.counts[k] = count
+
+	return count, nil
+}
+
+func (m *stoMigration) migrate(design stotypes.Design, holders []base.Address) ([]base.StateMergeValue, error) {
+	for i := range holders {
+		h := holders[i]
+
+		st, found, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyTokenHolderPartitions(ca, m.stoID, h)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var holding bool
+
+		switch {
+		case found:
+			ps, err := stostate.StateTokenHolderPartitionsValue(st)
+			if err != nil {
+				return nil, err
+			}
+
+			holding = len(ps) > 0
+		default:
+			// NOTE the tokenholder without partitions is marked as moved to
+			// be aborted and not to be migrated again
+			m.sts = append(m.sts,
+				currencystate.NewStateMergeValue(
+					stostate.StateKeyTokenHolderPartitions(m.to, m.stoID, h),
+					stostate.NewTokenHolderPartitionsStateValue([]stotypes.Partition{}),
+				),
+				currencystate.NewStateMergeValue(
+					stostate.StateKeyTokenHolderPartitions(m.from, m.stoID, h),
+					stostate.NewMigratedStateValue(m.to),
+				),
+			)
+		}
+
+		if err := m.migrateJurisdiction(h, holding); err != nil {
+			return nil, err
+		}
+
+		if err := m.migrateGlobalOperators(h); err != nil {
+			return nil, err
+		}
+
+		for _, p := range design.Policy().Partitions() {
+			if err := m.migratePartition(h, p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sts := m.sts
+
+	for k, v := range m.counts {
+		if v == m.reads[k] {
+			continue
+		}
+
+		if stostate.IsStateJurisdictionHoldersCountKey(k) {
+			sts = append(sts, stostate.NewJurisdictionHoldersCountStateMergeValue(k, m.reads[k], v))
+		} else {
+			sts = append(sts, stostate.NewOperatorTokenHoldersCountStateMergeValue(k, m.reads[k], v))
+		}
+	}
+
+	return sts, nil
+}
+
+// finish moves the design and the partition states to the target contract
+// account; the tokenholders are already moved.
+func (m *stoMigration) finish(design stotypes.Design) ([]base.StateMergeValue, error) {
+	m.sts = append(m.sts,
+		currencystate.NewStateMergeValue(stostate.StateKeyDesign(m.to, m.stoID), stostate.NewDesignStateValue(design)),
+		currencystate.NewStateMergeValue(stostate.StateKeyDesign(m.from, m.stoID), stostate.NewMigratedStateValue(m.to)),
+	)
+
+	for _, p := range design.Policy().Partitions() {
+		p := p
+
+		if _, _, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyPartitionBalance(ca, m.stoID, p)
+		}); err != nil {
+			return nil, err
+		}
+
+		if _, _, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyPartitionControllers(ca, m.stoID, p)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.sts, nil
+}
+
+// migrateJurisdiction moves h to its jurisdiction of the target contract
+// account; h, which holds tokens without jurisdiction, is counted in the empty
+// jurisdiction.
+func (m *stoMigration) migrateJurisdiction(h base.Address, holding bool) error {
+	st, found, err := m.move(func(ca base.Address) string {
+		return stostate.StateKeyTokenHolderJurisdiction(ca, m.stoID, h)
+	})
+	if err != nil {
+		return err
+	}
+
+	var j kyctypes.Jurisdiction
+	if found {
+		if j, err = stostate.StateTokenHolderJurisdictionValue(st); err != nil {
+			return err
+		}
+	}
+
+	if len(j) < 1 && !holding {
+		return nil
+	}
+
+	return m.count(func(ca base.Address) string {
+		return stostate.StateKeyJurisdictionHoldersCount(ca, m.stoID, j)
+	}, nil)
+}
+
+func (m *stoMigration) migrateGlobalOperators(h base.Address) error {
+	st, found, err := m.move(func(ca base.Address) string {
+		return stostate.StateKeyTokenHolderOperators(ca, m.stoID, h)
+	})
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	}
+
+	operators, err := stostate.StateTokenHolderOperatorsValue(st)
+	if err != nil {
+		return err
+	}
+
+	for i := range operators {
+		o := operators[i]
+
+		pst, found, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyGlobalOperatorTokenHolder(ca, m.stoID, o, h)
+		})
+		switch {
+		case err != nil:
+			return err
+		case !found:
+			continue
+		}
+
+		switch authorized, err := stostate.StateOperatorTokenHolderValue(pst); {
+		case err != nil:
+			return err
+		case !authorized:
+			continue
+		}
+
+		if err := m.count(func(ca base.Address) string {
+			return stostate.StateKeyGlobalOperatorTokenHoldersCount(ca, m.stoID, o)
+		}, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *stoMigration) migratePartition(h base.Address, p stotypes.Partition) error {
+	if _, _, err := m.move(func(ca base.Address) string {
+		return stostate.StateKeyTokenHolderPartitionBalance(ca, m.stoID, h, p)
+	}); err != nil {
+		return err
+	}
+
+	st, found, err := m.move(func(ca base.Address) string {
+		return stostate.StateKeyTokenHolderPartitionOperators(ca, m.stoID, h, p)
+	})
+	switch {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	}
+
+	operators, err := stostate.StateTokenHolderPartitionOperatorsValue(st)
+	if err != nil {
+		return err
+	}
+
+	for i := range operators {
+		o := operators[i]
+
+		pst, found, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyOperatorTokenHolder(ca, m.stoID, o, p, h)
+		})
+		if err != nil {
+			return err
+		}
+
+		var authorized bool
+
+		switch {
+		case found:
+			if authorized, err = stostate.StateOperatorTokenHolderValue(pst); err != nil {
+				return err
+			}
+		default:
+			// NOTE the pair authorized before the pair states is in the former
+			// operator tokenholders list; it is written as the pair state in
+			// the target contract account
+			if authorized, err = newOperatorRelations().isAuthorized(
+				newOperatorPair(m.from, m.stoID, o, p, h), m.getStateFunc,
+			); err != nil {
+				return err
+			}
+
+			if authorized {
+				m.sts = append(m.sts, currencystate.NewStateMergeValue(
+					stostate.StateKeyOperatorTokenHolder(m.to, m.stoID, o, p, h),
+					stostate.NewOperatorTokenHolderStateValue(true),
+				))
+			}
+		}
+
+		// NOTE the allowance of released pair is not carried to the target
+		// contract account
+		if !authorized {
+			continue
+		}
+
+		if _, _, err := m.move(func(ca base.Address) string {
+			return stostate.StateKeyOperatorAllowance(ca, m.stoID, h, p, o)
+		}); err != nil {
+			return err
+		}
+
+		if err := m.count(func(ca base.Address) string {
+			return stostate.StateKeyOperatorTokenHoldersCount(ca, m.stoID, o, p)
+		}, func(ca base.Address) string {
+			return stostate.StateKeyOperatorTokenHolders(ca, m.stoID, o, p)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
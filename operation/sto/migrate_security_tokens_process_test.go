@@ -0,0 +1,420 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestMigrateSecurityTokensProcess(t *testing.T) {
+	var newContract base.Address
+
+	prepare := func(f *fixture) {
+		newContract = f.NewContractAccount(f.owner.Address)
+
+		f.issue(f.holder.Address, 100)
+		f.issue(f.receiver.Address, 50)
+	}
+
+	migrateMode := func(f *fixture, mode sto.MigrationMode, holders ...base.Address) (base.Operation, error) {
+		return f.owner.Builder().MigrateSecurityTokens(f.owner.Address, f.contract, f.stoID, newContract, mode, holders, f.currency)
+	}
+
+	migrate := func(f *fixture, sender base.Address, holders ...base.Address) (base.Operation, error) {
+		return f.owner.Builder().MigrateSecurityTokens(
+			sender, f.contract, f.stoID, newContract, sto.MigrationModeMigrate, holders, f.currency)
+	}
+
+	finish := func(f *fixture) (base.Operation, error) {
+		return migrateMode(f, sto.MigrationModeFinish)
+	}
+
+	abort := func(f *fixture, holders ...base.Address) (base.Operation, error) {
+		return migrateMode(f, sto.MigrationModeAbort, holders...)
+	}
+
+	migrated := func(f *fixture) {
+		prepare(f)
+		f.mustProcess(migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address))
+		f.mustProcess(finish(f))
+	}
+
+	migrating := func(f *fixture) stostate.MigratingStateValue {
+		st, found := f.State(stostate.StateKeyDesign(f.contract, f.stoID))
+		if !found {
+			f.t.Fatal("old sto design not found")
+		}
+
+		m, ok := st.Value().(stostate.MigratingStateValue)
+		if !ok {
+			f.t.Fatalf("expected MigratingStateValue, but %T", st.Value())
+		}
+
+		return m
+	}
+
+	globalOperatorCount := func(f *fixture, contract base.Address) uint64 {
+		st, found := f.State(stostate.StateKeyGlobalOperatorTokenHoldersCount(contract, f.stoID, f.operator.Address))
+		if !found {
+			return 0
+		}
+
+		count, err := stostate.StateOperatorTokenHoldersCountValue(st)
+		if err != nil {
+			f.t.Fatalf("invalid global operator tokenholders count: %+v", err)
+		}
+
+		return count
+	}
+
+	authorizeGlobal := func(f *fixture, holder test.Account) {
+		f.mustProcess(holder.Builder().AuthorizeGlobalOperators(
+			holder.Address,
+			sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, f.operator.Address, f.currency),
+		))
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "migrate",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address))
+			},
+			op: finish,
+			check: func(f *fixture) {
+				design := f.STO(newContract, f.stoID)
+				if a := design.Policy().Aggregate(); !a.Equal(common.NewBig(150)) {
+					f.t.Errorf("expected aggregate 150, but %s", a)
+				}
+
+				if b := f.TokenHolderBalance(newContract, f.stoID, f.holder.Address, f.partition); !b.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected tokenholder balance 100, but %s", b)
+				}
+
+				if b := f.PartitionBalance(newContract, f.stoID, f.partition); !b.Equal(common.NewBig(150)) {
+					f.t.Errorf("expected partition balance 150, but %s", b)
+				}
+
+				st, found := f.State(stostate.StateKeyDesign(f.contract, f.stoID))
+				if !found {
+					f.t.Fatal("old sto design not found")
+				}
+
+				if m, ok := st.Value().(stostate.MigratedStateValue); !ok {
+					f.t.Errorf("expected MigratedStateValue, but %T", st.Value())
+				} else if !m.Contract.Equal(newContract) {
+					f.t.Errorf("expected migrated to %q, but %q", newContract, m.Contract)
+				}
+
+				f.checkBalance(f.owner.Address, balance.Sub(fee).Sub(fee))
+			},
+		},
+		{
+			name:    "old sto after migration",
+			prepare: migrated,
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.holder.Address, common.NewBig(100), f.partition, f.currency),
+				)
+			},
+			reason: "sto migrated to",
+		},
+		{
+			name:    "new sto after migration",
+			prepare: migrated,
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						newContract, f.stoID, f.holder.Address, f.receiver.Address, f.partition, common.NewBig(30), f.currency,
+					),
+				)
+			},
+			check: func(f *fixture) {
+				if b := f.TokenHolderBalance(newContract, f.stoID, f.receiver.Address, f.partition); !b.Equal(common.NewBig(80)) {
+					f.t.Errorf("expected tokenholder balance 80, but %s", b)
+				}
+			},
+		},
+		{
+			name:    "first tokenholders",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.holder.Address)
+			},
+			check: func(f *fixture) {
+				m := migrating(f)
+
+				if !m.Migrated[0].Equal(common.NewBig(100)) {
+					f.t.Errorf("expected migrated balance 100, but %s", m.Migrated[0])
+				}
+
+				if m.Holders != 1 {
+					f.t.Errorf("expected 1 migrated tokenholder, but %d", m.Holders)
+				}
+
+				if _, found := f.State(stostate.StateKeyDesign(newContract, f.stoID)); found {
+					f.t.Error("sto design in new contract account before migration finished")
+				}
+
+				if b := f.TokenHolderBalance(newContract, f.stoID, f.holder.Address, f.partition); !b.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected tokenholder balance 100, but %s", b)
+				}
+			},
+		},
+		{
+			name: "last tokenholders",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.receiver.Address)
+			},
+			check: func(f *fixture) {
+				// NOTE the migration is not finished by the last tokens
+				m := migrating(f)
+
+				if !m.Migrated[0].Equal(common.NewBig(150)) {
+					f.t.Errorf("expected migrated balance 150, but %s", m.Migrated[0])
+				}
+
+				if m.Holders != 2 {
+					f.t.Errorf("expected 2 migrated tokenholders, but %d", m.Holders)
+				}
+
+				if _, found := f.State(stostate.StateKeyDesign(newContract, f.stoID)); found {
+					f.t.Error("sto design in new contract account before migration finished")
+				}
+			},
+		},
+		{
+			name: "finish before balances migrated",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+			},
+			op:     finish,
+			reason: "partition balance not migrated",
+		},
+		{
+			name:    "finish not migrating",
+			prepare: prepare,
+			op:      finish,
+			reason:  "sto not migrating",
+		},
+		{
+			name: "abort",
+			prepare: func(f *fixture) {
+				prepare(f)
+				authorizeGlobal(f, f.holder)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return abort(f, f.holder.Address, f.receiver.Address)
+			},
+			check: func(f *fixture) {
+				f.STO(f.contract, f.stoID)
+
+				if b := f.TokenHolderBalance(f.contract, f.stoID, f.holder.Address, f.partition); !b.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected tokenholder balance 100, but %s", b)
+				}
+
+				if count := globalOperatorCount(f, f.contract); count != 1 {
+					f.t.Errorf("expected 1 global operator tokenholder, but %d", count)
+				}
+
+				if count := globalOperatorCount(f, newContract); count != 0 {
+					f.t.Errorf("expected no global operator tokenholder in new contract account, but %d", count)
+				}
+
+				st, found := f.State(stostate.StateKeyTokenHolderPartitions(newContract, f.stoID, f.holder.Address))
+				if !found {
+					f.t.Fatal("tokenholder partitions not found in new contract account")
+				}
+
+				if _, ok := st.Value().(stostate.MigratedStateValue); !ok {
+					f.t.Errorf("expected MigratedStateValue, but %T", st.Value())
+				}
+			},
+		},
+		{
+			name: "abort some tokenholders",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return abort(f, f.receiver.Address)
+			},
+			check: func(f *fixture) {
+				m := migrating(f)
+
+				if !m.Migrated[0].Equal(common.NewBig(100)) {
+					f.t.Errorf("expected migrated balance 100, but %s", m.Migrated[0])
+				}
+
+				if m.Holders != 1 {
+					f.t.Errorf("expected 1 migrated tokenholder, but %d", m.Holders)
+				}
+
+				if b := f.TokenHolderBalance(f.contract, f.stoID, f.receiver.Address, f.partition); !b.Equal(common.NewBig(50)) {
+					f.t.Errorf("expected tokenholder balance 50, but %s", b)
+				}
+			},
+		},
+		{
+			name: "abort tokenholder not migrated",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return abort(f, f.receiver.Address)
+			},
+			reason: "tokenholder not migrated",
+		},
+		{
+			name: "migrate again after abort",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+				f.mustProcess(abort(f, f.holder.Address))
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address))
+			},
+			op: finish,
+			check: func(f *fixture) {
+				if b := f.TokenHolderBalance(newContract, f.stoID, f.holder.Address, f.partition); !b.Equal(common.NewBig(100)) {
+					f.t.Errorf("expected tokenholder balance 100, but %s", b)
+				}
+
+				if b := f.PartitionBalance(newContract, f.stoID, f.partition); !b.Equal(common.NewBig(150)) {
+					f.t.Errorf("expected partition balance 150, but %s", b)
+				}
+			},
+		},
+		{
+			name: "sto migrating",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.receiver.Builder().TransferSecurityTokensPartition(
+					f.receiver.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						f.contract, f.stoID, f.receiver.Address, f.holder.Address, f.partition, common.NewBig(30), f.currency,
+					),
+				)
+			},
+			reason: "sto migrating to",
+		},
+		{
+			name: "tokenholder already migrated",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address)
+			},
+			reason: "tokenholder already migrated",
+		},
+		{
+			name: "migrating to other contract account",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.mustProcess(migrate(f, f.owner.Address, f.holder.Address))
+				newContract = f.NewContractAccount(f.owner.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.receiver.Address)
+			},
+			reason: "sto migrating to another contract account",
+		},
+		{
+			name: "operators of tokenholder without balance",
+			prepare: func(f *fixture) {
+				newContract = f.NewContractAccount(f.owner.Address)
+
+				f.issue(f.holder.Address, 100)
+				authorizeGlobal(f, f.receiver)
+				authorizeGlobal(f, f.holder)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				// NOTE receiver has no balance
+				return migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address)
+			},
+			check: func(f *fixture) {
+				if count := globalOperatorCount(f, newContract); count != 2 {
+					f.t.Errorf("expected 2 global operator tokenholders, but %d", count)
+				}
+
+				if count := globalOperatorCount(f, f.contract); count != 0 {
+					f.t.Errorf("expected no global operator tokenholder in old contract account, but %d", count)
+				}
+
+				if _, found := f.State(stostate.StateKeyTokenHolderOperators(newContract, f.stoID, f.receiver.Address)); !found {
+					f.t.Error("operators of tokenholder without balance not migrated")
+				}
+
+				if m := migrating(f); m.Holders != 2 {
+					f.t.Errorf("expected 2 migrated tokenholders, but %d", m.Holders)
+				}
+			},
+		},
+		{
+			name: "new contract account of other owner",
+			prepare: func(f *fixture) {
+				prepare(f)
+				newContract = f.NewContractAccount(f.holder.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name: "sto exists in new contract account",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.SetSTO(newContract, f.STO(f.contract, f.stoID))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address)
+			},
+			reason: "sto already exists in new contract account",
+		},
+		{
+			name:    "not owner",
+			prepare: prepare,
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().MigrateSecurityTokens(
+					f.controller.Address, f.contract, f.stoID, newContract, sto.MigrationModeMigrate,
+					[]base.Address{f.holder.Address, f.receiver.Address}, f.currency,
+				)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				prepare(f)
+				f.SetBalance(f.owner.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return migrate(f, f.owner.Address, f.holder.Address, f.receiver.Address)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.STO(f.contract, f.stoID)
+			},
+		},
+	})
+}
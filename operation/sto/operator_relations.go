@@ -0,0 +1,251 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// operatorPair is the state keys of operator and tokenholder pair.
+type operatorPair struct {
+	pair      string // authorized state of pair
+	count     string // tokenholders count of operator
+	allowance string // allowance of pair; empty for global operators
+	legacy    string // former operator tokenholders list; empty for global operators
+	holder    base.Address
+}
+
+func newOperatorPair(
+	contract base.Address, stoID currencytypes.ContractID, operator base.Address, partition stotypes.Partition, holder base.Address,
+) operatorPair {
+	return operatorPair{
+		pair:      stostate.StateKeyOperatorTokenHolder(contract, stoID, operator, partition, holder),
+		count:     stostate.StateKeyOperatorTokenHoldersCount(contract, stoID, operator, partition),
+		allowance: stostate.StateKeyOperatorAllowance(contract, stoID, holder, partition, operator),
+		legacy:    stostate.StateKeyOperatorTokenHolders(contract, stoID, operator, partition),
+		holder:    holder,
+	}
+}
+
+func newGlobalOperatorPair(
+	contract base.Address, stoID currencytypes.ContractID, operator base.Address, holder base.Address,
+) operatorPair {
+	return operatorPair{
+		pair:   stostate.StateKeyGlobalOperatorTokenHolder(contract, stoID, operator, holder),
+		count:  stostate.StateKeyGlobalOperatorTokenHoldersCount(contract, stoID, operator),
+		holder: holder,
+	}
+}
+
+// operatorRelations keeps the operator and tokenholder pairs, the operator
+// tokenholders counts and the allowances of released pairs changed while
+// processing an operation. Each pair is a separate state, so authorizing or
+// releasing one costs the same regardless of how many tokenholders an
+// operator serves.
+//
+// The pairs of partition operators authorized before the pair states are
+// only in the former operator tokenholders lists; the list is read when the
+// pair or count state does not exist yet, and the pair and count states
+// written by the operation take over from it.
+//
+// The counts read before the changes are kept, so the counts changed by
+// operations in the same block are merged by their differences.
+type operatorRelations struct {
+	pairs      map[string]bool
+	counts     map[string]uint64
+	reads      map[string]uint64
+	allowances map[string]struct{}
+}
+
+func newOperatorRelations() *operatorRelations {
+	return &operatorRelations{
+		pairs:      map[string]bool{},
+		counts:     map[string]uint64{},
+		reads:      map[string]uint64{},
+		allowances: map[string]struct{}{},
+	}
+}
+
+func (r *operatorRelations) isAuthorized(op operatorPair, getStateFunc base.GetStateFunc) (bool, error) {
+	if authorized, found := r.pairs[op.pair]; found {
+		return authorized, nil
+	}
+
+	switch st, found, err := getStateFunc(op.pair); {
+	case err != nil:
+		return false, err
+	case found:
+		return stostate.StateOperatorTokenHolderValue(st)
+	}
+
+	holders, err := legacyOperatorTokenHolders(op, getStateFunc)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range holders {
+		if holders[i].Equal(op.holder) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *operatorRelations) count(op operatorPair, getStateFunc base.GetStateFunc) (uint64, error) {
+	if count, found := r.counts[op.count]; found {
+		return count, nil
+	}
+
+	count, err := r.read(op, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	r.reads[op.count] = count
+
+	return count, nil
+}
+
+func (*operatorRelations) read(op operatorPair, getStateFunc base.GetStateFunc) (uint64, error) {
+	switch st, found, err := getStateFunc(op.count); {
+	case err != nil:
+		return 0, err
+	case found:
+		return stostate.StateOperatorTokenHoldersCountValue(st)
+	}
+
+	holders, err := legacyOperatorTokenHolders(op, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(holders)), nil
+}
+
+// authorize adds the pair and increases the count of operator within
+// maxTokenHolders.
+func (r *operatorRelations) authorize(op operatorPair, maxTokenHolders uint64, getStateFunc base.GetStateFunc) error {
+	switch authorized, err := r.isAuthorized(op, getStateFunc); {
+	case err != nil:
+		return err
+	case authorized:
+		return errors.Errorf("tokenholder already authorized operator, %q", op.pair)
+	}
+
+	count, err := r.count(op, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if count >= maxTokenHolders {
+		return errors.Errorf("operator tokenholders over %d, %q", maxTokenHolders, op.count)
+	}
+
+	r.pairs[op.pair] = true
+	r.counts[op.count] = count + 1
+
+	return nil
+}
+
+// release removes the pair and decreases the count of operator. The allowance
+// of pair is reset to zero, so it is not left to the operator. Pairs which
+// were not authorized are ignored.
+func (r *operatorRelations) release(op operatorPair, getStateFunc base.GetStateFunc) error {
+	switch authorized, err := r.isAuthorized(op, getStateFunc); {
+	case err != nil:
+		return err
+	case !authorized:
+		return nil
+	}
+
+	count, err := r.count(op, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	r.pairs[op.pair] = false
+	if count > 0 {
+		r.counts[op.count] = count - 1
+	} else {
+		r.counts[op.count] = 0
+	}
+
+	if len(op.allowance) < 1 {
+		return nil
+	}
+
+	switch _, found, err := getStateFunc(op.allowance); {
+	case err != nil:
+		return err
+	case found:
+		r.allowances[op.allowance] = struct{}{}
+	}
+
+	return nil
+}
+
+// isAllowanceReset reports whether the allowance, ak, is reset by the released
+// pair; the allowance spent in the same operation should not be written.
+func (r *operatorRelations) isAllowanceReset(ak string) bool {
+	_, found := r.allowances[ak]
+
+	return found
+}
+
+func (r *operatorRelations) stateMergeValues() []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, 0, len(r.pairs)+len(r.counts)+len(r.allowances))
+
+	for k, v := range r.pairs {
+		sts = append(sts, currencystate.NewStateMergeValue(k, stostate.NewOperatorTokenHolderStateValue(v)))
+	}
+
+	for k, v := range r.counts {
+		sts = append(sts, stostate.NewOperatorTokenHoldersCountStateMergeValue(k, r.reads[k], v))
+	}
+
+	for k := range r.allowances {
+		sts = append(sts, currencystate.NewStateMergeValue(k, resetOperatorAllowance()))
+	}
+
+	return sts
+}
+
+// legacyOperatorTokenHolders returns the former operator tokenholders list of
+// pair; empty when it does not exist.
+func legacyOperatorTokenHolders(op operatorPair, getStateFunc base.GetStateFunc) ([]base.Address, error) {
+	if len(op.legacy) < 1 {
+		return nil, nil
+	}
+
+	switch st, found, err := getStateFunc(op.legacy); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		return stostate.StateOperatorTokenHoldersValue(st)
+	}
+}
+
+// resetOperatorAllowance is the allowance of removed operator pair; nothing can
+// be spent by it.
+func resetOperatorAllowance() stostate.OperatorAllowanceStateValue {
+	return stostate.NewOperatorAllowanceStateValue(common.ZeroBig, 0)
+}
+
+func removeAddress(addrs *[]base.Address, a base.Address) error {
+	for i, ad := range *addrs {
+		if ad.Equal(a) {
+			*addrs = append((*addrs)[:i], (*addrs)[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return errors.Errorf("address not found, %q", a)
+}
@@ -0,0 +1,163 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RecoverTokenHolderFactHint = hint.MustNewHint("mitum-sto-recover-tokenholder-operation-fact-v0.0.1")
+	RecoverTokenHolderHint     = hint.MustNewHint("mitum-sto-recover-tokenholder-operation-v0.0.1")
+)
+
+// RecoverTokenHolderFact moves the tokens and the operators of lostHolder to
+// sender, the new address of tokenholder. The operation should be signed by
+// both sender and controller, a controller of sto.
+type RecoverTokenHolderFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address             // contract account
+	stoID      currencytypes.ContractID // token id
+	lostHolder base.Address             // lost tokenholder
+	controller base.Address             // approving controller
+	currency   currencytypes.CurrencyID // fee
+}
+
+func NewRecoverTokenHolderFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	lostHolder base.Address,
+	controller base.Address,
+	currency currencytypes.CurrencyID,
+) RecoverTokenHolderFact {
+	bf := base.NewBaseFact(RecoverTokenHolderFactHint, token)
+	fact := RecoverTokenHolderFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		stoID:      stoID,
+		lostHolder: lostHolder,
+		controller: controller,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RecoverTokenHolderFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RecoverTokenHolderFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RecoverTokenHolderFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.stoID.Bytes(),
+		fact.lostHolder.Bytes(),
+		fact.controller.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RecoverTokenHolderFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.stoID, fact.lostHolder, fact.controller, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.sender.Equal(fact.lostHolder) {
+		return util.ErrInvalid.Errorf("lost tokenholder is same with sender, %q", fact.sender)
+	}
+
+	if fact.sender.Equal(fact.controller) {
+		return util.ErrInvalid.Errorf("controller is same with sender, %q", fact.sender)
+	}
+
+	if fact.lostHolder.Equal(fact.controller) {
+		return util.ErrInvalid.Errorf("controller is same with lost tokenholder, %q", fact.controller)
+	}
+
+	if fact.contract.Equal(fact.lostHolder) {
+		return util.ErrInvalid.Errorf("lost tokenholder is same with contract, %q", fact.contract)
+	}
+
+	return nil
+}
+
+func (fact RecoverTokenHolderFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RecoverTokenHolderFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RecoverTokenHolderFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RecoverTokenHolderFact) STO() currencytypes.ContractID {
+	return fact.stoID
+}
+
+func (fact RecoverTokenHolderFact) LostTokenHolder() base.Address {
+	return fact.lostHolder
+}
+
+func (fact RecoverTokenHolderFact) Controller() base.Address {
+	return fact.controller
+}
+
+func (fact RecoverTokenHolderFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RecoverTokenHolderFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 4)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.lostHolder
+	as[3] = fact.controller
+
+	return as, nil
+}
+
+type RecoverTokenHolder struct {
+	common.BaseOperation
+}
+
+func NewRecoverTokenHolder(fact RecoverTokenHolderFact) (RecoverTokenHolder, error) {
+	return RecoverTokenHolder{BaseOperation: common.NewBaseOperation(RecoverTokenHolderHint, fact)}, nil
+}
+
+func (op *RecoverTokenHolder) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
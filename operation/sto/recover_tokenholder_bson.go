@@ -0,0 +1,86 @@
+package sto // nolint: dupl
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact RecoverTokenHolderFact) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":            fact.Hint().String(),
+			"sender":           fact.sender,
+			"contract":         fact.contract,
+			"stoid":            fact.stoID,
+			"lost_tokenholder": fact.lostHolder,
+			"controller":       fact.controller,
+			"currency":         fact.currency,
+			"hash":             fact.BaseFact.Hash().String(),
+			"token":            fact.BaseFact.Token(),
+		},
+	)
+}
+
+type RecoverTokenHolderFactBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Sender     string `bson:"sender"`
+	Contract   string `bson:"contract"`
+	STOID      string `bson:"stoid"`
+	LostHolder string `bson:"lost_tokenholder"`
+	Controller string `bson:"controller"`
+	Currency   string `bson:"currency"`
+}
+
+func (fact *RecoverTokenHolderFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RecoverTokenHolderFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf RecoverTokenHolderFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.STOID, uf.LostHolder, uf.Controller, uf.Currency)
+}
+
+func (op RecoverTokenHolder) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *RecoverTokenHolder) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RecoverTokenHolder")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RecoverTokenHolderFact) unpack(enc encoder.Encoder, sa, ca, stoid, la, con, cid string) error {
+	e := util.StringError("failed to unmarshal RecoverTokenHolderFact")
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(la, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.lostHolder = a
+	}
+
+	switch a, err := base.DecodeAddress(con, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.controller = a
+	}
+
+	fact.stoID = currencytypes.ContractID(stoid)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RecoverTokenHolderFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	STOID      currencytypes.ContractID `json:"stoid"`
+	LostHolder base.Address             `json:"lost_tokenholder"`
+	Controller base.Address             `json:"controller"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RecoverTokenHolderFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoverTokenHolderFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		STOID:                 fact.stoID,
+		LostHolder:            fact.lostHolder,
+		Controller:            fact.controller,
+		Currency:              fact.currency,
+	})
+}
+
+type RecoverTokenHolderFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	STOID      string `json:"stoid"`
+	LostHolder string `json:"lost_tokenholder"`
+	Controller string `json:"controller"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RecoverTokenHolderFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RecoverTokenHolderFact")
+
+	var uf RecoverTokenHolderFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.STOID, uf.LostHolder, uf.Controller, uf.Currency)
+}
+
+type RecoverTokenHolderMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RecoverTokenHolder) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RecoverTokenHolderMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RecoverTokenHolder) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RecoverTokenHolder")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
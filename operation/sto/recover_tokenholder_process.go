@@ -0,0 +1,448 @@
+package sto
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var recoverTokenHolderProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RecoverTokenHolderProcessor)
+	},
+}
+
+func (RecoverTokenHolder) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RecoverTokenHolderProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRecoverTokenHolderProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RecoverTokenHolderProcessor")
+
+		nopp := recoverTokenHolderProcessorPool.Get()
+		opp, ok := nopp.(*RecoverTokenHolderProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RecoverTokenHolderProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RecoverTokenHolderProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RecoverTokenHolder")
+
+	fact, ok := op.Fact().(RecoverTokenHolderFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("not RecoverTokenHolderFact, %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot recover tokenholder, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := checkAccountSigns(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", fact.Contract(), err), nil
+	}
+
+	design, err := stostate.ExistsDesign(fact.Contract(), fact.STO(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	policy := design.Policy()
+
+	isController := false
+	for _, con := range policy.Controllers() {
+		if con.Equal(fact.Controller()) {
+			isController = true
+			break
+		}
+	}
+
+	if !isController {
+		return nil, base.NewBaseOperationProcessReasonError("not controller of sto, %s-%s, %q", fact.Contract(), fact.STO(), fact.Controller()), nil
+	}
+
+	if err := checkAccountSigns(fact.Controller(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("not approved by controller, %q: %w", fact.Controller(), err), nil
+	}
+
+	partitions, err := stostate.ExistsTokenHolderPartitions(fact.Contract(), fact.STO(), fact.LostTokenHolder(), getStateFunc)
+	switch {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	case len(partitions) < 1:
+		return nil, base.NewBaseOperationProcessReasonError("lost tokenholder holds no tokens, %s-%s-%s", fact.Contract(), fact.STO(), fact.LostTokenHolder()), nil
+	}
+
+	if err := checkRecoveryTokenHolder(fact.Contract(), fact.STO(), fact.Sender(), partitions, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := stostate.CheckTokenHolderKYC(policy.KYC(), fact.Sender(), opp.Height(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := checkTokenHolderJurisdiction(fact.Contract(), design, fact.Sender(), opp.Height(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RecoverTokenHolderProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RecoverTokenHolder")
+
+	fact, ok := op.Fact().(RecoverTokenHolderFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected RecoverTokenHolderFact, not %T", op.Fact()))
+	}
+
+	design, err := stostate.ExistsDesign(fact.Contract(), fact.STO(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	partitions, err := stostate.ExistsTokenHolderPartitions(fact.Contract(), fact.STO(), fact.LostTokenHolder(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	r := newTokenHolderRecovery(fact.Contract(), fact.STO(), fact.LostTokenHolder(), fact.Sender(), getStateFunc)
+
+	if err := r.recover(partitions); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to recover tokenholder, %q: %w", fact.LostTokenHolder(), err), nil
+	}
+
+	holders := newJurisdictionHolders()
+
+	if err := holders.leave(fact.Contract(), fact.STO(), fact.LostTokenHolder(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	j, err := stostate.TokenHolderJurisdiction(design.Policy().KYC(), fact.Sender(), opp.Height(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := holders.join(fact.Contract(), fact.STO(), fact.Sender(), j, design.Jurisdictions(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	sts := append(r.sts, holders.stateMergeValues()...) // nolint:gocritic
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *RecoverTokenHolderProcessor) Close() error {
+	recoverTokenHolderProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkAccountSigns checks the signs of address pass its threshold. Unlike
+// CheckFactSignsByState, the signs of the other signers are ignored, so one
+// operation can be signed by several accounts.
+func checkAccountSigns(address base.Address, signs []base.Sign, getStateFunc base.GetStateFunc) error {
+	st, err := currencystate.ExistsState(currency.StateKeyAccount(address), "keys of account", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := currency.StateKeysValue(st)
+	switch {
+	case err != nil:
+		return errors.Errorf("failed to get keys, %q: %v", address, err)
+	case keys == nil:
+		return errors.Errorf("empty keys found, %q", address)
+	}
+
+	var fs []base.Sign
+	for i := range signs {
+		if _, found := keys.Key(signs[i].Signer()); found {
+			fs = append(fs, signs[i])
+		}
+	}
+
+	return currencytypes.CheckThreshold(fs, keys)
+}
+
+// checkRecoveryTokenHolder checks holder, the new address of tokenholder,
+// neither holds tokens of sto nor has operators in it.
+func checkRecoveryTokenHolder(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	holder base.Address,
+	partitions []stotypes.Partition,
+	getStateFunc base.GetStateFunc,
+) error {
+	switch st, found, err := getStateFunc(stostate.StateKeyTokenHolderPartitions(contract, stoID, holder)); {
+	case err != nil:
+		return err
+	case found:
+		if ps, err := stostate.StateTokenHolderPartitionsValue(st); err == nil && len(ps) > 0 {
+			return errors.Errorf("new tokenholder already holds tokens, %s-%s-%s", contract, stoID, holder)
+		}
+	}
+
+	switch st, found, err := getStateFunc(stostate.StateKeyTokenHolderOperators(contract, stoID, holder)); {
+	case err != nil:
+		return err
+	case found:
+		if operators, err := stostate.StateTokenHolderOperatorsValue(st); err == nil && len(operators) > 0 {
+			return errors.Errorf("new tokenholder already has global operators, %s-%s-%s", contract, stoID, holder)
+		}
+	}
+
+	for _, p := range partitions {
+		switch st, found, err := getStateFunc(stostate.StateKeyTokenHolderPartitionOperators(contract, stoID, holder, p)); {
+		case err != nil:
+			return err
+		case found:
+			if operators, err := stostate.StateTokenHolderPartitionOperatorsValue(st); err == nil && len(operators) > 0 {
+				return errors.Errorf("new tokenholder already has operators, %s-%s-%s-%s", contract, stoID, holder, p)
+			}
+		}
+	}
+
+	return nil
+}
+
+// tokenHolderRecovery moves the states of lost tokenholder to the new
+// tokenholder. The operator tokenholders counts are not changed, because each
+// operator serves the new tokenholder instead of the lost one.
+type tokenHolderRecovery struct {
+	contract     base.Address
+	stoID        currencytypes.ContractID
+	lost         base.Address
+	holder       base.Address
+	getStateFunc base.GetStateFunc
+	sts          []base.StateMergeValue
+}
+
+func newTokenHolderRecovery(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	lost, holder base.Address,
+	getStateFunc base.GetStateFunc,
+) *tokenHolderRecovery {
+	return &tokenHolderRecovery{
+		contract:     contract,
+		stoID:        stoID,
+		lost:         lost,
+		holder:       holder,
+		getStateFunc: getStateFunc,
+	}
+}
+
+func (r *tokenHolderRecovery) set(k string, v base.StateValue) {
+	r.sts = append(r.sts, currencystate.NewStateMergeValue(k, v))
+}
+
+func (r *tokenHolderRecovery) recover(partitions []stotypes.Partition) error {
+	r.set(stostate.StateKeyTokenHolderPartitions(r.contract, r.stoID, r.holder), stostate.NewTokenHolderPartitionsStateValue(partitions))
+	r.set(stostate.StateKeyTokenHolderPartitions(r.contract, r.stoID, r.lost), stostate.NewTokenHolderPartitionsStateValue([]stotypes.Partition{}))
+
+	for _, p := range partitions {
+		balance, err := stostate.ExistsTokenHolderPartitionBalance(r.contract, r.stoID, r.lost, p, r.getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		r.set(stostate.StateKeyTokenHolderPartitionBalance(r.contract, r.stoID, r.holder, p), stostate.NewTokenHolderPartitionBalanceStateValue(balance, p))
+		r.set(stostate.StateKeyTokenHolderPartitionBalance(r.contract, r.stoID, r.lost, p), stostate.NewTokenHolderPartitionBalanceStateValue(common.ZeroBig, p))
+
+		if err := r.recoverOperators(p); err != nil {
+			return err
+		}
+	}
+
+	return r.recoverGlobalOperators()
+}
+
+func (r *tokenHolderRecovery) recoverOperators(p stotypes.Partition) error {
+	var operators []base.Address
+
+	switch st, found, err := r.getStateFunc(stostate.StateKeyTokenHolderPartitionOperators(r.contract, r.stoID, r.lost, p)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		operators, err = stostate.StateTokenHolderPartitionOperatorsValue(st)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.set(stostate.StateKeyTokenHolderPartitionOperators(r.contract, r.stoID, r.holder, p), stostate.NewTokenHolderPartitionOperatorsStateValue(operators))
+	r.set(stostate.StateKeyTokenHolderPartitionOperators(r.contract, r.stoID, r.lost, p), stostate.NewTokenHolderPartitionOperatorsStateValue([]base.Address{}))
+
+	for _, o := range operators {
+		if err := r.movePair(
+			stostate.StateKeyOperatorTokenHolder(r.contract, r.stoID, o, p, r.lost),
+			stostate.StateKeyOperatorTokenHolder(r.contract, r.stoID, o, p, r.holder),
+		); err != nil {
+			return err
+		}
+
+		switch st, found, err := r.getStateFunc(stostate.StateKeyOperatorAllowance(r.contract, r.stoID, r.lost, p, o)); {
+		case err != nil:
+			return err
+		case found:
+			r.set(stostate.StateKeyOperatorAllowance(r.contract, r.stoID, r.holder, p, o), st.Value())
+			r.set(stostate.StateKeyOperatorAllowance(r.contract, r.stoID, r.lost, p, o), resetOperatorAllowance())
+		}
+
+		if err := r.replaceOperatorTokenHolder(stostate.StateKeyOperatorTokenHolders(r.contract, r.stoID, o, p)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *tokenHolderRecovery) recoverGlobalOperators() error {
+	var operators []base.Address
+
+	switch st, found, err := r.getStateFunc(stostate.StateKeyTokenHolderOperators(r.contract, r.stoID, r.lost)); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		operators, err = stostate.StateTokenHolderOperatorsValue(st)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.set(stostate.StateKeyTokenHolderOperators(r.contract, r.stoID, r.holder), stostate.NewTokenHolderOperatorsStateValue(operators))
+	r.set(stostate.StateKeyTokenHolderOperators(r.contract, r.stoID, r.lost), stostate.NewTokenHolderOperatorsStateValue([]base.Address{}))
+
+	for _, o := range operators {
+		if err := r.movePair(
+			stostate.StateKeyGlobalOperatorTokenHolder(r.contract, r.stoID, o, r.lost),
+			stostate.StateKeyGlobalOperatorTokenHolder(r.contract, r.stoID, o, r.holder),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// movePair moves the operator and tokenholder pair of lost tokenholder, lk, to
+// the new tokenholder, hk.
+func (r *tokenHolderRecovery) movePair(lk, hk string) error {
+	switch st, found, err := r.getStateFunc(lk); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		authorized, err := stostate.StateOperatorTokenHolderValue(st)
+		switch {
+		case err != nil:
+			return err
+		case !authorized:
+			return nil
+		}
+	}
+
+	r.set(hk, stostate.NewOperatorTokenHolderStateValue(true))
+	r.set(lk, stostate.NewOperatorTokenHolderStateValue(false))
+
+	return nil
+}
+
+// replaceOperatorTokenHolder replaces lost tokenholder with the new one in the
+// former list of operator tokenholders, if it is still in state.
+func (r *tokenHolderRecovery) replaceOperatorTokenHolder(k string) error {
+	switch st, found, err := r.getStateFunc(k); {
+	case err != nil:
+		return err
+	case !found:
+		return nil
+	default:
+		holders, err := stostate.StateOperatorTokenHoldersValue(st)
+		if err != nil {
+			return err
+		}
+
+		replaced := make([]base.Address, len(holders))
+		for i := range holders {
+			if holders[i].Equal(r.lost) {
+				replaced[i] = r.holder
+			} else {
+				replaced[i] = holders[i]
+			}
+		}
+
+		r.set(k, stostate.NewOperatorTokenHoldersStateValue(replaced))
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRecoverTokenHolderProcess(t *testing.T) {
+	// recoverOp builds the operation of holder, the new address of lost
+	// tokenholder, approved by controller of sto.
+	recoverOp := func(f *fixture, holder test.Account, controller base.Address, approved bool) (base.Operation, error) {
+		op, err := holder.Builder().RecoverTokenHolder(
+			holder.Address, f.contract, f.stoID, f.holder.Address, controller, f.currency)
+		if err != nil || !approved {
+			return op, err
+		}
+
+		return f.controller.Builder().Sign(op)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "recover",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.controller.Address, true)
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 0)
+				f.checkTokenHolderBalance(f.receiver.Address, 100)
+				f.checkPartitionBalance(100)
+				f.checkBalance(f.receiver.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "recovered tokenholder transfers",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(recoverOp(f, f.receiver, f.controller.Address, true))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.receiver.Builder().TransferSecurityTokensPartition(
+					f.receiver.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						f.contract, f.stoID, f.receiver.Address, f.operator.Address, f.partition, common.NewBig(10), f.currency,
+					),
+				)
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.receiver.Address, 90)
+				f.checkTokenHolderBalance(f.operator.Address, 10)
+			},
+		},
+		{
+			name: "not approved by controller",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.controller.Address, false)
+			},
+			reason: "not approved by controller",
+		},
+		{
+			name: "not controller",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.operator.Address, true)
+			},
+			reason: "not controller of sto",
+		},
+		{
+			name: "lost tokenholder holds no tokens",
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.controller.Address, true)
+			},
+			reason: "tokenholder partitions not found",
+		},
+		{
+			name: "new tokenholder holds tokens",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.controller.Address, true)
+			},
+			reason: "new tokenholder already holds tokens",
+		},
+		{
+			name: "new tokenholder has global operators",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(f.receiver.Builder().AuthorizeGlobalOperators(
+					f.receiver.Address,
+					sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, f.operator.Address, f.currency),
+				))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.receiver, f.controller.Address, true)
+			},
+			reason: "new tokenholder already has global operators",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return recoverOp(f, f.poor, f.controller.Address, true)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 100)
+			},
+		},
+	})
+}
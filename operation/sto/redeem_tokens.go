@@ -2,6 +2,7 @@ package sto
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,6 +14,8 @@ var (
 	RedeemTokensHint     = hint.MustNewHint("mitum-sto-redeem-tokens-operation-v0.0.1")
 )
 
+var MaxRedeemTokensItems = uint(networktypes.MaxItemsLimit)
+
 type RedeemTokensFact struct {
 	base.BaseFact
 	sender base.Address
@@ -59,6 +62,8 @@ func (fact RedeemTokensFact) IsValid(b []byte) error {
 
 	if n := len(fact.items); n < 1 {
 		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxRedeemTokensItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxRedeemTokensItems)
 	}
 
 	if err := fact.sender.IsValid(nil); err != nil {
@@ -7,11 +7,11 @@ import (
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -43,6 +43,8 @@ type RedeemTokensItemProcessor struct {
 	item             RedeemTokensItem
 	sto              *stotypes.Design
 	partitionBalance *common.Big
+	relations        *operatorRelations
+	holders          *jurisdictionHolders
 }
 
 func (ipp *RedeemTokensItemProcessor) PreProcess(
@@ -78,22 +80,11 @@ func (ipp *RedeemTokensItemProcessor) PreProcess(
 		}
 
 		if !isController {
-			st, err := currencystate.ExistsState(stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), it.TokenHolder(), it.Partition()), "key of tokenholder partition operators", getStateFunc)
+			ok, err := stostate.IsTokenHolderOperator(it.Contract(), it.STO(), it.TokenHolder(), it.Partition(), ipp.sender, getStateFunc)
 			if err != nil {
 				return err
 			}
-
-			operators, err := stostate.StateTokenHolderPartitionOperatorsValue(st)
-			if err != nil {
-				return err
-			}
-
-			for _, op := range operators {
-				if op.Equal(ipp.sender) {
-					isOperator = true
-					break
-				}
-			}
+			isOperator = ok
 		}
 
 		if !(isController || isOperator) {
@@ -157,7 +148,7 @@ func (ipp *RedeemTokensItemProcessor) Process(
 	aggr := policy.Aggregate().Sub(it.Amount())
 
 	if (*ipp.partitionBalance).OverZero() {
-		policy = stotypes.NewPolicy(policy.Partitions(), aggr, policy.Controllers(), policy.Documents())
+		policy = stotypes.NewPolicy(policy.Partitions(), aggr, policy.Controllers(), policy.Documents(), policy.KYC())
 		if err := policy.IsValid(nil); err != nil {
 			return nil, err
 		}
@@ -172,13 +163,13 @@ func (ipp *RedeemTokensItemProcessor) Process(
 			}
 		}
 
-		policy = stotypes.NewPolicy(partitions, aggr, policy.Controllers(), policy.Documents())
+		policy = stotypes.NewPolicy(partitions, aggr, policy.Controllers(), policy.Documents(), policy.KYC())
 		if err := policy.IsValid(nil); err != nil {
 			return nil, err
 		}
 	}
 
-	design = stotypes.NewDesign(it.STO(), design.Granularity(), policy)
+	design = design.SetPolicy(policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -208,16 +199,25 @@ func (ipp *RedeemTokensItemProcessor) Process(
 			}
 		}
 
-		opk := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), it.TokenHolder(), it.Partition())
-
-		st, err := currencystate.ExistsState(opk, "key of tokenholder partition operators", getStateFunc)
-		if err != nil {
-			return nil, err
+		if len(tokenholderPartitions) == 0 {
+			if err := ipp.holders.leave(it.Contract(), it.STO(), it.TokenHolder(), getStateFunc); err != nil {
+				return nil, err
+			}
 		}
 
-		operators, err := stostate.StateTokenHolderPartitionOperatorsValue(st)
-		if err != nil {
+		opk := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), it.TokenHolder(), it.Partition())
+
+		var operators []base.Address
+		switch st, found, err := getStateFunc(opk); {
+		case err != nil:
 			return nil, err
+		case found:
+			operators, err = stostate.StateTokenHolderPartitionOperatorsValue(st)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			operators = []base.Address{}
 		}
 
 		sts = append(sts, currencystate.NewStateMergeValue(
@@ -225,30 +225,11 @@ func (ipp *RedeemTokensItemProcessor) Process(
 		))
 
 		for _, op := range operators {
-			thk := stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), op, it.Partition())
-
-			st, err := currencystate.ExistsState(thk, "key of operator tokenholders", getStateFunc)
-			if err != nil {
-				return nil, err
-			}
-
-			holders, err := stostate.StateOperatorTokenHoldersValue(st)
-			if err != nil {
+			if err := ipp.relations.release(
+				newOperatorPair(it.Contract(), it.STO(), op, it.Partition(), it.TokenHolder()), getStateFunc,
+			); err != nil {
 				return nil, err
 			}
-
-			for i, th := range holders {
-				if th.Equal(it.TokenHolder()) {
-					if i < len(holders)-1 {
-						copy(holders[i:], holders[i+1:])
-					}
-					holders = holders[:len(holders)-1]
-				}
-			}
-
-			sts = append(sts, currencystate.NewStateMergeValue(
-				thk, stostate.NewOperatorTokenHoldersStateValue(holders),
-			))
 		}
 	}
 
@@ -269,6 +250,8 @@ func (ipp *RedeemTokensItemProcessor) Close() error {
 	ipp.sender = nil
 	ipp.item = RedeemTokensItem{}
 	ipp.sto = nil
+	ipp.relations = nil
+	ipp.holders = nil
 	ipp.partitionBalance = nil
 
 	redeemTokensItemProcessorPool.Put(ipp)
@@ -321,6 +304,15 @@ func (opp *RedeemTokensProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -353,11 +345,20 @@ func (opp *RedeemTokensProcessor) PreProcess(
 		}
 	}
 
-	_, err := checkEnoughPartitionBalance(getStateFunc, fact.Items())
-	if err != nil {
+	if _, err := checkEnoughPartitionBalance(getStateFunc, fact.Items()); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("not enough partition balance: %w", err), nil
 	}
 
+	fitems := fact.Items()
+	sitems := make([]OperatorSpendItem, len(fitems))
+	for i := range fitems {
+		sitems[i] = fitems[i]
+	}
+
+	if _, err := spendOperatorAllowances(opp.Height(), fact.Sender(), sitems, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("not enough operator allowance: %w", err), nil
+	}
+
 	for _, it := range fact.Items() {
 		ip := redeemTokensItemProcessorPool.Get()
 		ipc, ok := ip.(*RedeemTokensItemProcessor)
@@ -370,6 +371,8 @@ func (opp *RedeemTokensProcessor) PreProcess(
 		ipc.item = it
 		ipc.sto = stos[stostate.StateKeyDesign(it.Contract(), it.STO())]
 		ipc.partitionBalance = nil
+		ipc.relations = nil
+		ipc.holders = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess RedeemTokensItem: %w", err), nil
@@ -419,6 +422,9 @@ func (opp *RedeemTokensProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
+	relations := newOperatorRelations()
+	holders := newJurisdictionHolders()
+
 	ipcs := make([]*RedeemTokensItemProcessor, len(fact.Items()))
 	for i, it := range fact.Items() {
 		ip := redeemTokensItemProcessorPool.Get()
@@ -432,6 +438,8 @@ func (opp *RedeemTokensProcessor) Process( // nolint:dupl
 		ipc.item = it
 		ipc.sto = stos[stostate.StateKeyDesign(it.Contract(), it.STO())]
 		ipc.partitionBalance = partitionBalances[stostate.StateKeyPartitionBalance(it.Contract(), it.STO(), it.Partition())]
+		ipc.relations = relations
+		ipc.holders = holders
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -450,35 +458,41 @@ func (opp *RedeemTokensProcessor) Process( // nolint:dupl
 		sts = append(sts, currencystate.NewStateMergeValue(k, stostate.NewPartitionBalanceStateValue(*v)))
 	}
 
+	sts = append(sts, relations.stateMergeValues()...)
+	sts = append(sts, holders.stateMergeValues()...)
+
 	for _, ipc := range ipcs {
 		ipc.Close()
 	}
 
 	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
+	sitems := make([]OperatorSpendItem, len(fitems))
+	for i := range fitems {
+		sitems[i] = fitems[i]
 	}
 
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	allowances, err := spendOperatorAllowances(opp.Height(), fact.Sender(), sitems, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+		return nil, base.NewBaseOperationProcessReasonError("not enough operator allowance: %w", err), nil
+	}
+
+	for k, v := range allowances {
+		if v.IsLimited() && !relations.isAllowanceReset(k) {
+			sts = append(sts, currencystate.NewStateMergeValue(k, v))
+		}
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
+
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *RedeemTokensProcessor) Close() error {
@@ -0,0 +1,177 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRedeemTokensProcess(t *testing.T) {
+	item := func(f *fixture, holder base.Address, amount int64) sto.RedeemTokensItem {
+		return sto.NewRedeemTokensItem(f.contract, f.stoID, holder, common.NewBig(amount), f.partition, f.currency)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "by tokenholder",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 30))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkPartitionBalance(70)
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+
+				if a := f.STO(f.contract, f.stoID).Policy().Aggregate(); !a.Equal(common.NewBig(70)) {
+					f.t.Errorf("expected aggregate 70, but %s", a)
+				}
+			},
+		},
+		{
+			name: "whole balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 100))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 0)
+				f.checkPartitionBalance(100)
+
+				ps, err := stostate.ExistsTokenHolderPartitions(f.contract, f.stoID, f.holder.Address, f.GetStateFunc)
+				if err != nil {
+					f.t.Fatalf("tokenholder partitions not found: %+v", err)
+				}
+
+				if len(ps) != 0 {
+					f.t.Errorf("expected empty partitions, but %v", ps)
+				}
+			},
+		},
+		{
+			name: "whole balance with operator",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 100))
+			},
+			check: func(f *fixture) {
+				ok, err := stostate.IsTokenHolderOperator(
+					f.contract, f.stoID, f.holder.Address, f.partition, f.operator.Address, f.GetStateFunc)
+				if err != nil {
+					f.t.Fatalf("failed to check operator: %+v", err)
+				}
+
+				if ok {
+					f.t.Error("operator should be released")
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().RedeemTokens(
+					f.controller.Address,
+					item(f, f.holder.Address, 30),
+					item(f, f.receiver.Address, 20),
+				)
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkTokenHolderBalance(f.receiver.Address, 80)
+				f.checkPartitionBalance(150)
+				f.checkBalance(f.controller.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "by operator",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().RedeemTokens(f.operator.Address, item(f, f.holder.Address, 30))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+			},
+		},
+		{
+			name: "neither controller nor operator",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().RedeemTokens(f.operator.Address, item(f, f.holder.Address, 30))
+			},
+			reason: "sender is neither controller nor operator",
+		},
+		{
+			name: "insufficient tokenholder balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 150))
+			},
+			reason: "tokenholder partition balance not over item amount",
+		},
+		{
+			name: "insufficient partition balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 150))
+			},
+			reason: "not enough partition balance",
+		},
+		{
+			name: "granularity",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+
+				design := f.STO(f.contract, f.stoID)
+				f.Set(
+					stostate.StateKeyDesign(f.contract, f.stoID),
+					stostate.NewDesignStateValue(stotypes.NewDesign(f.stoID, 10, design.Policy(), design.Jurisdictions())),
+				)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RedeemTokens(f.holder.Address, item(f, f.holder.Address, 15))
+			},
+			reason: "granularity",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.issue(f.poor.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().RedeemTokens(f.poor.Address, item(f, f.poor.Address, 30))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.poor.Address, 100)
+				f.checkPartitionBalance(100)
+			},
+		},
+	})
+}
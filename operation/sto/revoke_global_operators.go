@@ -0,0 +1,140 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevokeGlobalOperatorsFactHint = hint.MustNewHint("mitum-sto-revoke-global-operator-operation-fact-v0.0.1")
+	RevokeGlobalOperatorsHint     = hint.MustNewHint("mitum-sto-revoke-global-operator-operation-v0.0.1")
+)
+
+var MaxRevokeGlobalOperatorsItems = uint(networktypes.MaxItemsLimit)
+
+type RevokeGlobalOperatorsFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []RevokeGlobalOperatorsItem
+}
+
+func NewRevokeGlobalOperatorsFact(token []byte, sender base.Address, items []RevokeGlobalOperatorsItem) RevokeGlobalOperatorsFact {
+	bf := base.NewBaseFact(RevokeGlobalOperatorsFactHint, token)
+	fact := RevokeGlobalOperatorsFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeGlobalOperatorsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeGlobalOperatorsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeGlobalOperatorsFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact RevokeGlobalOperatorsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxRevokeGlobalOperatorsItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxRevokeGlobalOperatorsItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+		}
+
+		if _, found := founds[it.Operator().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate operator found, %s", it.Operator())
+		}
+
+		founds[it.operator.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (fact RevokeGlobalOperatorsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeGlobalOperatorsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeGlobalOperatorsFact) Items() []RevokeGlobalOperatorsItem {
+	return fact.items
+}
+
+func (fact RevokeGlobalOperatorsFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type RevokeGlobalOperators struct {
+	common.BaseOperation
+}
+
+func NewRevokeGlobalOperators(fact RevokeGlobalOperatorsFact) (RevokeGlobalOperators, error) {
+	return RevokeGlobalOperators{BaseOperation: common.NewBaseOperation(RevokeGlobalOperatorsHint, fact)}, nil
+}
+
+func (op *RevokeGlobalOperators) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
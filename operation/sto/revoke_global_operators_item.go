@@ -0,0 +1,85 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var RevokeGlobalOperatorsItemHint = hint.MustNewHint("mitum-sto-revoke-global-operators-item-v0.0.1")
+
+type RevokeGlobalOperatorsItem struct {
+	hint.BaseHinter
+	contract base.Address             // contract account
+	stoID    currencytypes.ContractID // token id
+	operator base.Address             // operator account
+	currency currencytypes.CurrencyID // fee
+}
+
+func NewRevokeGlobalOperatorsItem(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	operator base.Address,
+	currency currencytypes.CurrencyID,
+) RevokeGlobalOperatorsItem {
+	return RevokeGlobalOperatorsItem{
+		BaseHinter: hint.NewBaseHinter(RevokeGlobalOperatorsItemHint),
+		contract:   contract,
+		stoID:      stoID,
+		operator:   operator,
+		currency:   currency,
+	}
+}
+
+func (it RevokeGlobalOperatorsItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.stoID.Bytes(),
+		it.operator.Bytes(),
+		it.currency.Bytes(),
+	)
+}
+
+func (it RevokeGlobalOperatorsItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.stoID,
+		it.operator,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.operator) {
+		return util.ErrInvalid.Errorf("contract address is same with operator, %q", it.contract)
+	}
+
+	return nil
+}
+
+func (it RevokeGlobalOperatorsItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it RevokeGlobalOperatorsItem) STO() currencytypes.ContractID {
+	return it.stoID
+}
+
+func (it RevokeGlobalOperatorsItem) Operator() base.Address {
+	return it.operator
+}
+
+func (it RevokeGlobalOperatorsItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it RevokeGlobalOperatorsItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.operator
+
+	return ad
+}
@@ -0,0 +1,46 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RevokeGlobalOperatorsItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	STO      currencytypes.ContractID `json:"stoid"`
+	Operator base.Address             `json:"operator"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (it RevokeGlobalOperatorsItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeGlobalOperatorsItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		STO:        it.stoID,
+		Operator:   it.operator,
+		Currency:   it.currency,
+	})
+}
+
+type RevokeGlobalOperatorsItemJSONUnMarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	STO      string    `json:"stoid"`
+	Operator string    `json:"operator"`
+	Currency string    `json:"currency"`
+}
+
+func (it *RevokeGlobalOperatorsItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeGlobalOperatorsItem")
+
+	var uit RevokeGlobalOperatorsItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, uit.Hint, uit.Contract, uit.STO, uit.Operator, uit.Currency)
+}
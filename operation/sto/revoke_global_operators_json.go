@@ -0,0 +1,66 @@
+package sto
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RevokeGlobalOperatorsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner base.Address                `json:"sender"`
+	Items []RevokeGlobalOperatorsItem `json:"items"`
+}
+
+func (fact RevokeGlobalOperatorsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeGlobalOperatorsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type RevokeGlobalOperatorsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner string          `json:"sender"`
+	Items json.RawMessage `json:"items"`
+}
+
+func (fact *RevokeGlobalOperatorsFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeGlobalOperatorsFact")
+
+	var uf RevokeGlobalOperatorsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Items)
+}
+
+type RevokeGlobalOperatorsMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeGlobalOperators) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeGlobalOperatorsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeGlobalOperators) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeGlobalOperators")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
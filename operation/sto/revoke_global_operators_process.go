@@ -0,0 +1,300 @@
+package sto
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var revokeGlobalOperatorsItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevokeGlobalOperatorsItemProcessor)
+	},
+}
+
+var revokeGlobalOperatorsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevokeGlobalOperatorsProcessor)
+	},
+}
+
+func (RevokeGlobalOperators) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RevokeGlobalOperatorsItemProcessor struct {
+	h         util.Hash
+	sender    base.Address
+	item      RevokeGlobalOperatorsItem
+	operators *[]base.Address
+	relations *operatorRelations
+}
+
+func (ipp *RevokeGlobalOperatorsItemProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(it.Contract()), getStateFunc); err != nil {
+		return err
+	}
+
+	if _, err := stostate.ExistsDesign(it.Contract(), it.STO(), getStateFunc); err != nil {
+		return err
+	}
+
+	if len(*ipp.operators) == 0 {
+		return errors.Errorf("empty tokenholder global operators, %s-%s-%s", it.Contract(), it.STO(), ipp.sender)
+	}
+
+	for i, ad := range *ipp.operators {
+		if ad.Equal(it.Operator()) {
+			break
+		}
+
+		if i == len(*ipp.operators)-1 {
+			return errors.Errorf("operator not in tokenholder global operators, %s-%s-%s, %q", it.Contract(), it.STO(), ipp.sender, it.Operator())
+		}
+	}
+
+	switch authorized, err := ipp.relations.isAuthorized(
+		newGlobalOperatorPair(it.Contract(), it.STO(), it.Operator(), ipp.sender), getStateFunc,
+	); {
+	case err != nil:
+		return err
+	case !authorized:
+		return errors.Errorf("sender not in global operator tokenholders, %s-%s-%s, %q", it.Contract(), it.STO(), it.Operator(), ipp.sender)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *RevokeGlobalOperatorsItemProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	if err := removeAddress(ipp.operators, it.Operator()); err != nil {
+		return nil, errors.Errorf("operator not in tokenholder global operators, %s-%s-%s, %q", it.Contract(), it.STO(), ipp.sender, it.Operator())
+	}
+
+	if err := ipp.relations.release(
+		newGlobalOperatorPair(it.Contract(), it.STO(), it.Operator(), ipp.sender), getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func (ipp *RevokeGlobalOperatorsItemProcessor) Close() error {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = RevokeGlobalOperatorsItem{}
+	ipp.operators = nil
+	ipp.relations = nil
+
+	revokeGlobalOperatorsItemProcessorPool.Put(ipp)
+
+	return nil
+}
+
+type RevokeGlobalOperatorsProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRevokeGlobalOperatorsProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RevokeGlobalOperatorsProcessor")
+
+		nopp := revokeGlobalOperatorsProcessorPool.Get()
+		opp, ok := nopp.(*RevokeGlobalOperatorsProcessor)
+		if !ok {
+			return nil, e.Wrap(errors.Errorf("expected RevokeGlobalOperatorsProcessor, not %T", nopp))
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RevokeGlobalOperatorsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RevokeGlobalOperators")
+
+	fact, ok := op.Fact().(RevokeGlobalOperatorsFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("expected RevokeGlobalOperatorsFact, not %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot set its operators, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	operators := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
+
+	for _, it := range fact.Items() {
+		k := stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.Sender())
+		if _, found := operators[k]; !found {
+			ops, err := loadTokenHolderOperators(k, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			operators[k] = ops
+		}
+	}
+
+	for _, it := range fact.Items() {
+		ip := revokeGlobalOperatorsItemProcessorPool.Get()
+		ipc, ok := ip.(*RevokeGlobalOperatorsItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RevokeGlobalOperatorsItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.operators = operators[stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.sender)]
+		ipc.relations = relations
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess RevokeGlobalOperatorsItem: %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RevokeGlobalOperatorsProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RevokeGlobalOperators")
+
+	fact, ok := op.Fact().(RevokeGlobalOperatorsFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected RevokeGlobalOperatorsFact, not %T", op.Fact()))
+	}
+
+	operators := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
+
+	for _, it := range fact.Items() {
+		k := stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.Sender())
+		if _, found := operators[k]; !found {
+			ops, err := loadTokenHolderOperators(k, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			operators[k] = ops
+		}
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	ipcs := make([]*RevokeGlobalOperatorsItemProcessor, len(fact.items))
+	for i, it := range fact.Items() {
+		ip := revokeGlobalOperatorsItemProcessorPool.Get()
+		ipc, ok := ip.(*RevokeGlobalOperatorsItemProcessor)
+		if !ok {
+			return nil, nil, e.Wrap(errors.Errorf("expected RevokeGlobalOperatorsItemProcessor, not %T", ip))
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.operators = operators[stostate.StateKeyTokenHolderOperators(it.Contract(), it.STO(), fact.sender)]
+		ipc.relations = relations
+
+		s, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process RevokeGlobalOperatorsItem: %w", err), nil
+		}
+		sts = append(sts, s...)
+
+		ipcs[i] = ipc
+	}
+
+	for k, v := range operators {
+		sts = append(sts, currencystate.NewStateMergeValue(
+			k,
+			stostate.NewTokenHolderOperatorsStateValue(*v),
+		))
+	}
+
+	sts = append(sts, relations.stateMergeValues()...)
+
+	for _, ipc := range ipcs {
+		ipc.Close()
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *RevokeGlobalOperatorsProcessor) Close() error {
+	revokeGlobalOperatorsProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRevokeGlobalOperatorsProcess(t *testing.T) {
+	item := func(f *fixture, operator base.Address) sto.RevokeGlobalOperatorsItem {
+		return sto.NewRevokeGlobalOperatorsItem(f.contract, f.stoID, operator, f.currency)
+	}
+
+	// authorizeGlobal authorizes operators as the global operators of holder.
+	authorizeGlobal := func(f *fixture, holder test.Account, operators ...base.Address) {
+		items := make([]sto.AuthorizeGlobalOperatorsItem, len(operators))
+		for i := range operators {
+			items[i] = sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, operators[i], f.currency)
+		}
+
+		f.mustProcess(holder.Builder().AuthorizeGlobalOperators(holder.Address, items...))
+	}
+
+	isGlobalOperator := func(f *fixture, operator base.Address) bool {
+		ok, err := stostate.IsTokenHolderGlobalOperator(f.contract, f.stoID, f.holder.Address, operator, f.GetStateFunc)
+		if err != nil {
+			f.t.Fatalf("failed to check global operator: %+v", err)
+		}
+
+		return ok
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "revoke",
+			prepare: func(f *fixture) {
+				authorizeGlobal(f, f.holder, f.operator.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				if isGlobalOperator(f, f.operator.Address) {
+					f.t.Error("global operator not revoked")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				authorizeGlobal(f, f.holder, f.operator.Address, f.receiver.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeGlobalOperators(
+					f.holder.Address, item(f, f.operator.Address), item(f, f.receiver.Address))
+			},
+			check: func(f *fixture) {
+				if isGlobalOperator(f, f.operator.Address) || isGlobalOperator(f, f.receiver.Address) {
+					f.t.Error("global operators not revoked")
+				}
+			},
+		},
+		{
+			name: "not authorized",
+			prepare: func(f *fixture) {
+				authorizeGlobal(f, f.holder, f.receiver.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "operator not in tokenholder global operators",
+		},
+		{
+			name: "empty operators",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeGlobalOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "empty tokenholder global operators",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.poor.Address, f.amount(15))
+				authorizeGlobal(f, f.poor, f.operator.Address)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().RevokeGlobalOperators(f.poor.Address, item(f, f.operator.Address))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
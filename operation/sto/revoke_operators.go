@@ -2,6 +2,7 @@ package sto
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +14,7 @@ var (
 	RevokeOperatorsHint     = hint.MustNewHint("mitum-sto-revoke-operator-operation-v0.0.1")
 )
 
-var MaxRevokeOperatorsItems uint = 10
+var MaxRevokeOperatorsItems = uint(networktypes.MaxItemsLimit)
 
 type RevokeOperatorsFact struct {
 	base.BaseFact
@@ -4,11 +4,11 @@ import (
 	"context"
 	"sync"
 
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -34,11 +34,11 @@ func (RevokeOperators) Process(
 }
 
 type RevokeOperatorsItemProcessor struct {
-	h            util.Hash
-	sender       base.Address
-	item         RevokeOperatorsItem
-	operators    *[]base.Address
-	tokenHolders *[]base.Address
+	h         util.Hash
+	sender    base.Address
+	item      RevokeOperatorsItem
+	operators *[]base.Address
+	relations *operatorRelations
 }
 
 func (ipp *RevokeOperatorsItemProcessor) PreProcess(
@@ -50,7 +50,7 @@ func (ipp *RevokeOperatorsItemProcessor) PreProcess(
 		return err
 	}
 
-	if err := currencystate.CheckExistsState(stostate.StateKeyDesign(it.Contract(), it.STO()), getStateFunc); err != nil {
+	if _, err := stostate.ExistsDesign(it.Contract(), it.STO(), getStateFunc); err != nil {
 		return err
 	}
 
@@ -72,20 +72,6 @@ func (ipp *RevokeOperatorsItemProcessor) PreProcess(
 		}
 	}
 
-	if len(*ipp.tokenHolders) == 0 {
-		return errors.Errorf("empty operator tokenholders, %s-%s-%s-%s", it.Contract(), it.STO(), it.Partition(), it.Operator())
-	}
-
-	for i, ad := range *ipp.tokenHolders {
-		if ad.Equal(ipp.sender) {
-			break
-		}
-
-		if i == len(*ipp.tokenHolders)-1 {
-			return errors.Errorf("sender not in operator tokenholders, %s-%s-%s-%s, %q", it.Contract(), it.STO(), it.Partition(), it.Operator(), ipp.sender)
-		}
-	}
-
 	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
 		return err
 	}
@@ -96,53 +82,19 @@ func (ipp *RevokeOperatorsItemProcessor) PreProcess(
 func (ipp *RevokeOperatorsItemProcessor) Process(
 	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
 ) ([]base.StateMergeValue, error) {
-	sts := make([]base.StateMergeValue, 1)
-
 	it := ipp.item
 
-	if len(*ipp.operators) == 0 {
-		return nil, errors.Errorf("empty tokenholder operators, %s-%s-%s-%s", it.Contract(), it.STO(), it.Partition(), ipp.sender)
+	if err := removeAddress(ipp.operators, it.Operator()); err != nil {
+		return nil, errors.Errorf("operator not in tokenholder operators, %s-%s-%s-%s, %q", it.Contract(), it.STO(), it.Partition(), ipp.sender, it.Operator())
 	}
 
-	for i, ad := range *ipp.operators {
-		if ad.Equal(it.Operator()) {
-			if i < len(*ipp.operators)-1 {
-				copy((*ipp.operators)[i:], (*ipp.operators)[i+1:])
-			}
-			*ipp.operators = (*ipp.operators)[:len(*ipp.operators)-1]
-			break
-		}
-
-		if i == len(*ipp.operators)-1 {
-			return nil, errors.Errorf("operator not in tokenholder operators, %s-%s-%s-%s, %q", it.Contract(), it.STO(), it.Partition(), ipp.sender, it.Operator())
-		}
-	}
-
-	holders := *ipp.tokenHolders
-	if len(holders) == 0 {
-		return nil, errors.Errorf("empty operator tokenholders, %s-%s-%s-%s", it.Contract(), it.STO(), it.Partition(), it.Operator())
-	}
-
-	for i, ad := range holders {
-		if ad.Equal(ipp.sender) {
-			if i < len(holders)-1 {
-				copy((holders)[i:], (holders)[i+1:])
-			}
-			holders = (holders)[:len(holders)-1]
-			break
-		}
-
-		if i == len(holders)-1 {
-			return nil, errors.Errorf("sender not in operator tokenholders, %s-%s-%s-%s, %q", it.Contract(), it.STO(), it.Partition(), it.Operator(), ipp.sender)
-		}
+	if err := ipp.relations.release(
+		newOperatorPair(it.Contract(), it.STO(), it.Operator(), it.Partition(), ipp.sender), getStateFunc,
+	); err != nil {
+		return nil, err
 	}
 
-	sts[0] = currencystate.NewStateMergeValue(
-		stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition()),
-		stostate.NewOperatorTokenHoldersStateValue(holders),
-	)
-
-	return sts, nil
+	return nil, nil
 }
 
 func (ipp *RevokeOperatorsItemProcessor) Close() error {
@@ -150,7 +102,7 @@ func (ipp *RevokeOperatorsItemProcessor) Close() error {
 	ipp.sender = nil
 	ipp.item = RevokeOperatorsItem{}
 	ipp.operators = nil
-	ipp.tokenHolders = nil
+	ipp.relations = nil
 
 	revokeOperatorsItemProcessorPool.Put(ipp)
 
@@ -202,6 +154,15 @@ func (opp *RevokeOperatorsProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -215,10 +176,10 @@ func (opp *RevokeOperatorsProcessor) PreProcess(
 	}
 
 	operators := map[string]*[]base.Address{}
-	holders := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
 
 	for _, it := range fact.Items() {
-		var ops, hds []base.Address
+		var ops []base.Address
 
 		k := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())
 		if _, found := operators[k]; !found {
@@ -236,21 +197,6 @@ func (opp *RevokeOperatorsProcessor) PreProcess(
 			operators[k] = &ops
 		}
 
-		k = stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())
-		if _, found := holders[k]; !found {
-			switch st, found, err := getStateFunc(k); {
-			case err != nil:
-				return nil, base.NewBaseOperationProcessReasonError("failed to find operator tokenholders, %s: %w", k, err), nil
-			case found:
-				hds, err = stostate.StateOperatorTokenHoldersValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to get operator tokenholders, %s: %w", k, err), nil
-				}
-			default:
-				return nil, base.NewBaseOperationProcessReasonError("operator tokenholders not in state, %q", k), nil
-			}
-			holders[k] = &hds
-		}
 	}
 
 	for _, it := range fact.Items() {
@@ -264,7 +210,7 @@ func (opp *RevokeOperatorsProcessor) PreProcess(
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.operators = operators[stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())]
-		ipc.tokenHolders = holders[stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())]
+		ipc.relations = relations
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess RevokeOperatorsItem: %w", err), nil
@@ -290,10 +236,10 @@ func (opp *RevokeOperatorsProcessor) Process( // nolint:dupl
 	var sts []base.StateMergeValue // nolint:prealloc
 
 	operators := map[string]*[]base.Address{}
-	holders := map[string]*[]base.Address{}
+	relations := newOperatorRelations()
 
 	for _, it := range fact.Items() {
-		var ops, hds []base.Address
+		var ops []base.Address
 
 		k := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())
 		if _, found := operators[k]; !found {
@@ -311,22 +257,6 @@ func (opp *RevokeOperatorsProcessor) Process( // nolint:dupl
 			operators[k] = &ops
 		}
 
-		k = stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())
-		if _, found := holders[k]; !found {
-			switch st, found, err := getStateFunc(k); {
-			case err != nil:
-				return nil, base.NewBaseOperationProcessReasonError("failed to find operator tokenholders, %s: %w", k, err), nil
-			case found:
-				hds, err = stostate.StateOperatorTokenHoldersValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to get operator tokenholders, %s: %w", k, err), nil
-				}
-			default:
-				return nil, base.NewBaseOperationProcessReasonError("operator tokenholders not in state, %q", k), nil
-			}
-
-			holders[k] = &hds
-		}
 	}
 
 	ipcs := make([]*RevokeOperatorsItemProcessor, len(fact.items))
@@ -341,7 +271,7 @@ func (opp *RevokeOperatorsProcessor) Process( // nolint:dupl
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.operators = operators[stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), fact.sender, it.Partition())]
-		ipc.tokenHolders = holders[stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), it.Operator(), it.Partition())]
+		ipc.relations = relations
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -359,35 +289,23 @@ func (opp *RevokeOperatorsProcessor) Process( // nolint:dupl
 		))
 	}
 
+	sts = append(sts, relations.stateMergeValues()...)
+
 	for _, ipc := range ipcs {
 		ipc.Close()
 	}
 
-	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
-	}
-
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
-	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *RevokeOperatorsProcessor) Close() error {
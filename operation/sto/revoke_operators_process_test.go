@@ -0,0 +1,188 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestRevokeOperatorsProcess(t *testing.T) {
+	item := func(f *fixture, operator base.Address) sto.RevokeOperatorsItem {
+		return sto.NewRevokeOperatorsItem(f.contract, f.stoID, operator, f.partition, f.currency)
+	}
+
+	isOperator := func(f *fixture, operator base.Address) bool {
+		ok, err := stostate.IsTokenHolderOperator(f.contract, f.stoID, f.holder.Address, f.partition, operator, f.GetStateFunc)
+		if err != nil {
+			f.t.Fatalf("failed to check operator: %+v", err)
+		}
+
+		return ok
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "revoke",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				if isOperator(f, f.operator.Address) {
+					f.t.Error("operator not revoked")
+				}
+
+				f.checkBalance(f.holder.Address, balance.Sub(fee.MulInt64(2)))
+			},
+		},
+		{
+			name: "allowance reset",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(f.holder.Builder().AuthorizeOperators(
+					f.holder.Address,
+					sto.NewAuthorizeOperatorsItem(
+						f.contract, f.stoID, f.operator.Address, f.partition, common.NewBig(50), 0, f.currency,
+					),
+				))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				st, found := f.State(stostate.StateKeyOperatorAllowance(
+					f.contract, f.stoID, f.holder.Address, f.partition, f.operator.Address))
+				if !found {
+					f.t.Fatal("operator allowance not found")
+				}
+
+				allowance, err := stostate.StateOperatorAllowanceValue(st)
+				if err != nil {
+					f.t.Fatalf("invalid operator allowance: %+v", err)
+				}
+
+				if !allowance.IsLimited() || !allowance.Amount.IsZero() {
+					f.t.Errorf("expected zero allowance, but %s", allowance.Amount)
+				}
+			},
+		},
+		{
+			name: "legacy operator tokenholders",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+
+				// NOTE operator authorized before the pair states is only in the
+				// operator tokenholders list
+				f.Set(
+					stostate.StateKeyTokenHolderPartitionOperators(f.contract, f.stoID, f.holder.Address, f.partition),
+					stostate.NewTokenHolderPartitionOperatorsStateValue([]base.Address{f.operator.Address}),
+				)
+				f.Set(
+					stostate.StateKeyOperatorTokenHolders(f.contract, f.stoID, f.operator.Address, f.partition),
+					stostate.NewOperatorTokenHoldersStateValue([]base.Address{f.receiver.Address, f.holder.Address}),
+				)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			check: func(f *fixture) {
+				if isOperator(f, f.operator.Address) {
+					f.t.Error("operator not revoked")
+				}
+
+				st, found := f.State(stostate.StateKeyOperatorTokenHolder(
+					f.contract, f.stoID, f.operator.Address, f.partition, f.holder.Address))
+				if !found {
+					f.t.Fatal("operator tokenholder not found")
+				}
+
+				if authorized, err := stostate.StateOperatorTokenHolderValue(st); err != nil || authorized {
+					f.t.Errorf("expected released pair, but %v, %v", authorized, err)
+				}
+
+				st, found = f.State(stostate.StateKeyOperatorTokenHoldersCount(
+					f.contract, f.stoID, f.operator.Address, f.partition))
+				if !found {
+					f.t.Fatal("operator tokenholders count not found")
+				}
+
+				if count, err := stostate.StateOperatorTokenHoldersCountValue(st); err != nil || count != 1 {
+					f.t.Errorf("expected 1 operator tokenholder, but %d, %v", count, err)
+				}
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+				f.authorize(f.holder, f.receiver)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(
+					f.holder.Address, item(f, f.operator.Address), item(f, f.receiver.Address))
+			},
+			check: func(f *fixture) {
+				if isOperator(f, f.operator.Address) || isOperator(f, f.receiver.Address) {
+					f.t.Error("operators not revoked")
+				}
+			},
+		},
+		{
+			name: "revoked operator can not transfer",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+				f.mustProcess(f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address)))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						f.contract, f.stoID, f.holder.Address, f.receiver.Address, f.partition, common.NewBig(30), f.currency,
+					),
+				)
+			},
+			reason: "sender is neither controller nor operator",
+		},
+		{
+			name: "not authorized",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.receiver)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "operator not in tokenholder operators",
+		},
+		{
+			name: "empty operators",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().RevokeOperators(f.holder.Address, item(f, f.operator.Address))
+			},
+			reason: "empty tokenholder operators",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.issue(f.poor.Address, 100)
+				f.SetBalance(f.poor.Address, f.amount(15))
+				f.authorize(f.poor, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().RevokeOperators(f.poor.Address, item(f, f.operator.Address))
+			},
+			reason: "insufficient balance",
+		},
+	})
+}
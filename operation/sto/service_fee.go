@@ -0,0 +1,67 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/fee"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// serviceFeeItem is the item of sto charged by the fee schedule of its design.
+type serviceFeeItem interface {
+	Contract() base.Address
+	STO() currencytypes.ContractID
+}
+
+// amountItem is the item which carries the amount of tokens.
+type amountItem interface {
+	Amount() common.Big
+}
+
+// addServiceFees adds the service fees of operation in the fee schedules of
+// items to fees; they are paid to the owner of contract account.
+func addServiceFees(
+	fees *fee.Fees, operation stotypes.FeeOperation, items []STOItem, getStateFunc base.GetStateFunc,
+) error {
+	for i := range items {
+		it, ok := items[i].(serviceFeeItem)
+		if !ok {
+			return errors.Errorf("expected sto item with contract, not %T", items[i])
+		}
+
+		design, err := stostate.ExistsDesign(it.Contract(), it.STO(), getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		sf, found := design.Fees().Fee(operation)
+		if !found {
+			continue
+		}
+
+		st, err := currencystate.ExistsState(
+			extensioncurrency.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		ca, err := extensioncurrency.StateContractAccountValue(st)
+		if err != nil {
+			return err
+		}
+
+		amount := common.ZeroBig
+		if ai, ok := items[i].(amountItem); ok {
+			amount = ai.Amount()
+		}
+
+		fees.Add(sf.Currency(), ca.Owner(), sf.Fee(amount))
+	}
+
+	return nil
+}
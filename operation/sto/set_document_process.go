@@ -4,7 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
@@ -135,56 +134,34 @@ func (opp *SetDocumentProcessor) Process(
 	}
 	Policy := design.Policy()
 
-	Policy = stotypes.NewPolicy(Policy.Partitions(), Policy.Aggregate(), Policy.Controllers(), append(Policy.Documents(), doc))
+	Policy = stotypes.NewPolicy(Policy.Partitions(), Policy.Aggregate(), Policy.Controllers(), append(Policy.Documents(), doc), Policy.KYC())
 	if err := Policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid sto policy, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
 	}
 
-	design = stotypes.NewDesign(design.STO(), design.Granularity(), Policy)
+	design = design.SetPolicy(Policy)
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid sto design, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
 	}
 
-	sts := make([]base.StateMergeValue, 2)
+	sts := make([]base.StateMergeValue, 1)
 
 	sts[0] = currencystate.NewStateMergeValue(
 		stostate.StateKeyDesign(fact.Contract(), fact.STO()),
 		stostate.NewDesignStateValue(design),
 	)
 
-	currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q: %w", fact.Sender(), err), nil
-	}
-	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
-
-	switch b, err := currency.StateBalanceValue(st); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q: %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
-	case b.Big().Compare(fee) < 0:
-		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
-	}
-
-	v, ok := sb.Value().(currency.BalanceStateValue)
-	if !ok {
-		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
-	}
-	sts[1] = currencystate.NewStateMergeValue(
-		sb.Key(),
-		currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))),
-	)
-
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *SetDocumentProcessor) Close() error {
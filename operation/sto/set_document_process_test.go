@@ -0,0 +1,89 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestSetDocumentProcess(t *testing.T) {
+	runProcessCases(t, []processCase{
+		{
+			name: "set",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().SetDocument(
+					f.controller.Address, f.contract, f.stoID, "prospectus", "https://example.com/prospectus", "DOCHASH", f.currency)
+			},
+			check: func(f *fixture) {
+				docs := f.STO(f.contract, f.stoID).Policy().Documents()
+				if len(docs) != 1 {
+					f.t.Fatalf("expected 1 document, but %d", len(docs))
+				}
+
+				if docs[0].STO() != f.stoID {
+					f.t.Errorf("expected document of %q, but %q", f.stoID, docs[0].STO())
+				}
+
+				f.checkBalance(f.controller.Address, balance.Sub(fee))
+				f.checkBalance(f.feeer.Address, fee)
+			},
+		},
+		{
+			name: "append",
+			prepare: func(f *fixture) {
+				f.mustProcess(f.controller.Builder().SetDocument(
+					f.controller.Address, f.contract, f.stoID, "prospectus", "https://example.com/prospectus", "DOCHASH", f.currency))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().SetDocument(
+					f.controller.Address, f.contract, f.stoID, "report", "https://example.com/report", "REPORTHASH", f.currency)
+			},
+			check: func(f *fixture) {
+				if n := len(f.STO(f.contract, f.stoID).Policy().Documents()); n != 2 {
+					f.t.Errorf("expected 2 documents, but %d", n)
+				}
+			},
+		},
+		{
+			name: "not controller",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().SetDocument(
+					f.holder.Address, f.contract, f.stoID, "prospectus", "https://example.com/prospectus", "DOCHASH", f.currency)
+			},
+			reason: "sender is not controller of sto",
+		},
+		{
+			name: "unknown sto",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().SetDocument(
+					f.controller.Address, f.contract, "STB", "prospectus", "https://example.com/prospectus", "DOCHASH", f.currency)
+			},
+			reason: "sto policy not found",
+		},
+		{
+			name: "contract account sender",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().SetDocument(
+					f.NewContractAccount(f.owner.Address), f.contract, f.stoID,
+					"prospectus", "https://example.com/prospectus", "DOCHASH", f.currency)
+			},
+			reason: "contract account cannot update sto documents",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.SetBalance(f.controller.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().SetDocument(
+					f.controller.Address, f.contract, f.stoID, "prospectus", "https://example.com/prospectus", "DOCHASH", f.currency)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if n := len(f.STO(f.contract, f.stoID).Policy().Documents()); n != 0 {
+					f.t.Errorf("expected no document, but %d", n)
+				}
+			},
+		},
+	})
+}
@@ -2,6 +2,9 @@ package sto
 
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -13,7 +16,17 @@ var (
 	TransferSecurityTokensPartitionHint     = hint.MustNewHint("mitum-sto-transfer-security-tokens-partition-operation-v0.0.1")
 )
 
-var MaxTransferSecurityTokensPartitionItems uint = 10
+var MaxTransferSecurityTokensPartitionItems = uint(networktypes.MaxItemsLimit)
+
+// OperatorSpendItem is an item which moves tokenholder partition balance,
+// possibly by an operator on behalf of the tokenholder.
+type OperatorSpendItem interface {
+	Contract() base.Address
+	STO() currencytypes.ContractID
+	TokenHolder() base.Address
+	Partition() stotypes.Partition
+	Amount() common.Big
+}
 
 type TransferSecurityTokensPartitionFact struct {
 	base.BaseFact
@@ -6,11 +6,11 @@ import (
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
-	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
 	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
 	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
 	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
@@ -38,10 +38,13 @@ func (TransferSecurityTokensPartition) Process(
 
 type TransferSecurityTokensPartitionItemProcessor struct {
 	h          util.Hash
+	height     base.Height
 	sender     base.Address
 	item       TransferSecurityTokensPartitionItem
 	partitions map[string][]stotypes.Partition
 	balances   map[string]common.Big
+	relations  *operatorRelations
+	holders    *jurisdictionHolders
 }
 
 func (ipp *TransferSecurityTokensPartitionItemProcessor) PreProcess(
@@ -108,22 +111,11 @@ func (ipp *TransferSecurityTokensPartitionItemProcessor) PreProcess(
 		}
 
 		if !isController {
-			st, err := currencystate.ExistsState(stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), it.TokenHolder(), it.Partition()), "key of tokenholder partition operators", getStateFunc)
+			ok, err := stostate.IsTokenHolderOperator(it.Contract(), it.STO(), it.TokenHolder(), it.Partition(), ipp.sender, getStateFunc)
 			if err != nil {
 				return err
 			}
-
-			operators, err := stostate.StateTokenHolderPartitionOperatorsValue(st)
-			if err != nil {
-				return err
-			}
-
-			for _, op := range operators {
-				if op.Equal(ipp.sender) {
-					isOperator = true
-					break
-				}
-			}
+			isOperator = ok
 		}
 
 		if !(isController || isOperator) {
@@ -131,6 +123,14 @@ func (ipp *TransferSecurityTokensPartitionItemProcessor) PreProcess(
 		}
 	}
 
+	if err := stostate.CheckTokenHolderKYC(policy.KYC(), it.Receiver(), ipp.height, getStateFunc); err != nil {
+		return err
+	}
+
+	if err := checkTokenHolderJurisdiction(it.Contract(), design, it.Receiver(), ipp.height, getStateFunc); err != nil {
+		return err
+	}
+
 	gn := new(big.Int)
 	gn.SetUint64(design.Granularity())
 
@@ -177,6 +177,12 @@ func (ipp *TransferSecurityTokensPartitionItemProcessor) Process(
 			}
 		}
 
+		if len(partitions) == 0 {
+			if err := ipp.holders.leave(it.Contract(), it.STO(), it.TokenHolder(), getStateFunc); err != nil {
+				return nil, err
+			}
+		}
+
 		opk := stostate.StateKeyTokenHolderPartitionOperators(it.Contract(), it.STO(), it.TokenHolder(), it.Partition())
 
 		var operators []base.Address
@@ -197,31 +203,37 @@ func (ipp *TransferSecurityTokensPartitionItemProcessor) Process(
 		))
 
 		for _, op := range operators {
-			thk := stostate.StateKeyOperatorTokenHolders(it.Contract(), it.STO(), op, it.Partition())
-
-			st, err := currencystate.ExistsState(thk, "key of operator tokenholders", getStateFunc)
-			if err != nil {
+			if err := ipp.relations.release(
+				newOperatorPair(it.Contract(), it.STO(), op, it.Partition(), it.TokenHolder()), getStateFunc,
+			); err != nil {
 				return nil, err
 			}
+		}
+	}
 
-			holders, err := stostate.StateOperatorTokenHoldersValue(st)
-			if err != nil {
-				return nil, err
-			}
+	st, err := currencystate.ExistsState(stostate.StateKeyDesign(it.Contract(), it.STO()), "key of sto design", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
 
-			for i, th := range holders {
-				if th.Equal(it.TokenHolder()) {
-					if i < len(holders)-1 {
-						copy(holders[i:], holders[i+1:])
-					}
-					holders = holders[:len(holders)-1]
-				}
-			}
+	design, err := stostate.StateDesignValue(st)
+	if err != nil {
+		return nil, err
+	}
 
-			sts = append(sts, currencystate.NewStateMergeValue(
-				thk, stostate.NewOperatorTokenHoldersStateValue(holders),
-			))
-		}
+	j, err := stostate.TokenHolderJurisdiction(design.Policy().KYC(), it.Receiver(), ipp.height, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receiverPartitions) == 0 {
+		err = ipp.holders.join(it.Contract(), it.STO(), it.Receiver(), j, design.Jurisdictions(), getStateFunc)
+	} else {
+		err = ipp.holders.recount(it.Contract(), it.STO(), it.Receiver(), j, design.Jurisdictions(), getStateFunc)
+	}
+
+	if err != nil {
+		return nil, err
 	}
 
 	if len(receiverPartitions) == 0 {
@@ -248,10 +260,13 @@ func (ipp *TransferSecurityTokensPartitionItemProcessor) Process(
 
 func (ipp *TransferSecurityTokensPartitionItemProcessor) Close() error {
 	ipp.h = nil
+	ipp.height = 0
 	ipp.sender = nil
 	ipp.item = TransferSecurityTokensPartitionItem{}
 	ipp.balances = nil
 	ipp.partitions = nil
+	ipp.relations = nil
+	ipp.holders = nil
 
 	transferSecurityTokensPartitionItemProcessorPool.Put(ipp)
 
@@ -303,6 +318,15 @@ func (opp *TransferSecurityTokensPartitionProcessor) PreProcess(
 		return ctx, nil, e.Wrap(err)
 	}
 
+	networkPolicy, err := networkstate.LoadNetworkPolicy(getStateFunc)
+	if err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("failed to load sto network policy: %w", err), nil
+	}
+
+	if err := networkPolicy.CheckItems(len(fact.Items())); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
 	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
 	}
@@ -338,10 +362,13 @@ func (opp *TransferSecurityTokensPartitionProcessor) PreProcess(
 		}
 
 		ipc.h = op.Hash()
+		ipc.height = opp.Height()
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.partitions = partitions
 		ipc.balances = nil
+		ipc.relations = nil
+		ipc.holders = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("fail to preprocess TransferSecurityTokensPartitionItem: %w", err), nil
@@ -354,6 +381,16 @@ func (opp *TransferSecurityTokensPartitionProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("not enough tokenholder partition balance: %w", err), nil
 	}
 
+	fitems := fact.Items()
+	sitems := make([]OperatorSpendItem, len(fitems))
+	for i := range fitems {
+		sitems[i] = fitems[i]
+	}
+
+	if _, err := spendOperatorAllowances(opp.Height(), fact.Sender(), sitems, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("not enough operator allowance: %w", err), nil
+	}
+
 	return ctx, nil, nil
 }
 
@@ -437,6 +474,9 @@ func (opp *TransferSecurityTokensPartitionProcessor) Process( // nolint:dupl
 
 	var sts []base.StateMergeValue // nolint:prealloc
 
+	relations := newOperatorRelations()
+	holders := newJurisdictionHolders()
+
 	ipcs := make([]*TransferSecurityTokensPartitionItemProcessor, len(fact.Items()))
 	for i, it := range fact.Items() {
 		ip := transferSecurityTokensPartitionItemProcessorPool.Get()
@@ -446,10 +486,13 @@ func (opp *TransferSecurityTokensPartitionProcessor) Process( // nolint:dupl
 		}
 
 		ipc.h = op.Hash()
+		ipc.height = opp.Height()
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.partitions = partitions
 		ipc.balances = balances
+		ipc.relations = relations
+		ipc.holders = holders
 
 		s, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -464,6 +507,9 @@ func (opp *TransferSecurityTokensPartitionProcessor) Process( // nolint:dupl
 		sts = append(sts, currencystate.NewStateMergeValue(k, stostate.NewTokenHolderPartitionsStateValue(v)))
 	}
 
+	sts = append(sts, relations.stateMergeValues()...)
+	sts = append(sts, holders.stateMergeValues()...)
+
 	for _, it := range fact.Items() {
 		k := stostate.StateKeyTokenHolderPartitionBalance(it.Contract(), it.STO(), it.TokenHolder(), it.Partition())
 		sts = append(sts, currencystate.NewStateMergeValue(k, stostate.NewTokenHolderPartitionBalanceStateValue(balances[k], it.Partition())))
@@ -477,30 +523,33 @@ func (opp *TransferSecurityTokensPartitionProcessor) Process( // nolint:dupl
 	}
 
 	fitems := fact.Items()
-	items := make([]STOItem, len(fitems))
-	for i := range fact.Items() {
-		items[i] = fitems[i]
+	sitems := make([]OperatorSpendItem, len(fitems))
+	for i := range fitems {
+		sitems[i] = fitems[i]
 	}
 
-	required, err := calculateSTOItemsFee(getStateFunc, items)
+	allowances, err := spendOperatorAllowances(opp.Height(), fact.Sender(), sitems, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+		return nil, base.NewBaseOperationProcessReasonError("not enough operator allowance: %w", err), nil
 	}
-	sb, err := currencyoperation.CheckEnoughBalance(fact.sender, required, getStateFunc)
+
+	for k, v := range allowances {
+		if v.IsLimited() && !relations.isAllowanceReset(k) {
+			sts = append(sts, currencystate.NewStateMergeValue(k, v))
+		}
+	}
+
+	fees, err := OperationFees(fact, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance: %w", err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
 	}
 
-	for i := range sb {
-		v, ok := sb[i].Value().(currency.BalanceStateValue)
-		if !ok {
-			return nil, nil, e.Wrap(errors.Errorf("expected BalanceStateValue, not %T", sb[i].Value()))
-		}
-		stv := currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
-		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	fsts, err := fees.Pay(fact.sender, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
 	}
 
-	return sts, nil, nil
+	return append(sts, fsts...), nil, nil
 }
 
 func (opp *TransferSecurityTokensPartitionProcessor) Close() error {
@@ -538,3 +587,74 @@ func checkEnoughTokenHolderBalance(getStateFunc base.GetStateFunc, items []Trans
 
 	return nil
 }
+
+// spendOperatorAllowances returns the operator allowances left after sender
+// spends the amounts of items on behalf of tokenholders.
+func spendOperatorAllowances(
+	height base.Height, sender base.Address, items []OperatorSpendItem, getStateFunc base.GetStateFunc,
+) (map[string]stostate.OperatorAllowanceStateValue, error) {
+	allowances := map[string]stostate.OperatorAllowanceStateValue{}
+
+	for _, it := range items {
+		if it.TokenHolder().Equal(sender) {
+			continue
+		}
+
+		policy, err := stostate.ExistsPolicy(it.Contract(), it.STO(), getStateFunc)
+		if err != nil {
+			return nil, err
+		}
+
+		isController := false
+		for _, con := range policy.Controllers() {
+			if con.Equal(sender) {
+				isController = true
+				break
+			}
+		}
+
+		if isController {
+			continue
+		}
+
+		switch ok, err := stostate.IsTokenHolderGlobalOperator(it.Contract(), it.STO(), it.TokenHolder(), sender, getStateFunc); {
+		case err != nil:
+			return nil, err
+		case ok:
+			continue
+		}
+
+		k := stostate.StateKeyOperatorAllowance(it.Contract(), it.STO(), it.TokenHolder(), it.Partition(), sender)
+
+		allowance, found := allowances[k]
+		if !found {
+			switch st, found, err := getStateFunc(k); {
+			case err != nil:
+				return nil, err
+			case !found:
+				continue
+			default:
+				allowance, err = stostate.StateOperatorAllowanceValue(st)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if allowance.IsExpired(height) {
+			return nil, errors.Errorf("operator allowance expired, %q, %d", k, allowance.Expiry)
+		}
+
+		if allowance.IsLimited() {
+			if allowance.Amount.Compare(it.Amount()) < 0 {
+				return nil, errors.Errorf("operator allowance exhausted, %q, %q < %q", k, allowance.Amount, it.Amount())
+			}
+
+			allowance = stostate.NewOperatorAllowanceStateValue(allowance.Amount.Sub(it.Amount()), allowance.Expiry)
+		}
+
+		allowances[k] = allowance
+	}
+
+	return allowances, nil
+}
@@ -0,0 +1,335 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestTransferSecurityTokensPartitionProcess(t *testing.T) {
+	item := func(f *fixture, holder, receiver base.Address, amount int64) sto.TransferSecurityTokensPartitionItem {
+		return sto.NewTransferSecurityTokensPartitionItem(
+			f.contract, f.stoID, holder, receiver, f.partition, common.NewBig(amount), f.currency,
+		)
+	}
+
+	// authorizeAllowance authorizes operator for the partition of holder up to
+	// allowance until expiry.
+	authorizeAllowance := func(f *fixture, allowance int64, expiry base.Height) {
+		f.mustProcess(f.holder.Builder().AuthorizeOperators(
+			f.holder.Address,
+			sto.NewAuthorizeOperatorsItem(
+				f.contract, f.stoID, f.operator.Address, f.partition, common.NewBig(allowance), expiry, f.currency,
+			),
+		))
+	}
+
+	jurisdictionCount := func(f *fixture, j kyctypes.Jurisdiction) uint64 {
+		st, found := f.State(stostate.StateKeyJurisdictionHoldersCount(f.contract, f.stoID, j))
+		if !found {
+			f.t.Fatalf("tokenholders count of jurisdiction, %q not found", j)
+		}
+
+		count, err := stostate.StateJurisdictionHoldersCountValue(st)
+		if err != nil {
+			f.t.Fatalf("invalid tokenholders count: %+v", err)
+		}
+
+		return count
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "by tokenholder",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkTokenHolderBalance(f.receiver.Address, 30)
+				f.checkPartitionBalance(100)
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+
+				ps, err := stostate.ExistsTokenHolderPartitions(f.contract, f.stoID, f.receiver.Address, f.GetStateFunc)
+				if err != nil {
+					f.t.Fatalf("tokenholder partitions not found: %+v", err)
+				}
+
+				if len(ps) != 1 || ps[0] != f.partition {
+					f.t.Errorf("expected partitions, [%s], but %v", f.partition, ps)
+				}
+			},
+		},
+		{
+			name: "whole balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 100))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 0)
+				f.checkTokenHolderBalance(f.receiver.Address, 100)
+			},
+		},
+		{
+			name: "multiple items",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.issue(f.operator.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().TransferSecurityTokensPartition(
+					f.controller.Address,
+					item(f, f.holder.Address, f.receiver.Address, 30),
+					item(f, f.operator.Address, f.receiver.Address, 20),
+				)
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkTokenHolderBalance(f.operator.Address, 80)
+				f.checkTokenHolderBalance(f.receiver.Address, 50)
+				f.checkBalance(f.controller.Address, balance.Sub(fee.MulInt64(2)))
+				f.checkBalance(f.feeer.Address, fee.MulInt64(2))
+			},
+		},
+		{
+			name: "by controller",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().TransferSecurityTokensPartition(
+					f.controller.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkTokenHolderBalance(f.receiver.Address, 30)
+			},
+		},
+		{
+			name: "by operator",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.authorize(f.holder, f.operator)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 70)
+				f.checkTokenHolderBalance(f.receiver.Address, 30)
+				f.checkBalance(f.operator.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "within operator allowance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				authorizeAllowance(f, 50, 0)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			check: func(f *fixture) {
+				st, found := f.State(stostate.StateKeyOperatorAllowance(
+					f.contract, f.stoID, f.holder.Address, f.partition, f.operator.Address))
+				if !found {
+					f.t.Fatal("operator allowance not found")
+				}
+
+				allowance, err := stostate.StateOperatorAllowanceValue(st)
+				if err != nil {
+					f.t.Fatalf("invalid operator allowance: %+v", err)
+				}
+
+				if !allowance.Amount.Equal(common.NewBig(20)) {
+					f.t.Errorf("expected allowance 20, but %s", allowance.Amount)
+				}
+			},
+		},
+		{
+			name: "whole balance by operator resets allowance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				authorizeAllowance(f, 150, 0)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 100))
+			},
+			check: func(f *fixture) {
+				st, found := f.State(stostate.StateKeyOperatorAllowance(
+					f.contract, f.stoID, f.holder.Address, f.partition, f.operator.Address))
+				if !found {
+					f.t.Fatal("operator allowance not found")
+				}
+
+				allowance, err := stostate.StateOperatorAllowanceValue(st)
+				if err != nil {
+					f.t.Fatalf("invalid operator allowance: %+v", err)
+				}
+
+				if !allowance.Amount.IsZero() {
+					f.t.Errorf("expected allowance reset to zero, but %s", allowance.Amount)
+				}
+			},
+		},
+		{
+			name: "operator allowance exhausted",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				authorizeAllowance(f, 50, 0)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 60))
+			},
+			reason: "operator allowance exhausted",
+		},
+		{
+			name: "operator allowance expired",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				authorizeAllowance(f, 50, f.Height()+1)
+				f.SetHeight(f.Height() + 10)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			reason: "operator allowance expired",
+		},
+		{
+			name: "neither controller nor operator",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.operator.Builder().TransferSecurityTokensPartition(
+					f.operator.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			reason: "sender is neither controller nor operator",
+		},
+		{
+			name: "insufficient tokenholder balance",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 101))
+			},
+			reason: "not enough tokenholder partition balance",
+		},
+		{
+			name: "not tokenholder",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			reason: "failed to get tokenholder partitions value",
+		},
+		{
+			name: "partition not held",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				it := sto.NewTransferSecurityTokensPartitionItem(
+					f.contract, f.stoID, f.holder.Address, f.receiver.Address, "PTB", common.NewBig(30), f.currency,
+				)
+
+				return f.holder.Builder().TransferSecurityTokensPartition(f.holder.Address, it)
+			},
+			reason: "partition not in tokenholder partitions",
+		},
+		{
+			name: "contract account receiver",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.NewContractAccount(f.owner.Address), 30))
+			},
+			reason: "already exists",
+		},
+		{
+			name: "receiver not verified",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "KR"})
+				f.issue(f.holder.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 30))
+			},
+			reason: "not verified",
+		},
+		{
+			name: "receivers in the same block",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{
+					f.holder.Address.String():   "KR",
+					f.receiver.Address.String(): "KR",
+					f.operator.Address.String(): "KR",
+					f.owner.Address.String():    "KR",
+				})
+				f.issue(f.holder.Address, 100)
+				f.issue(f.operator.Address, 100)
+				f.mustProcessBlock(
+					func() (base.Operation, error) {
+						return f.holder.Builder().TransferSecurityTokensPartition(
+							f.holder.Address, item(f, f.holder.Address, f.receiver.Address, 50))
+					},
+					func() (base.Operation, error) {
+						return f.operator.Builder().TransferSecurityTokensPartition(
+							f.operator.Address, item(f, f.operator.Address, f.owner.Address, 50))
+					},
+				)
+
+				if count := jurisdictionCount(f, "KR"); count != 4 {
+					f.t.Errorf("expected 4 tokenholders of KR, but %d", count)
+				}
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().TransferSecurityTokensPartition(
+					f.owner.Address, item(f, f.owner.Address, f.holder.Address, 50))
+			},
+			check: func(f *fixture) {
+				if count := jurisdictionCount(f, "KR"); count != 3 {
+					f.t.Errorf("expected 3 tokenholders of KR, but %d", count)
+				}
+			},
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.issue(f.poor.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.poor.Builder().TransferSecurityTokensPartition(
+					f.poor.Address, item(f, f.poor.Address, f.receiver.Address, 30))
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.poor.Address, 100)
+				f.checkTokenHolderBalance(f.receiver.Address, 0)
+			},
+		},
+	})
+}
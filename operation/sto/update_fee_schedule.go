@@ -0,0 +1,135 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateFeeScheduleFactHint = hint.MustNewHint("mitum-sto-update-fee-schedule-operation-fact-v0.0.1")
+	UpdateFeeScheduleHint     = hint.MustNewHint("mitum-sto-update-fee-schedule-operation-v0.0.1")
+)
+
+type UpdateFeeScheduleFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address             // contract account
+	stoID    currencytypes.ContractID // token id
+	schedule stotypes.FeeSchedule     // new fee schedule
+	currency currencytypes.CurrencyID // fee
+}
+
+func NewUpdateFeeScheduleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	schedule stotypes.FeeSchedule,
+	currency currencytypes.CurrencyID,
+) UpdateFeeScheduleFact {
+	bf := base.NewBaseFact(UpdateFeeScheduleFactHint, token)
+	fact := UpdateFeeScheduleFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		stoID:    stoID,
+		schedule: schedule,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateFeeScheduleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateFeeScheduleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateFeeScheduleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.stoID.Bytes(),
+		fact.schedule.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateFeeScheduleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.stoID, fact.schedule, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact UpdateFeeScheduleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateFeeScheduleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateFeeScheduleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateFeeScheduleFact) STO() currencytypes.ContractID {
+	return fact.stoID
+}
+
+func (fact UpdateFeeScheduleFact) Schedule() stotypes.FeeSchedule {
+	return fact.schedule
+}
+
+func (fact UpdateFeeScheduleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateFeeScheduleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type UpdateFeeSchedule struct {
+	common.BaseOperation
+}
+
+func NewUpdateFeeSchedule(fact UpdateFeeScheduleFact) (UpdateFeeSchedule, error) {
+	return UpdateFeeSchedule{BaseOperation: common.NewBaseOperation(UpdateFeeScheduleHint, fact)}, nil
+}
+
+func (op *UpdateFeeSchedule) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
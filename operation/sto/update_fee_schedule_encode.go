@@ -0,0 +1,41 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *UpdateFeeScheduleFact) unpack(enc encoder.Encoder, sa, ca, stoid string, bs []byte, cid string) error {
+	e := util.StringError("failed to unmarshal UpdateFeeScheduleFact")
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	if hinter, err := enc.Decode(bs); err != nil {
+		return e.Wrap(err)
+	} else if schedule, ok := hinter.(stotypes.FeeSchedule); !ok {
+		return e.Wrap(errors.Errorf("expected FeeSchedule, not %T", hinter))
+	} else {
+		fact.schedule = schedule
+	}
+
+	fact.stoID = currencytypes.ContractID(stoid)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
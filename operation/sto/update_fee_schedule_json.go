@@ -0,0 +1,77 @@
+package sto
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateFeeScheduleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	STOID    currencytypes.ContractID `json:"stoid"`
+	Schedule stotypes.FeeSchedule     `json:"schedule"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateFeeScheduleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateFeeScheduleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		STOID:                 fact.stoID,
+		Schedule:              fact.schedule,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateFeeScheduleFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string          `json:"sender"`
+	Contract string          `json:"contract"`
+	STOID    string          `json:"stoid"`
+	Schedule json.RawMessage `json:"schedule"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *UpdateFeeScheduleFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateFeeScheduleFact")
+
+	var uf UpdateFeeScheduleFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.STOID, uf.Schedule, uf.Currency)
+}
+
+type UpdateFeeScheduleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateFeeSchedule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateFeeScheduleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateFeeSchedule) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateFeeSchedule")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
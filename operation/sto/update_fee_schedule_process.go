@@ -0,0 +1,173 @@
+package sto
+
+import (
+	"context"
+	"sync"
+
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateFeeScheduleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateFeeScheduleProcessor)
+	},
+}
+
+func (UpdateFeeSchedule) Process(
+	ctx context.Context, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateFeeScheduleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateFeeScheduleProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateFeeScheduleProcessor")
+
+		nopp := updateFeeScheduleProcessorPool.Get()
+		opp, ok := nopp.(*UpdateFeeScheduleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateFeeScheduleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateFeeScheduleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateFeeSchedule")
+
+	fact, ok := op.Fact().(UpdateFeeScheduleFact)
+	if !ok {
+		return ctx, nil, e.Wrap(errors.Errorf("not UpdateFeeScheduleFact, %T", op.Fact()))
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot update fee schedule, %q: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account not found, %q: %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found, %q: %w", fact.Contract(), err), nil
+	}
+
+	if !ca.Owner().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("not contract account owner, %q", fact.Sender()), nil
+	}
+
+	st, err = currencystate.ExistsState(stostate.StateKeyDesign(fact.Contract(), fact.STO()), "key of sto design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	design, err := stostate.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design value not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	if err := design.SetFees(fact.Schedule()).IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid sto design, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	for _, sf := range fact.Schedule().Fees() {
+		if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(sf.Currency()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("service fee currency doesn't exist, %q: %w", sf.Currency(), err), nil
+		}
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateFeeScheduleProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process UpdateFeeSchedule")
+
+	fact, ok := op.Fact().(UpdateFeeScheduleFact)
+	if !ok {
+		return nil, nil, e.Wrap(errors.Errorf("expected UpdateFeeScheduleFact, not %T", op.Fact()))
+	}
+
+	st, err := currencystate.ExistsState(stostate.StateKeyDesign(fact.Contract(), fact.STO()), "key of sto design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	design, err := stostate.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sto design value not found, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	design = design.SetFees(fact.Schedule())
+	if err := design.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid sto design, %s-%s: %w", fact.Contract(), fact.STO(), err), nil
+	}
+
+	sts := make([]base.StateMergeValue, 1)
+
+	sts[0] = currencystate.NewStateMergeValue(
+		stostate.StateKeyDesign(fact.Contract(), fact.STO()),
+		stostate.NewDesignStateValue(design),
+	)
+
+	fees, err := OperationFees(fact, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee: %w", err), nil
+	}
+
+	fsts, err := fees.Pay(fact.Sender(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to pay fee: %w", err), nil
+	}
+
+	return append(sts, fsts...), nil, nil
+}
+
+func (opp *UpdateFeeScheduleProcessor) Close() error {
+	updateFeeScheduleProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestUpdateFeeScheduleProcess(t *testing.T) {
+	schedule := func(f *fixture) stotypes.FeeSchedule {
+		return stotypes.NewFeeSchedule([]stotypes.ServiceFee{
+			stotypes.NewServiceFee(stotypes.FeeOperationIssue, f.currency, common.NewBig(5), 0),
+			stotypes.NewServiceFee(stotypes.FeeOperationTransfer, f.currency, common.NewBig(1), 250),
+		})
+	}
+
+	setSchedule := func(f *fixture) {
+		f.SetSTO(f.contract, f.design(stotypes.EmptyKYCRequirement(), stotypes.EmptyJurisdictionRule()).SetFees(schedule(f)))
+	}
+
+	issueOp := func(f *fixture) (base.Operation, error) {
+		return f.controller.Builder().IssueSecurityTokens(
+			f.controller.Address,
+			sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.holder.Address, common.NewBig(100), f.partition, f.currency),
+		)
+	}
+
+	runProcessCases(t, []processCase{
+		{
+			name: "update",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateFeeSchedule(f.owner.Address, f.contract, f.stoID, schedule(f), f.currency)
+			},
+			check: func(f *fixture) {
+				if n := len(f.STO(f.contract, f.stoID).Fees().Fees()); n != 2 {
+					f.t.Errorf("expected 2 service fees, but %d", n)
+				}
+
+				f.checkBalance(f.owner.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "flat fee of issue",
+			prepare: func(f *fixture) {
+				f.mustProcess(f.owner.Builder().UpdateFeeSchedule(f.owner.Address, f.contract, f.stoID, schedule(f), f.currency))
+			},
+			op: issueOp,
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 100)
+				f.checkBalance(f.controller.Address, balance.Sub(fee).Sub(common.NewBig(5)))
+				f.checkBalance(f.owner.Address, balance.Sub(fee).Add(common.NewBig(5)))
+				f.checkBalance(f.feeer.Address, fee.MulInt64(2))
+			},
+		},
+		{
+			name: "rate fee of transfer",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 1000)
+				setSchedule(f)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().TransferSecurityTokensPartition(
+					f.holder.Address,
+					sto.NewTransferSecurityTokensPartitionItem(
+						f.contract, f.stoID, f.holder.Address, f.receiver.Address, f.partition, common.NewBig(400), f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				// NOTE 1 + 400 * 250 / 10000
+				f.checkBalance(f.holder.Address, balance.Sub(fee).Sub(common.NewBig(11)))
+				f.checkBalance(f.owner.Address, balance.Add(common.NewBig(11)))
+			},
+		},
+		{
+			name: "not charged operation",
+			prepare: func(f *fixture) {
+				setSchedule(f)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.holder.Builder().AuthorizeGlobalOperators(
+					f.holder.Address,
+					sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, f.operator.Address, f.currency),
+				)
+			},
+			check: func(f *fixture) {
+				f.checkBalance(f.holder.Address, balance.Sub(fee))
+				f.checkBalance(f.owner.Address, balance)
+			},
+		},
+		{
+			name: "insufficient balance for service fee",
+			prepare: func(f *fixture) {
+				setSchedule(f)
+				f.SetBalance(f.controller.Address, f.amount(12))
+			},
+			op:     issueOp,
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				f.checkTokenHolderBalance(f.holder.Address, 0)
+				f.checkBalance(f.owner.Address, balance)
+			},
+		},
+		{
+			name: "unknown service fee currency",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateFeeSchedule(f.owner.Address, f.contract, f.stoID, stotypes.NewFeeSchedule(
+					[]stotypes.ServiceFee{stotypes.NewServiceFee(stotypes.FeeOperationIssue, "MCD", common.NewBig(5), 0)},
+				), f.currency)
+			},
+			reason: "service fee currency doesn't exist",
+		},
+		{
+			name: "owner without balance of service fee currency",
+			prepare: func(f *fixture) {
+				f.SetCurrency(currencytypes.CurrencyID("MCD"), f.feeer.Address, common.ZeroBig)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateFeeSchedule(f.owner.Address, f.contract, f.stoID, stotypes.NewFeeSchedule(
+					[]stotypes.ServiceFee{stotypes.NewServiceFee(stotypes.FeeOperationIssue, "MCD", common.NewBig(5), 0)},
+				), f.currency)
+			},
+			check: func(f *fixture) {
+				if n := len(f.STO(f.contract, f.stoID).Fees().Fees()); n != 1 {
+					f.t.Errorf("expected 1 service fee, but %d", n)
+				}
+			},
+		},
+		{
+			name: "not contract account owner",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().UpdateFeeSchedule(f.controller.Address, f.contract, f.stoID, schedule(f), f.currency)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name: "unknown sto",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateFeeSchedule(f.owner.Address, f.contract, "STB", schedule(f), f.currency)
+			},
+			reason: "sto design not found",
+		},
+	})
+}
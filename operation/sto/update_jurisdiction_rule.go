@@ -0,0 +1,135 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateJurisdictionRuleFactHint = hint.MustNewHint("mitum-sto-update-jurisdiction-rule-operation-fact-v0.0.1")
+	UpdateJurisdictionRuleHint     = hint.MustNewHint("mitum-sto-update-jurisdiction-rule-operation-v0.0.1")
+)
+
+type UpdateJurisdictionRuleFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address              // contract account
+	stoID    currencytypes.ContractID  // token id
+	rule     stotypes.JurisdictionRule // new jurisdiction rule
+	currency currencytypes.CurrencyID  // fee
+}
+
+func NewUpdateJurisdictionRuleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	rule stotypes.JurisdictionRule,
+	currency currencytypes.CurrencyID,
+) UpdateJurisdictionRuleFact {
+	bf := base.NewBaseFact(UpdateJurisdictionRuleFactHint, token)
+	fact := UpdateJurisdictionRuleFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		stoID:    stoID,
+		rule:     rule,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateJurisdictionRuleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateJurisdictionRuleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateJurisdictionRuleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.stoID.Bytes(),
+		fact.rule.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateJurisdictionRuleFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false, fact.sender, fact.contract, fact.stoID, fact.rule, fact.currency); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	return nil
+}
+
+func (fact UpdateJurisdictionRuleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateJurisdictionRuleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateJurisdictionRuleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateJurisdictionRuleFact) STO() currencytypes.ContractID {
+	return fact.stoID
+}
+
+func (fact UpdateJurisdictionRuleFact) Rule() stotypes.JurisdictionRule {
+	return fact.rule
+}
+
+func (fact UpdateJurisdictionRuleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateJurisdictionRuleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type UpdateJurisdictionRule struct {
+	common.BaseOperation
+}
+
+func NewUpdateJurisdictionRule(fact UpdateJurisdictionRuleFact) (UpdateJurisdictionRule, error) {
+	return UpdateJurisdictionRule{BaseOperation: common.NewBaseOperation(UpdateJurisdictionRuleHint, fact)}, nil
+}
+
+func (op *UpdateJurisdictionRule) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *UpdateJurisdictionRuleFact) unpack(enc encoder.Encoder, sa, ca, stoid string, br []byte, cid string) error {
+	e := util.StringError("failed to unmarshal UpdateJurisdictionRuleFact")
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	if hinter, err := enc.Decode(br); err != nil {
+		return e.Wrap(err)
+	} else if rule, ok := hinter.(stotypes.JurisdictionRule); !ok {
+		return e.Wrap(errors.Errorf("expected JurisdictionRule, not %T", hinter))
+	} else {
+		fact.rule = rule
+	}
+
+	fact.stoID = currencytypes.ContractID(stoid)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	return nil
+}
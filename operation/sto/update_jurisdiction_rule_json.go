@@ -0,0 +1,77 @@
+package sto
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateJurisdictionRuleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address              `json:"sender"`
+	Contract base.Address              `json:"contract"`
+	STOID    currencytypes.ContractID  `json:"stoid"`
+	Rule     stotypes.JurisdictionRule `json:"rule"`
+	Currency currencytypes.CurrencyID  `json:"currency"`
+}
+
+func (fact UpdateJurisdictionRuleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateJurisdictionRuleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		STOID:                 fact.stoID,
+		Rule:                  fact.rule,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateJurisdictionRuleFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string          `json:"sender"`
+	Contract string          `json:"contract"`
+	STOID    string          `json:"stoid"`
+	Rule     json.RawMessage `json:"rule"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *UpdateJurisdictionRuleFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateJurisdictionRuleFact")
+
+	var uf UpdateJurisdictionRuleFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.STOID, uf.Rule, uf.Currency)
+}
+
+type UpdateJurisdictionRuleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateJurisdictionRule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateJurisdictionRuleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateJurisdictionRule) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateJurisdictionRule")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package sto_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestUpdateJurisdictionRuleProcess(t *testing.T) {
+	denyUS := stotypes.NewJurisdictionRule(nil, []kyctypes.Jurisdiction{"US"}, nil)
+	capKR := stotypes.NewJurisdictionRule(nil, nil, []stotypes.JurisdictionCap{stotypes.NewJurisdictionCap("KR", 1)})
+
+	runProcessCases(t, []processCase{
+		{
+			name: "update",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), nil)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, denyUS, f.currency)
+			},
+			check: func(f *fixture) {
+				denied := f.STO(f.contract, f.stoID).Jurisdictions().Denied()
+				if len(denied) != 1 || denied[0] != "US" {
+					f.t.Errorf("expected denied, [US], but %v", denied)
+				}
+
+				f.checkBalance(f.owner.Address, balance.Sub(fee))
+			},
+		},
+		{
+			name: "denied after update",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "US"})
+				f.mustProcess(f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, denyUS, f.currency))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.holder.Address, common.NewBig(100), f.partition, f.currency),
+				)
+			},
+			reason: "jurisdiction denied",
+		},
+		{
+			name: "cap after holders",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{
+					f.holder.Address.String():   "KR",
+					f.receiver.Address.String(): "KR",
+				})
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, capKR, f.currency))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.receiver.Address, common.NewBig(100), f.partition, f.currency),
+				)
+			},
+			reason: "over 1",
+		},
+		{
+			name: "cap under holders",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{
+					f.holder.Address.String():   "KR",
+					f.receiver.Address.String(): "KR",
+				})
+				f.issue(f.holder.Address, 100)
+				f.issue(f.receiver.Address, 100)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, capKR, f.currency)
+			},
+			reason: "tokenholders of jurisdiction, \"KR\", 2 over 1",
+		},
+		{
+			name: "cap with holders without jurisdiction",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{f.holder.Address.String(): "KR"})
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, capKR, f.currency)
+			},
+			reason: "1 tokenholders without jurisdiction",
+		},
+		{
+			name: "cap after recount",
+			prepare: func(f *fixture) {
+				f.issue(f.holder.Address, 100)
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), map[string]kyctypes.Jurisdiction{
+					f.holder.Address.String():   "KR",
+					f.receiver.Address.String(): "KR",
+				})
+				f.issue(f.holder.Address, 100)
+				f.mustProcess(f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, capKR, f.currency))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().IssueSecurityTokens(
+					f.controller.Address,
+					sto.NewIssueSecurityTokensItem(f.contract, f.stoID, f.receiver.Address, common.NewBig(100), f.partition, f.currency),
+				)
+			},
+			reason: "over 1",
+		},
+		{
+			name: "clear",
+			prepare: func(f *fixture) {
+				f.requireKYC(denyUS, nil)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(
+					f.owner.Address, f.contract, f.stoID, stotypes.EmptyJurisdictionRule(), f.currency)
+			},
+			check: func(f *fixture) {
+				if !f.STO(f.contract, f.stoID).Jurisdictions().IsEmpty() {
+					f.t.Error("expected empty jurisdiction rule")
+				}
+			},
+		},
+		{
+			name: "without kyc requirement",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, denyUS, f.currency)
+			},
+			reason: "jurisdiction rule needs kyc requirement",
+		},
+		{
+			name: "not contract account owner",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), nil)
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.controller.Builder().UpdateJurisdictionRule(f.controller.Address, f.contract, f.stoID, denyUS, f.currency)
+			},
+			reason: "not contract account owner",
+		},
+		{
+			name: "unknown sto",
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, "STB", denyUS, f.currency)
+			},
+			reason: "sto design not found",
+		},
+		{
+			name: "insufficient balance",
+			prepare: func(f *fixture) {
+				f.requireKYC(stotypes.EmptyJurisdictionRule(), nil)
+				f.SetBalance(f.owner.Address, f.amount(5))
+			},
+			op: func(f *fixture) (base.Operation, error) {
+				return f.owner.Builder().UpdateJurisdictionRule(f.owner.Address, f.contract, f.stoID, denyUS, f.currency)
+			},
+			reason: "insufficient balance",
+			check: func(f *fixture) {
+				if !f.STO(f.contract, f.stoID).Jurisdictions().IsEmpty() {
+					f.t.Error("jurisdiction rule should not be updated")
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,5 @@
+/*
+Package test provides the in-memory states and the helpers to run the
+processors of sto and kyc operations in tests, without node or database.
+*/
+package test
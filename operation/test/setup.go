@@ -0,0 +1,240 @@
+package test
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/pkg/builder"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// Account is the account with single key, which can sign its operations.
+type Account struct {
+	Address base.Address
+	Priv    base.Privatekey
+}
+
+// Builder returns the operation builder signed by account.
+func (a Account) Builder() builder.Builder {
+	b, err := builder.New(a.Priv, NetworkID)
+	if err != nil {
+		panic(err)
+	}
+
+	return b
+}
+
+// NewAccount creates account with the balances of amounts.
+func (s *States) NewAccount(amounts ...currencytypes.Amount) Account {
+	s.t.Helper()
+
+	priv := base.NewMPrivatekey()
+
+	ac := s.newAccount(priv)
+
+	s.Set(currency.StateKeyAccount(ac.Address()), currency.NewAccountStateValue(ac))
+
+	for i := range amounts {
+		s.SetBalance(ac.Address(), amounts[i])
+	}
+
+	return Account{Address: ac.Address(), Priv: priv}
+}
+
+// NewContractAccount creates contract account of owner; contract account has
+// no keys and can not sign.
+func (s *States) NewContractAccount(owner base.Address) base.Address {
+	s.t.Helper()
+
+	addr := s.NewAddress()
+
+	keys, err := currencytypes.NewContractAccountKeys()
+	if err != nil {
+		s.t.Fatalf("failed to create contract account keys: %+v", err)
+	}
+
+	ac, err := currencytypes.NewAccount(addr, keys)
+	if err != nil {
+		s.t.Fatalf("failed to create contract account: %+v", err)
+	}
+
+	s.Set(currency.StateKeyAccount(addr), currency.NewAccountStateValue(ac))
+	s.Set(
+		extensioncurrency.StateKeyContractAccount(addr),
+		extensioncurrency.NewContractAccountStateValue(currencytypes.NewContractAccountStatus(owner)),
+	)
+
+	return addr
+}
+
+// NewAddress returns the address of new key, which is not stored as
+// account.
+func (s *States) NewAddress() base.Address {
+	s.t.Helper()
+
+	return s.newAccount(base.NewMPrivatekey()).Address()
+}
+
+func (s *States) newAccount(priv base.Privatekey) currencytypes.Account {
+	s.t.Helper()
+
+	key, err := currencytypes.NewBaseAccountKey(priv.Publickey(), 100)
+	if err != nil {
+		s.t.Fatalf("failed to create account key: %+v", err)
+	}
+
+	keys, err := currencytypes.NewBaseAccountKeys([]currencytypes.AccountKey{key}, 100)
+	if err != nil {
+		s.t.Fatalf("failed to create account keys: %+v", err)
+	}
+
+	ac, err := currencytypes.NewAccountFromKeys(keys)
+	if err != nil {
+		s.t.Fatalf("failed to create account: %+v", err)
+	}
+
+	return ac
+}
+
+func (s *States) SetBalance(addr base.Address, amount currencytypes.Amount) {
+	s.Set(currency.StateKeyBalance(addr, amount.Currency()), currency.NewBalanceStateValue(amount))
+}
+
+// Balance returns the balance of addr; zero when the balance does not exist.
+func (s *States) Balance(addr base.Address, cid currencytypes.CurrencyID) common.Big {
+	s.t.Helper()
+
+	st, found := s.State(currency.StateKeyBalance(addr, cid))
+	if !found {
+		return common.ZeroBig
+	}
+
+	am, err := currency.StateBalanceValue(st)
+	if err != nil {
+		s.t.Fatalf("invalid balance state, %q: %+v", st.Key(), err)
+	}
+
+	return am.Big()
+}
+
+// SetCurrency creates currency, cid with fixed fee to receiver; zero fee
+// means no fee.
+func (s *States) SetCurrency(cid currencytypes.CurrencyID, receiver base.Address, fee common.Big) {
+	var feeer currencytypes.Feeer = currencytypes.NewNilFeeer()
+	if fee.OverZero() {
+		feeer = currencytypes.NewFixedFeeer(receiver, fee)
+	}
+
+	design := currencytypes.NewCurrencyDesign(
+		currencytypes.NewAmount(common.NewBig(0), cid),
+		receiver,
+		currencytypes.NewCurrencyPolicy(common.ZeroBig, feeer),
+	)
+
+	s.Set(currency.StateKeyCurrencyDesign(cid), currency.NewCurrencyDesignStateValue(design))
+}
+
+// SetSTO stores the design of sto in contract with the zero balances of its
+// partitions.
+func (s *States) SetSTO(contract base.Address, design stotypes.Design) {
+	s.Set(stostate.StateKeyDesign(contract, design.STO()), stostate.NewDesignStateValue(design))
+
+	for _, p := range design.Policy().Partitions() {
+		s.Set(stostate.StateKeyPartitionBalance(contract, design.STO(), p), stostate.NewPartitionBalanceStateValue(common.ZeroBig))
+	}
+}
+
+// STO returns the design of sto.
+func (s *States) STO(contract base.Address, stoID currencytypes.ContractID) stotypes.Design {
+	s.t.Helper()
+
+	design, err := stostate.ExistsDesign(contract, stoID, s.GetStateFunc)
+	if err != nil {
+		s.t.Fatalf("sto design not found: %+v", err)
+	}
+
+	return design
+}
+
+// TokenHolderBalance returns the balance of holder in partition; zero when the
+// balance does not exist.
+func (s *States) TokenHolderBalance(
+	contract base.Address, stoID currencytypes.ContractID, holder base.Address, partition stotypes.Partition,
+) common.Big {
+	s.t.Helper()
+
+	st, found := s.State(stostate.StateKeyTokenHolderPartitionBalance(contract, stoID, holder, partition))
+	if !found {
+		return common.ZeroBig
+	}
+
+	b, err := stostate.StateTokenHolderPartitionBalanceValue(st)
+	if err != nil {
+		s.t.Fatalf("invalid tokenholder partition balance state, %q: %+v", st.Key(), err)
+	}
+
+	return b
+}
+
+// PartitionBalance returns the total balance of partition; zero when the
+// balance does not exist.
+func (s *States) PartitionBalance(
+	contract base.Address, stoID currencytypes.ContractID, partition stotypes.Partition,
+) common.Big {
+	s.t.Helper()
+
+	st, found := s.State(stostate.StateKeyPartitionBalance(contract, stoID, partition))
+	if !found {
+		return common.ZeroBig
+	}
+
+	b, err := stostate.StatePartitionBalanceValue(st)
+	if err != nil {
+		s.t.Fatalf("invalid partition balance state, %q: %+v", st.Key(), err)
+	}
+
+	return b
+}
+
+// SetKYC stores the design of kyc service in contract.
+func (s *States) SetKYC(contract base.Address, design kyctypes.Design) {
+	s.Set(kycstate.StateKeyDesign(contract, design.KYC()), kycstate.NewDesignStateValue(design))
+}
+
+// KYC returns the policy of kyc service.
+func (s *States) KYC(contract base.Address, kycID currencytypes.ContractID) kyctypes.Policy {
+	s.t.Helper()
+
+	policy, err := kycstate.ExistsPolicy(contract, kycID, s.GetStateFunc)
+	if err != nil {
+		s.t.Fatalf("kyc policy not found: %+v", err)
+	}
+
+	return policy
+}
+
+func (s *States) SetCustomer(
+	contract base.Address, kycID currencytypes.ContractID, customer base.Address, info kyctypes.CustomerInfo,
+) {
+	s.Set(kycstate.StateKeyCustomer(contract, kycID, customer), kycstate.NewCustomerStateValue(info))
+}
+
+// Customer returns the record of customer; false when it does not exist or
+// is removed.
+func (s *States) Customer(
+	contract base.Address, kycID currencytypes.ContractID, customer base.Address,
+) (kyctypes.CustomerInfo, bool) {
+	s.t.Helper()
+
+	info, found, err := kycstate.LoadCustomer(contract, kycID, customer, s.GetStateFunc)
+	if err != nil {
+		s.t.Fatalf("failed to load customer: %+v", err)
+	}
+
+	return info, found
+}
@@ -0,0 +1,231 @@
+package test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/processor"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+// NetworkID is the network id of the operations in tests.
+var NetworkID = base.NetworkID("mitum-sto-test")
+
+// States keeps the states in memory like the states of blocks. Operations are
+// processed one by one; every processed operation makes a new block, so the
+// height increases by one.
+type States struct {
+	t          testing.TB
+	m          map[string]base.State
+	processors map[hint.Type]currencytypes.GetNewProcessor
+	height     base.Height
+}
+
+func NewStates(t testing.TB) *States {
+	ps := processor.STOProcessors()
+
+	processors := make(map[hint.Type]currencytypes.GetNewProcessor, len(ps))
+	for i := range ps {
+		processors[ps[i].Hint.Type()] = ps[i].Processor
+	}
+
+	return &States{
+		t:          t,
+		m:          map[string]base.State{},
+		processors: processors,
+		height:     base.GenesisHeight + 1,
+	}
+}
+
+// Height is the height of the block which the next operation is processed in.
+func (s *States) Height() base.Height {
+	return s.height
+}
+
+func (s *States) SetHeight(height base.Height) {
+	s.height = height
+}
+
+// GetStateFunc is base.GetStateFunc of states.
+func (s *States) GetStateFunc(key string) (base.State, bool, error) {
+	st, found := s.m[key]
+
+	return st, found, nil
+}
+
+func (s *States) State(key string) (base.State, bool) {
+	st, found := s.m[key]
+
+	return st, found
+}
+
+// Set stores value at key, as it is stored in the previous block.
+func (s *States) Set(key string, value base.StateValue) {
+	var previous util.Hash
+	if st, found := s.m[key]; found {
+		previous = st.Hash()
+	}
+
+	s.m[key] = common.NewBaseState(s.height-1, key, value, previous, nil)
+}
+
+// Keys returns the sorted keys of states.
+func (s *States) Keys() []string {
+	keys := make([]string, 0, len(s.m))
+	for k := range s.m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// All returns the states sorted by key.
+func (s *States) All() []base.State {
+	keys := s.Keys()
+
+	sts := make([]base.State, len(keys))
+	for i := range keys {
+		sts[i] = s.m[keys[i]]
+	}
+
+	return sts
+}
+
+// Process runs PreProcess and Process of op and merges the states. The reason
+// of failure is returned; with reason, states are not changed. The error of
+// processor fails the test.
+func (s *States) Process(op base.Operation) base.OperationProcessReasonError {
+	s.t.Helper()
+
+	mvs, reason := s.process(op)
+	if reason != nil {
+		return reason
+	}
+
+	s.merge([]util.Hash{op.Hash()}, [][]base.StateMergeValue{mvs})
+	s.height++
+
+	return nil
+}
+
+// ProcessBlock processes ops in one block; every operation reads the states
+// of the former block and the states of the processed operations are merged
+// together in the order of ops. The reasons of failures are returned in the
+// order of ops.
+func (s *States) ProcessBlock(ops ...base.Operation) []base.OperationProcessReasonError {
+	s.t.Helper()
+
+	reasons := make([]base.OperationProcessReasonError, len(ops))
+
+	var hs []util.Hash
+	var mvs [][]base.StateMergeValue
+
+	for i := range ops {
+		opmvs, reason := s.process(ops[i])
+		if reason != nil {
+			reasons[i] = reason
+
+			continue
+		}
+
+		hs = append(hs, ops[i].Hash())
+		mvs = append(mvs, opmvs)
+	}
+
+	s.merge(hs, mvs)
+	s.height++
+
+	return reasons
+}
+
+func (s *States) process(op base.Operation) ([]base.StateMergeValue, base.OperationProcessReasonError) {
+	s.t.Helper()
+
+	f, found := s.processors[op.Hint().Type()]
+	if !found {
+		s.t.Fatalf("processor not found, %q", op.Hint())
+	}
+
+	opp, err := f(s.height, s.GetStateFunc, nil, nil)
+	if err != nil {
+		s.t.Fatalf("failed to create processor, %q: %+v", op.Hint(), err)
+	}
+
+	defer func() {
+		_ = opp.Close()
+	}()
+
+	ctx, reason, err := opp.PreProcess(context.Background(), op, s.GetStateFunc)
+
+	switch {
+	case err != nil:
+		s.t.Fatalf("failed to preprocess, %q: %+v", op.Hint(), err)
+	case reason != nil:
+		return nil, reason
+	}
+
+	mvs, reason, err := opp.Process(ctx, op, s.GetStateFunc)
+
+	switch {
+	case err != nil:
+		s.t.Fatalf("failed to process, %q: %+v", op.Hint(), err)
+	case reason != nil:
+		return nil, reason
+	}
+
+	return mvs, nil
+}
+
+// merge merges the states, mvs of operations, ops into states by their
+// mergers, in the order of ops and mvs.
+func (s *States) merge(ops []util.Hash, mvs [][]base.StateMergeValue) {
+	s.t.Helper()
+
+	var keys []string
+
+	mergers := map[string]base.StateValueMerger{}
+	merged := map[string][]util.Hash{}
+
+	for i := range mvs {
+		for j := range mvs[i] {
+			mv := mvs[i][j]
+
+			m, found := mergers[mv.Key()]
+			if !found {
+				m = mv.Merger(s.height, s.m[mv.Key()])
+				mergers[mv.Key()] = m
+				keys = append(keys, mv.Key())
+			}
+
+			if err := m.Merge(mv.Value(), []util.Hash{ops[i]}); err != nil {
+				s.t.Fatalf("failed to merge state, %q: %+v", mv.Key(), err)
+			}
+
+			if hs := merged[mv.Key()]; len(hs) < 1 || !hs[len(hs)-1].Equal(ops[i]) {
+				merged[mv.Key()] = append(hs, ops[i])
+			}
+		}
+	}
+
+	for _, k := range keys {
+		m := mergers[k]
+
+		if err := m.Close(); err != nil {
+			s.t.Fatalf("failed to close state merger, %q: %+v", k, err)
+		}
+
+		var previous util.Hash
+		if st, found := s.m[k]; found {
+			previous = st.Hash()
+		}
+
+		s.m[k] = common.NewBaseState(s.height, k, m.Value(), previous, merged[k])
+	}
+}
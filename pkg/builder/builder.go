@@ -0,0 +1,85 @@
+package builder
+
+import (
+	"reflect"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/pkg/errors"
+)
+
+// Builder creates the operations signed by priv for the network of networkID.
+type Builder struct {
+	priv      base.Privatekey
+	networkID base.NetworkID
+	token     []byte
+}
+
+func New(priv base.Privatekey, networkID base.NetworkID) (Builder, error) {
+	if priv == nil {
+		return Builder{}, errors.Errorf("empty privatekey")
+	}
+
+	if err := networkID.IsValid(nil); err != nil {
+		return Builder{}, errors.Wrap(err, "invalid network id")
+	}
+
+	return Builder{
+		priv:      priv,
+		networkID: networkID,
+	}, nil
+}
+
+// WithToken returns the builder which uses token for the facts of operations.
+// Without token, the current time is used like the command line tools.
+func (b Builder) WithToken(token []byte) Builder {
+	b.token = token
+
+	return b
+}
+
+func (b Builder) Token() []byte {
+	if len(b.token) > 0 {
+		return b.token
+	}
+
+	return []byte(localtime.Now().UTC().String())
+}
+
+// Sign adds the sign of builder to op, which is signed by the other accounts;
+// eg. the operations of multi-sig accounts or RecoverTokenHolder approved by
+// controller.
+func (b Builder) Sign(op base.Operation) (base.Operation, error) {
+	if op == nil {
+		return nil, errors.Errorf("empty operation")
+	}
+
+	p := reflect.New(reflect.TypeOf(op))
+	p.Elem().Set(reflect.ValueOf(op))
+
+	s, ok := p.Interface().(interface {
+		Sign(base.Privatekey, base.NetworkID) error
+	})
+	if !ok {
+		return nil, errors.Errorf("operation can not be signed, %T", op)
+	}
+
+	if err := s.Sign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrapf(err, "failed to sign operation, %T", op)
+	}
+
+	signed, ok := p.Elem().Interface().(base.Operation)
+	if !ok {
+		return nil, errors.Errorf("expected base.Operation, not %T", p.Elem().Interface())
+	}
+
+	return b.check(signed)
+}
+
+func (b Builder) check(op base.Operation) (base.Operation, error) {
+	if err := op.IsValid(b.networkID); err != nil {
+		return nil, errors.Wrapf(err, "invalid operation, %T", op)
+	}
+
+	return op, nil
+}
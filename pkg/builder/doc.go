@@ -0,0 +1,5 @@
+/*
+Package builder provides the builders of sto and kyc operations, which create
+signed operations without the command line tools.
+*/
+package builder
@@ -0,0 +1,41 @@
+package builder
+
+import (
+	"github.com/ProtoconNet/mitum-sto/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/pkg/errors"
+)
+
+// NewJSONEncoder returns the json encoder which knows the hinters of node, so
+// it can decode every operation of network.
+func NewJSONEncoder() (*jsonenc.Encoder, error) {
+	enc := jsonenc.NewEncoder()
+
+	if err := cmds.LoadHinters(enc); err != nil {
+		return nil, err
+	}
+
+	return enc, nil
+}
+
+// MarshalOperation returns the json of op, as the command line tools print.
+func MarshalOperation(op base.Operation) ([]byte, error) {
+	return util.MarshalJSON(op)
+}
+
+// UnmarshalOperation decodes the json of operation by enc.
+func UnmarshalOperation(enc *jsonenc.Encoder, b []byte) (base.Operation, error) {
+	hinter, err := enc.Decode(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode operation")
+	}
+
+	op, ok := hinter.(base.Operation)
+	if !ok {
+		return nil, errors.Errorf("expected base.Operation, not %T", hinter)
+	}
+
+	return op, nil
+}
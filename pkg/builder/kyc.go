@@ -0,0 +1,149 @@
+package builder
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// CreateKYCService creates kyc service in contract.
+func (b Builder) CreateKYCService(
+	sender base.Address,
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	controllers []kyctypes.Controller,
+	quorum uint64,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := kyc.NewCreateKYCService(kyc.NewCreateKYCServiceFact(b.Token(), sender, contract, kycID, controllers, quorum, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CreateKYCService")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign CreateKYCService")
+	}
+
+	return b.check(op)
+}
+
+// AddControllers adds the controllers of items to kyc services.
+func (b Builder) AddControllers(sender base.Address, items ...kyc.AddControllersItem) (base.Operation, error) {
+	op, err := kyc.NewAddControllers(kyc.NewAddControllersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AddControllers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign AddControllers")
+	}
+
+	return b.check(op)
+}
+
+// RemoveControllers removes the controllers of items from kyc services.
+func (b Builder) RemoveControllers(sender base.Address, items ...kyc.RemoveControllersItem) (base.Operation, error) {
+	op, err := kyc.NewRemoveControllers(kyc.NewRemoveControllersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RemoveControllers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RemoveControllers")
+	}
+
+	return b.check(op)
+}
+
+// AddCustomers registers the customers of items to kyc services.
+func (b Builder) AddCustomers(sender base.Address, items ...kyc.AddCustomersItem) (base.Operation, error) {
+	op, err := kyc.NewAddCustomers(kyc.NewAddCustomersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AddCustomers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign AddCustomers")
+	}
+
+	return b.check(op)
+}
+
+// UpdateCustomers updates the status of registered customers of items.
+func (b Builder) UpdateCustomers(sender base.Address, items ...kyc.UpdateCustomersItem) (base.Operation, error) {
+	op, err := kyc.NewUpdateCustomers(kyc.NewUpdateCustomersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create UpdateCustomers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign UpdateCustomers")
+	}
+
+	return b.check(op)
+}
+
+// RenewCustomers extends the expiry height of registered customers of items.
+func (b Builder) RenewCustomers(sender base.Address, items ...kyc.RenewCustomersItem) (base.Operation, error) {
+	op, err := kyc.NewRenewCustomers(kyc.NewRenewCustomersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RenewCustomers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RenewCustomers")
+	}
+
+	return b.check(op)
+}
+
+// ApproveCustomers approves the pending customers of items.
+func (b Builder) ApproveCustomers(sender base.Address, items ...kyc.ApproveCustomersItem) (base.Operation, error) {
+	op, err := kyc.NewApproveCustomers(kyc.NewApproveCustomersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ApproveCustomers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign ApproveCustomers")
+	}
+
+	return b.check(op)
+}
+
+// RemoveCustomers removes the registered customers of items.
+func (b Builder) RemoveCustomers(sender base.Address, items ...kyc.RemoveCustomersItem) (base.Operation, error) {
+	op, err := kyc.NewRemoveCustomers(kyc.NewRemoveCustomersFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RemoveCustomers")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RemoveCustomers")
+	}
+
+	return b.check(op)
+}
+
+// MigrateKYCService moves kyc service to newContract with customers.
+func (b Builder) MigrateKYCService(
+	sender base.Address,
+	contract base.Address,
+	kycID currencytypes.ContractID,
+	newContract base.Address,
+	customers []base.Address,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := kyc.NewMigrateKYCService(kyc.NewMigrateKYCServiceFact(b.Token(), sender, contract, kycID, newContract, customers, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create MigrateKYCService")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign MigrateKYCService")
+	}
+
+	return b.check(op)
+}
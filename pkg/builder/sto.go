@@ -0,0 +1,230 @@
+package builder
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// CreateSecurityTokens creates security tokens of items.
+func (b Builder) CreateSecurityTokens(sender base.Address, items ...sto.CreateSecurityTokensItem) (base.Operation, error) {
+	op, err := sto.NewCreateSecurityTokens(sto.NewCreateSecurityTokensFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CreateSecurityTokens")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign CreateSecurityTokens")
+	}
+
+	return b.check(op)
+}
+
+// IssueSecurityTokens issues security tokens of items to the receivers.
+func (b Builder) IssueSecurityTokens(sender base.Address, items ...sto.IssueSecurityTokensItem) (base.Operation, error) {
+	op, err := sto.NewIssueSecurityTokens(sto.NewIssueSecurityTokensFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create IssueSecurityTokens")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign IssueSecurityTokens")
+	}
+
+	return b.check(op)
+}
+
+// TransferSecurityTokensPartition transfers security tokens of items by partition.
+func (b Builder) TransferSecurityTokensPartition(sender base.Address, items ...sto.TransferSecurityTokensPartitionItem) (base.Operation, error) {
+	op, err := sto.NewTransferSecurityTokensPartition(sto.NewTransferSecurityTokensPartitionFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create TransferSecurityTokensPartition")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign TransferSecurityTokensPartition")
+	}
+
+	return b.check(op)
+}
+
+// RedeemTokens redeems tokens of items from the tokenholders.
+func (b Builder) RedeemTokens(sender base.Address, items ...sto.RedeemTokensItem) (base.Operation, error) {
+	op, err := sto.NewRedeemTokens(sto.NewRedeemTokensFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RedeemTokens")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RedeemTokens")
+	}
+
+	return b.check(op)
+}
+
+// AuthorizeOperators authorizes the operators of items for the partitions of sender.
+func (b Builder) AuthorizeOperators(sender base.Address, items ...sto.AuthorizeOperatorsItem) (base.Operation, error) {
+	op, err := sto.NewAuthorizeOperators(sto.NewAuthorizeOperatorsFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AuthorizeOperators")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign AuthorizeOperators")
+	}
+
+	return b.check(op)
+}
+
+// RevokeOperators revokes the operators of items from the partitions of sender.
+func (b Builder) RevokeOperators(sender base.Address, items ...sto.RevokeOperatorsItem) (base.Operation, error) {
+	op, err := sto.NewRevokeOperators(sto.NewRevokeOperatorsFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RevokeOperators")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RevokeOperators")
+	}
+
+	return b.check(op)
+}
+
+// AuthorizeGlobalOperators authorizes the operators of items for every partition of sender.
+func (b Builder) AuthorizeGlobalOperators(sender base.Address, items ...sto.AuthorizeGlobalOperatorsItem) (base.Operation, error) {
+	op, err := sto.NewAuthorizeGlobalOperators(sto.NewAuthorizeGlobalOperatorsFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AuthorizeGlobalOperators")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign AuthorizeGlobalOperators")
+	}
+
+	return b.check(op)
+}
+
+// RevokeGlobalOperators revokes the global operators of items of sender.
+func (b Builder) RevokeGlobalOperators(sender base.Address, items ...sto.RevokeGlobalOperatorsItem) (base.Operation, error) {
+	op, err := sto.NewRevokeGlobalOperators(sto.NewRevokeGlobalOperatorsFact(b.Token(), sender, items))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RevokeGlobalOperators")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RevokeGlobalOperators")
+	}
+
+	return b.check(op)
+}
+
+// SetDocument sets the document of sto.
+func (b Builder) SetDocument(
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	title string,
+	uri stotypes.URI,
+	hash string,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := sto.NewSetDocument(sto.NewSetDocumentFact(b.Token(), sender, contract, stoID, title, uri, hash, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create SetDocument")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign SetDocument")
+	}
+
+	return b.check(op)
+}
+
+// UpdateJurisdictionRule replaces the jurisdiction rule of sto.
+func (b Builder) UpdateJurisdictionRule(
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	rule stotypes.JurisdictionRule,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := sto.NewUpdateJurisdictionRule(sto.NewUpdateJurisdictionRuleFact(b.Token(), sender, contract, stoID, rule, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create UpdateJurisdictionRule")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign UpdateJurisdictionRule")
+	}
+
+	return b.check(op)
+}
+
+// UpdateFeeSchedule replaces the service fee schedule of sto.
+func (b Builder) UpdateFeeSchedule(
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	schedule stotypes.FeeSchedule,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := sto.NewUpdateFeeSchedule(sto.NewUpdateFeeScheduleFact(b.Token(), sender, contract, stoID, schedule, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create UpdateFeeSchedule")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign UpdateFeeSchedule")
+	}
+
+	return b.check(op)
+}
+
+// MigrateSecurityTokens migrates tokenHolders of sto to newContract, or
+// finishes or aborts the migration by mode.
+func (b Builder) MigrateSecurityTokens(
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	newContract base.Address,
+	mode sto.MigrationMode,
+	tokenHolders []base.Address,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := sto.NewMigrateSecurityTokens(sto.NewMigrateSecurityTokensFact(
+		b.Token(), sender, contract, stoID, newContract, mode, tokenHolders, currency,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create MigrateSecurityTokens")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign MigrateSecurityTokens")
+	}
+
+	return b.check(op)
+}
+
+// RecoverTokenHolder moves the tokens of lostHolder to sender. The
+// operation should be signed by controller too; see Builder.Sign.
+func (b Builder) RecoverTokenHolder(
+	sender base.Address,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	lostHolder base.Address,
+	controller base.Address,
+	currency currencytypes.CurrencyID,
+) (base.Operation, error) {
+	op, err := sto.NewRecoverTokenHolder(sto.NewRecoverTokenHolderFact(b.Token(), sender, contract, stoID, lostHolder, controller, currency))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create RecoverTokenHolder")
+	}
+
+	if err := op.HashSign(b.priv, b.networkID); err != nil {
+		return nil, errors.Wrap(err, "failed to sign RecoverTokenHolder")
+	}
+
+	return b.check(op)
+}
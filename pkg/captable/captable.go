@@ -0,0 +1,269 @@
+package captable
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+// PercentPrecision is the number of decimal places of percentages.
+var PercentPrecision = 6
+
+var CSVHeader = []string{
+	"holder", "partition", "balance", "percent_of_partition", "percent_of_aggregate",
+	"kyc_contract", "kyc_id", "kyc_status", "jurisdiction", "accreditation", "category", "expiry_height", "document_hash",
+}
+
+type PartitionBalance struct {
+	Partition stotypes.Partition `json:"partition"`
+	Balance   common.Big         `json:"balance"`
+}
+
+// KYC is the customer record of holder in the first kyc service of sto which
+// knows the holder.
+type KYC struct {
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kyc_id"`
+	Customer kyctypes.CustomerInfo    `json:"customer"`
+}
+
+type Row struct {
+	Holder             base.Address       `json:"holder"`
+	Partition          stotypes.Partition `json:"partition"`
+	Balance            common.Big         `json:"balance"`
+	PercentOfPartition string             `json:"percent_of_partition"`
+	PercentOfAggregate string             `json:"percent_of_aggregate"`
+	KYC                *KYC               `json:"kyc,omitempty"`
+}
+
+type CapTable struct {
+	Contract   base.Address             `json:"contract"`
+	STO        currencytypes.ContractID `json:"sto_id"`
+	Height     base.Height              `json:"height"`
+	Aggregate  common.Big               `json:"aggregate"`
+	Partitions []PartitionBalance       `json:"partitions"`
+	Rows       []Row                    `json:"rows"`
+}
+
+// Build makes the cap table of sto from sts, the last sto and kyc states at
+// height. Every holder with non-zero balance has a row per partition, sorted by
+// holder and partition.
+func Build(
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	height base.Height,
+	sts []base.State,
+	enc encoder.Encoder,
+) (CapTable, error) {
+	m := make(map[string]base.State, len(sts))
+	for i := range sts {
+		m[sts[i].Key()] = sts[i]
+	}
+
+	getStateFunc := func(key string) (base.State, bool, error) {
+		st, found := m[key]
+
+		return st, found, nil
+	}
+
+	design, err := stostate.ExistsDesign(contract, stoID, getStateFunc)
+	if err != nil {
+		return CapTable{}, err
+	}
+
+	policy := design.Policy()
+
+	t := CapTable{
+		Contract:   contract,
+		STO:        stoID,
+		Height:     height,
+		Aggregate:  policy.Aggregate(),
+		Partitions: make([]PartitionBalance, len(policy.Partitions())),
+	}
+
+	totals := map[stotypes.Partition]common.Big{}
+
+	for i, p := range policy.Partitions() {
+		total := common.ZeroBig
+
+		if st, found, _ := getStateFunc(stostate.StateKeyPartitionBalance(contract, stoID, p)); found {
+			b, err := stostate.StatePartitionBalanceValue(st)
+			if err != nil {
+				return CapTable{}, err
+			}
+
+			total = b
+		}
+
+		totals[p] = total
+		t.Partitions[i] = PartitionBalance{Partition: p, Balance: total}
+	}
+
+	holders, err := loadHolders(contract, stoID, sts, enc)
+	if err != nil {
+		return CapTable{}, err
+	}
+
+	for i := range holders {
+		holder := holders[i]
+
+		partitions, err := stostate.ExistsTokenHolderPartitions(contract, stoID, holder, getStateFunc)
+		if err != nil {
+			return CapTable{}, err
+		}
+
+		kyc, err := loadKYC(policy.KYC(), holder, getStateFunc)
+		if err != nil {
+			return CapTable{}, err
+		}
+
+		for _, p := range partitions {
+			st, found, _ := getStateFunc(stostate.StateKeyTokenHolderPartitionBalance(contract, stoID, holder, p))
+			if !found {
+				continue
+			}
+
+			balance, err := stostate.StateTokenHolderPartitionBalanceValue(st)
+			if err != nil {
+				return CapTable{}, err
+			}
+
+			if !balance.OverZero() {
+				continue
+			}
+
+			t.Rows = append(t.Rows, Row{
+				Holder:             holder,
+				Partition:          p,
+				Balance:            balance,
+				PercentOfPartition: percent(balance, totals[p]),
+				PercentOfAggregate: percent(balance, policy.Aggregate()),
+				KYC:                kyc,
+			})
+		}
+	}
+
+	sort.SliceStable(t.Rows, func(i, j int) bool {
+		if a, b := t.Rows[i].Holder.String(), t.Rows[j].Holder.String(); a != b {
+			return a < b
+		}
+
+		return t.Rows[i].Partition.String() < t.Rows[j].Partition.String()
+	})
+
+	return t, nil
+}
+
+// WriteCSV writes the rows of cap table with CSVHeader.
+func (t CapTable) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(CSVHeader); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := range t.Rows {
+		r := t.Rows[i]
+
+		record := []string{
+			r.Holder.String(), r.Partition.String(), r.Balance.String(), r.PercentOfPartition, r.PercentOfAggregate,
+			"", "", "", "", "", "", "", "",
+		}
+
+		if r.KYC != nil {
+			c := r.KYC.Customer
+
+			copy(record[5:], []string{
+				r.KYC.Contract.String(),
+				r.KYC.KYC.String(),
+				c.Status().String(),
+				c.Jurisdiction().String(),
+				c.Accreditation(),
+				c.Category(),
+				strconv.FormatUint(c.Expiry(), 10),
+				c.DocumentHash(),
+			})
+		}
+
+		if err := cw.Write(record); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	cw.Flush()
+
+	return errors.WithStack(cw.Error())
+}
+
+// loadHolders finds the holders of sto from the keys of tokenholder partitions
+// states; no state keeps the list of holders.
+func loadHolders(
+	contract base.Address, stoID currencytypes.ContractID, sts []base.State, enc encoder.Encoder,
+) ([]base.Address, error) {
+	prefix := stostate.StateKeySTOPrefix(contract, stoID) + "-"
+
+	var holders []base.Address
+
+	for i := range sts {
+		key := sts[i].Key()
+
+		if !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, stostate.TokenHolderPartitionsSuffix) {
+			continue
+		}
+
+		s := strings.TrimSuffix(strings.TrimPrefix(key, prefix), stostate.TokenHolderPartitionsSuffix)
+
+		holder, err := base.DecodeAddress(s, enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid holder in state key, %q", key)
+		}
+
+		holders = append(holders, holder)
+	}
+
+	return holders, nil
+}
+
+func loadKYC(kyc stotypes.KYCRequirement, holder base.Address, getStateFunc base.GetStateFunc) (*KYC, error) {
+	for _, s := range kyc.Services() {
+		contract := s.Contract()
+
+		if st, found, _ := getStateFunc(kycstate.StateKeyDesign(contract, s.KYC())); found {
+			if m, ok := st.Value().(kycstate.MigratedStateValue); ok {
+				contract = m.Contract
+			}
+		}
+
+		switch info, found, err := kycstate.LoadCustomer(contract, s.KYC(), holder, getStateFunc); {
+		case err != nil:
+			return nil, err
+		case found:
+			return &KYC{Contract: contract, KYC: s.KYC(), Customer: info}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func percent(a, b common.Big) string {
+	if !b.OverZero() {
+		return new(big.Rat).FloatString(PercentPrecision)
+	}
+
+	r := new(big.Rat).SetFrac(new(big.Int).Mul(a.Int, big.NewInt(100)), b.Int) //nolint:gomnd //...
+
+	return r.FloatString(PercentPrecision)
+}
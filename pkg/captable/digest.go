@@ -0,0 +1,42 @@
+package captable
+
+import (
+	"context"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/pkg/digest"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+// LoadDigested builds the cap table of sto from the last states until height
+// in digest database.
+func LoadDigested(
+	ctx context.Context,
+	st *currencydigest.Database,
+	enc encoder.Encoder,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	height base.Height,
+) (CapTable, error) {
+	dsts, err := digest.LoadStates(
+		ctx, st, []string{stostate.StateKeySTOPrefix(contract, stoID), kycstate.KYCPrefix}, height)
+	if err != nil {
+		return CapTable{}, err
+	}
+
+	filter := stateFilter(contract, stoID)
+
+	var sts []base.State
+
+	for i := range dsts {
+		if filter(dsts[i].Key()) {
+			sts = append(sts, dsts[i])
+		}
+	}
+
+	return Build(contract, stoID, height, sts, enc)
+}
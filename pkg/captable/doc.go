@@ -0,0 +1,5 @@
+/*
+Package captable builds the cap table of sto, the balances of every tokenholder
+by partition with the kyc record of tokenholder, from the states at a height.
+*/
+package captable
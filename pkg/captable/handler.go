@@ -0,0 +1,143 @@
+package captable
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/pkg/localfs"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+)
+
+var HandlerPathCapTable = `/sto/{contract:(?i)` + base.REStringAddressString + `}/{sto_id:[\w]+}/captable` // revive:disable-line:line-length-limit
+
+// Handler serves the cap table of sto at the height of query, "?height=", or
+// at the last digested height. The states are read from the digest database;
+// the cap-table command builds the same table from the local fs storage. With
+// "?format=csv", the rows are written in csv.
+type Handler struct {
+	enc      encoder.Encoder
+	cache    currencydigest.Cache
+	database *currencydigest.Database
+}
+
+func NewHandler(enc encoder.Encoder, cache currencydigest.Cache, st *currencydigest.Database) *Handler {
+	return &Handler{
+		enc:      enc,
+		cache:    cache,
+		database: st,
+	}
+}
+
+func (hd *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	cachekey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cachekey, w); err == nil {
+		return
+	}
+
+	contract, err := base.DecodeAddress(strings.TrimSpace(mux.Vars(r)["contract"]), hd.enc)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	stoID := currencytypes.ContractID(strings.TrimSpace(mux.Vars(r)["sto_id"]))
+	if err := stoID.IsValid(nil); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	}
+
+	last := hd.database.LastBlock()
+	height := last
+
+	if s := currencydigest.ParseStringQuery(r.URL.Query().Get("height")); len(s) > 0 {
+		switch h, err := base.ParseHeightString(s); {
+		case err != nil:
+			currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+			return
+		case h > last:
+			currencydigest.HTTP2ProblemWithError(w, errors.Errorf("height not digested yet, %d > %d", h, last), http.StatusNotFound)
+
+			return
+		default:
+			height = h
+		}
+	}
+
+	t, err := LoadDigested(r.Context(), hd.database, hd.enc, contract, stoID, height)
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	if currencydigest.ParseStringQuery(r.URL.Query().Get("format")) == "csv" {
+		buf := bytes.NewBuffer(nil)
+		if err := t.WriteCSV(buf); err != nil {
+			currencydigest.HTTP2HandleError(w, err)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		_, _ = w.Write(buf.Bytes())
+	} else {
+		b, err := hd.enc.Marshal(currencydigest.NewBaseHal(t, currencydigest.NewHalLink(r.URL.String(), nil)))
+		if err != nil {
+			currencydigest.HTTP2HandleError(w, err)
+
+			return
+		}
+
+		currencydigest.HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+	}
+
+	currencydigest.HTTP2WriteCache(w, cachekey, time.Second*3) //nolint:gomnd //...
+}
+
+// Load builds the cap table of sto from the blocks of local fs storage, root,
+// until height.
+func Load(
+	root string,
+	enc encoder.Encoder,
+	contract base.Address,
+	stoID currencytypes.ContractID,
+	height base.Height,
+) (CapTable, error) {
+	sts, last, err := localfs.LoadStates(root, enc, height, stateFilter(contract, stoID))
+	if err != nil {
+		return CapTable{}, err
+	}
+
+	return Build(contract, stoID, last, sts, enc)
+}
+
+// stateFilter selects the states of sto and the kyc states, which the cap
+// table is built from.
+func stateFilter(contract base.Address, stoID currencytypes.ContractID) func(string) bool {
+	prefix := stostate.StateKeySTOPrefix(contract, stoID)
+
+	return func(key string) bool {
+		switch {
+		case strings.HasPrefix(key, kycstate.KYCPrefix):
+			return true
+		case !strings.HasPrefix(key, prefix):
+			return false
+		default:
+			rest := key[len(prefix):]
+
+			return len(rest) > 0 && (rest[0] == ':' || rest[0] == '-')
+		}
+	}
+}
@@ -0,0 +1,6 @@
+/*
+Package digest stores the sto and kyc states of blocks and the fees paid by sto
+and kyc operations in the digest database of node, so the digest api reads
+them at a height without the local fs storage.
+*/
+package digest
@@ -0,0 +1,173 @@
+package digest
+
+import (
+	"context"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-sto/operation/fee"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/fixedtree"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DefaultColNameFeeEvent is the collection of the fees paid by sto and kyc
+// operations.
+var DefaultColNameFeeEvent = "digest_sto_fee_event"
+
+var feeEventIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "fact", Value: 1}},
+		Options: options.Index().
+			SetName("mitum_digest_sto_fee_event_fact"),
+	},
+	{
+		Keys: bson.D{bson.E{Key: "receiver", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_sto_fee_event_receiver"),
+	},
+}
+
+// FeeEventDoc is the fee which the operation of fact paid in the block of
+// height.
+type FeeEventDoc struct {
+	fact   util.Hash
+	height base.Height
+	ev     fee.Event
+}
+
+func NewFeeEventDoc(fact util.Hash, height base.Height, ev fee.Event) FeeEventDoc {
+	return FeeEventDoc{
+		fact:   fact,
+		height: height,
+		ev:     ev,
+	}
+}
+
+func (doc FeeEventDoc) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(bson.M{
+		"fact":     doc.fact.String(),
+		"height":   doc.height,
+		"payer":    doc.ev.Payer.String(),
+		"receiver": doc.ev.Receiver.String(),
+		"currency": doc.ev.Currency.String(),
+		"amount":   doc.ev.Amount.String(),
+	})
+}
+
+// OperationFees returns the fees of sto or kyc operation of fact; nil for the
+// other operations.
+func OperationFees(fact base.Fact, getStateFunc base.GetStateFunc) (*fee.Fees, error) {
+	switch fees, err := sto.OperationFees(fact, getStateFunc); {
+	case err != nil:
+		return nil, err
+	case fees != nil:
+		return fees, nil
+	}
+
+	return kyc.OperationFees(fact, getStateFunc)
+}
+
+// DigestFeeEvents stores the fees paid by the sto and kyc operations of block,
+// which are processed into states. The fees are calculated by the operation
+// like its processor, against the digested states of the previous block; the
+// states created in the block are read from sts.
+func DigestFeeEvents(
+	ctx context.Context,
+	st *currencydigest.Database,
+	height base.Height,
+	ops []base.Operation,
+	opstree fixedtree.Tree,
+	sts []base.State,
+) error {
+	if len(ops) < 1 {
+		return nil
+	}
+
+	inStates := map[string]bool{}
+
+	if err := opstree.Traverse(func(_ uint64, no fixedtree.Node) (bool, error) {
+		nno, ok := no.(base.OperationFixedtreeNode)
+		if !ok {
+			return false, errors.Errorf("expected OperationFixedtreeNode, not %T", no)
+		}
+
+		inStates[nno.Key()] = nno.InState()
+
+		return true, nil
+	}); err != nil {
+		return err
+	}
+
+	block := map[string]base.State{}
+	for i := range sts {
+		block[sts[i].Key()] = sts[i]
+	}
+
+	getStateFunc := func(key string) (base.State, bool, error) {
+		switch i, found, err := State(ctx, st, key, height-1); {
+		case err != nil:
+			return nil, false, err
+		case found:
+			return i, true, nil
+		}
+
+		i, found := block[key]
+
+		return i, found, nil
+	}
+
+	var models []mongo.WriteModel
+
+	for i := range ops {
+		fact := ops[i].Fact()
+
+		if !inStates[fact.Hash().String()] {
+			continue
+		}
+
+		fees, err := OperationFees(fact, getStateFunc)
+		if err != nil {
+			return errors.WithMessagef(err, "failed to calculate fees of operation, %q", fact.Hash())
+		}
+
+		if fees == nil {
+			continue
+		}
+
+		sender, ok := fact.(interface{ Sender() base.Address })
+		if !ok {
+			return errors.Errorf("expected fact with sender, not %T", fact)
+		}
+
+		for _, ev := range fees.Events(sender.Sender()) {
+			models = append(models, mongo.NewReplaceOneModel().
+				SetFilter(bson.D{
+					bson.E{Key: "fact", Value: fact.Hash().String()},
+					bson.E{Key: "receiver", Value: ev.Receiver.String()},
+					bson.E{Key: "currency", Value: ev.Currency.String()},
+				}).
+				SetReplacement(NewFeeEventDoc(fact.Hash(), height, ev)).
+				SetUpsert(true),
+			)
+		}
+	}
+
+	if len(models) < 1 {
+		return nil
+	}
+
+	if _, err := st.DatabaseClient().Collection(DefaultColNameFeeEvent).BulkWrite(
+		ctx, models, options.BulkWrite().SetOrdered(false),
+	); err != nil {
+		return errors.Wrap(err, "failed to digest fee events")
+	}
+
+	return nil
+}
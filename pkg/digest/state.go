@@ -0,0 +1,229 @@
+package digest
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	currency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DefaultColNameState is the collection of the sto and kyc states, and the
+// states which their fees are calculated by.
+var DefaultColNameState = "digest_sto_state"
+
+var stateIndexModels = []mongo.IndexModel{
+	{
+		Keys: bson.D{bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}},
+		Options: options.Index().
+			SetName("mitum_digest_sto_state"),
+	},
+}
+
+type StateDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewStateDoc(st base.State, enc encoder.Encoder) (StateDoc, error) {
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return StateDoc{}, err
+	}
+
+	return StateDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc StateDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	m["key"] = doc.st.Key()
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+// IsDigestState returns true for the states of sto and kyc, and for the
+// currency designs and contract accounts, which the fees of sto and kyc
+// operations are calculated by.
+func IsDigestState(key string) bool {
+	switch {
+	case strings.HasPrefix(key, stostate.STOPrefix),
+		strings.HasPrefix(key, kycstate.KYCPrefix),
+		currency.IsStateCurrencyDesignKey(key),
+		extensioncurrency.IsStateContractAccountKey(key):
+		return true
+	default:
+		return false
+	}
+}
+
+// CreateIndex creates the indexes of the collections in digest database.
+func CreateIndex(ctx context.Context, st *currencydigest.Database) error {
+	for col, models := range map[string][]mongo.IndexModel{
+		DefaultColNameState:    stateIndexModels,
+		DefaultColNameFeeEvent: feeEventIndexModels,
+	} {
+		if _, err := st.DatabaseClient().Collection(col).Indexes().CreateMany(ctx, models); err != nil {
+			return errors.Wrapf(err, "failed to create index of %s", col)
+		}
+	}
+
+	return nil
+}
+
+// DigestStates stores the states of block, which IsDigestState selects, in
+// digest database. The states are replaced by key and height, so the block can
+// be digested again.
+func DigestStates(ctx context.Context, st *currencydigest.Database, sts []base.State) error {
+	var models []mongo.WriteModel
+
+	for i := range sts {
+		if !IsDigestState(sts[i].Key()) {
+			continue
+		}
+
+		doc, err := NewStateDoc(sts[i], st.DatabaseEncoder())
+		if err != nil {
+			return err
+		}
+
+		models = append(models, mongo.NewReplaceOneModel().
+			SetFilter(bson.D{bson.E{Key: "key", Value: sts[i].Key()}, bson.E{Key: "height", Value: sts[i].Height()}}).
+			SetReplacement(doc).
+			SetUpsert(true),
+		)
+	}
+
+	if len(models) < 1 {
+		return nil
+	}
+
+	if _, err := st.DatabaseClient().Collection(DefaultColNameState).BulkWrite(
+		ctx, models, options.BulkWrite().SetOrdered(false),
+	); err != nil {
+		return errors.Wrap(err, "failed to digest sto states")
+	}
+
+	return nil
+}
+
+// LoadStates returns the last states until height, whose keys start with one
+// of prefixes.
+func LoadStates(
+	ctx context.Context,
+	st *currencydigest.Database,
+	prefixes []string,
+	height base.Height,
+) ([]base.State, error) {
+	keys := make(bson.A, len(prefixes))
+	for i := range prefixes {
+		keys[i] = bson.D{bson.E{Key: "key", Value: bson.D{
+			bson.E{Key: "$regex", Value: "^" + regexp.QuoteMeta(prefixes[i])},
+		}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{bson.E{Key: "$match", Value: bson.D{
+			bson.E{Key: "$or", Value: keys},
+			bson.E{Key: "height", Value: bson.D{bson.E{Key: "$lte", Value: height}}},
+		}}},
+		bson.D{bson.E{Key: "$sort", Value: bson.D{bson.E{Key: "key", Value: 1}, bson.E{Key: "height", Value: -1}}}},
+		bson.D{bson.E{Key: "$group", Value: bson.D{
+			bson.E{Key: "_id", Value: "$key"},
+			bson.E{Key: "doc", Value: bson.D{bson.E{Key: "$first", Value: "$$ROOT"}}},
+		}}},
+		bson.D{bson.E{Key: "$replaceRoot", Value: bson.D{bson.E{Key: "newRoot", Value: "$doc"}}}},
+	}
+
+	cursor, err := st.DatabaseClient().Collection(DefaultColNameState).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load sto states")
+	}
+
+	defer func() {
+		_ = cursor.Close(context.Background())
+	}()
+
+	var sts []base.State
+
+	for cursor.Next(ctx) {
+		i, err := loadState(cursor.Current, st.DatabaseEncoders())
+		if err != nil {
+			return nil, err
+		}
+
+		sts = append(sts, i)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to load sto states")
+	}
+
+	return sts, nil
+}
+
+// State returns the last state of key until height.
+func State(
+	ctx context.Context,
+	st *currencydigest.Database,
+	key string,
+	height base.Height,
+) (base.State, bool, error) {
+	res := st.DatabaseClient().Collection(DefaultColNameState).FindOne(
+		ctx,
+		bson.D{
+			bson.E{Key: "key", Value: key},
+			bson.E{Key: "height", Value: bson.D{bson.E{Key: "$lte", Value: height}}},
+		},
+		options.FindOne().SetSort(bson.D{bson.E{Key: "height", Value: -1}}),
+	)
+
+	var b bson.Raw
+
+	switch err := res.Decode(&b); {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, false, nil
+	case err != nil:
+		return nil, false, errors.Wrapf(err, "failed to load state, %q", key)
+	}
+
+	i, err := loadState(b, st.DatabaseEncoders())
+	if err != nil {
+		return nil, false, err
+	}
+
+	return i, true, nil
+}
+
+func loadState(b bson.Raw, encs *encoder.Encoders) (base.State, error) {
+	_, i, err := mongodbstorage.LoadDataFromDoc(b, encs)
+	if err != nil {
+		return nil, err
+	}
+
+	st, ok := i.(base.State)
+	if !ok {
+		return nil, errors.Errorf("expected base.State, not %T", i)
+	}
+
+	return st, nil
+}
@@ -0,0 +1,12 @@
+/*
+Package golden keeps the corpus of the json and bson documents of every hinted
+type of sto and kyc, which node stores in blocks and states. The tests decode
+the corpus and check the decoded values have the same bytes and hashes as
+before, so the change of the encoding, which forks the network, can not be
+made silently.
+
+The corpus is generated from the fixtures of tests:
+
+	go test ./pkg/golden -update
+*/
+package golden
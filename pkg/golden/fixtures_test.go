@@ -0,0 +1,402 @@
+package golden
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/genesis"
+	"github.com/ProtoconNet/mitum-sto/operation/kyc"
+	"github.com/ProtoconNet/mitum-sto/operation/network"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/pkg/builder"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	networkstate "github.com/ProtoconNet/mitum-sto/state/network"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	networkID = base.NetworkID("mitum-sto-golden")
+	token     = []byte("golden")
+	seed      = "mitum-sto golden corpus; the operations are signed by this seed"
+	currency  = currencytypes.CurrencyID("MCC")
+	stoID     = currencytypes.ContractID("STO")
+	kycID     = currencytypes.ContractID("KYC")
+	partition = stotypes.Partition("PTA")
+)
+
+var (
+	sender      = currencytypes.NewAddress("sender0")
+	contract    = currencytypes.NewAddress("contract0")
+	newContract = currencytypes.NewAddress("contract1")
+	controller  = currencytypes.NewAddress("controller0")
+	holder      = currencytypes.NewAddress("holder0")
+	receiver    = currencytypes.NewAddress("holder1")
+	operator    = currencytypes.NewAddress("operator0")
+	customer    = currencytypes.NewAddress("customer0")
+	node        = currencytypes.NewAddress("node0")
+)
+
+// fixture is the value of hint in the corpus. The token of volatile fixture
+// is the current time, so the fixture itself can not be compared with the
+// corpus; only the corpus is decoded and checked.
+type fixture struct {
+	hinter   hint.Hinter
+	volatile bool
+}
+
+// legacyFixture is the document of legacy hint, which node does not encode
+// anymore but still decodes from the old states and operations. It is written
+// by hand and decoded to the type of instance with the bytes, which the hash
+// of value was generated from by the legacy hint.
+type legacyFixture struct {
+	ht       hint.Hint
+	instance hint.Hinter
+	bytes    []byte
+}
+
+func privatekey(t *testing.T) base.Privatekey {
+	t.Helper()
+
+	priv, err := base.NewMPrivatekeyFromSeed(seed)
+	if err != nil {
+		t.Fatalf("failed to create privatekey: %+v", err)
+	}
+
+	return priv
+}
+
+func fixtures(t *testing.T) []fixture {
+	t.Helper()
+
+	priv := privatekey(t)
+
+	b, err := builder.New(priv, networkID)
+	if err != nil {
+		t.Fatalf("failed to create builder: %+v", err)
+	}
+	b = b.WithToken(token)
+
+	// types
+	kycService := stotypes.NewKYCService(contract, kycID)
+	kycRequirement := stotypes.NewKYCRequirement([]stotypes.KYCService{kycService}, stotypes.KYCModeAllOf)
+	jurisdictionCap := stotypes.NewJurisdictionCap("KR", 100)
+	rule := stotypes.NewJurisdictionRule(
+		[]kyctypes.Jurisdiction{"KR", "SG"},
+		[]kyctypes.Jurisdiction{"US"},
+		[]stotypes.JurisdictionCap{jurisdictionCap},
+	)
+	document := stotypes.NewDocument(stoID, "prospectus", "DOCHASH", "https://example.com/prospectus.pdf")
+	stoPolicy := stotypes.NewPolicy(
+		[]stotypes.Partition{partition},
+		common.NewBig(100),
+		[]base.Address{controller},
+		[]stotypes.Document{document},
+		kycRequirement,
+	)
+	serviceFee := stotypes.NewServiceFee(stotypes.FeeOperationTransfer, currency, common.NewBig(1), 25)
+	schedule := stotypes.NewFeeSchedule([]stotypes.ServiceFee{serviceFee})
+	stoDesign := stotypes.NewDesign(stoID, 1, stoPolicy, rule).SetFees(schedule)
+
+	kycController := kyctypes.NewController(controller, []kyctypes.Role{kyctypes.RoleReviewer, kyctypes.RoleApprover})
+	kycPolicy := kyctypes.NewPolicy([]kyctypes.Controller{kycController}, 2)
+	kycDesign := kyctypes.NewDesign(kycID, kycPolicy)
+	info := kyctypes.NewCustomerInfo(kyctypes.CustomerStatusApproved, "KR", "accredited", "individual", 1000, "KYCHASH")
+
+	networkPolicy := networktypes.NewNetworkPolicy(10, 1, 10, 10, 10)
+
+	fs := []fixture{
+		{hinter: kycService},
+		{hinter: kycRequirement},
+		{hinter: jurisdictionCap},
+		{hinter: rule},
+		{hinter: serviceFee},
+		{hinter: schedule},
+		{hinter: document},
+		{hinter: stoPolicy},
+		{hinter: stoDesign},
+		{hinter: kycController},
+		{hinter: kycPolicy},
+		{hinter: kycDesign},
+		{hinter: info},
+		{hinter: networkPolicy},
+	}
+
+	// states
+	fs = append(fs,
+		fixture{hinter: stostate.NewDesignStateValue(stoDesign)},
+		fixture{hinter: stostate.NewTokenHolderPartitionsStateValue([]stotypes.Partition{partition})},
+		fixture{hinter: stostate.NewTokenHolderPartitionBalanceStateValue(common.NewBig(100), partition)},
+		fixture{hinter: stostate.NewTokenHolderPartitionOperatorsStateValue([]base.Address{operator})},
+		fixture{hinter: stostate.NewPartitionBalanceStateValue(common.NewBig(100))},
+		fixture{hinter: stostate.NewOperatorTokenHoldersStateValue([]base.Address{holder})},
+		fixture{hinter: stostate.NewTokenHolderOperatorsStateValue([]base.Address{operator})},
+		fixture{hinter: stostate.NewOperatorTokenHolderStateValue(true)},
+		fixture{hinter: stostate.NewOperatorTokenHoldersCountStateValue(1)},
+		fixture{hinter: stostate.NewOperatorAllowanceStateValue(common.NewBig(50), 100)},
+		fixture{hinter: stostate.NewJurisdictionHoldersCountStateValue(1)},
+		fixture{hinter: stostate.NewTokenHolderJurisdictionStateValue("KR")},
+		fixture{hinter: stostate.NewMigratedStateValue(newContract)},
+		fixture{hinter: stostate.NewMigratingStateValue(newContract, stoDesign, []common.Big{common.NewBig(50)}, 1)},
+		fixture{hinter: kycstate.NewDesignStateValue(kycDesign)},
+		fixture{hinter: kycstate.NewCustomerStateValue(info)},
+		fixture{hinter: kycstate.NewRemovedCustomerStateValue()},
+		fixture{hinter: kycstate.NewCustomerApprovalsStateValue([]base.Address{controller})},
+		fixture{hinter: kycstate.NewMigratedStateValue(newContract)},
+		fixture{hinter: networkstate.NewNetworkPolicyStateValue(networkPolicy)},
+	)
+
+	// items; the operations of items are added below
+	createItem := sto.NewCreateSecurityTokensItem(
+		contract, stoID, 1, partition, []base.Address{controller}, kycRequirement, currency)
+	issueItem := sto.NewIssueSecurityTokensItem(contract, stoID, holder, common.NewBig(100), partition, currency)
+	transferItem := sto.NewTransferSecurityTokensPartitionItem(
+		contract, stoID, holder, receiver, partition, common.NewBig(30), currency)
+	redeemItem := sto.NewRedeemTokensItem(contract, stoID, holder, common.NewBig(30), partition, currency)
+	authorizeItem := sto.NewAuthorizeOperatorsItem(contract, stoID, operator, partition, common.NewBig(50), 100, currency)
+	revokeItem := sto.NewRevokeOperatorsItem(contract, stoID, operator, partition, currency)
+	authorizeGlobalItem := sto.NewAuthorizeGlobalOperatorsItem(contract, stoID, operator, currency)
+	revokeGlobalItem := sto.NewRevokeGlobalOperatorsItem(contract, stoID, operator, currency)
+
+	addControllersItem := kyc.NewAddControllersItem(contract, kycID, controller, []kyctypes.Role{kyctypes.RoleAdmin}, currency)
+	removeControllersItem := kyc.NewRemoveControllersItem(contract, kycID, controller, currency)
+	addCustomersItem := kyc.NewAddCustomersItem(contract, kycID, customer, info, currency)
+	updateCustomersItem := kyc.NewUpdateCustomersItem(contract, kycID, customer, info, currency)
+	renewCustomersItem := kyc.NewRenewCustomersItem(contract, kycID, customer, 2000, currency)
+	approveCustomersItem := kyc.NewApproveCustomersItem(contract, kycID, customer, currency)
+	removeCustomersItem := kyc.NewRemoveCustomersItem(contract, kycID, customer, currency)
+
+	genesisCustomer := genesis.NewGenesisCustomer(customer, info)
+	genesisKYCService := genesis.NewGenesisKYCService(kycDesign, []genesis.GenesisCustomer{genesisCustomer})
+	allocation := genesis.NewGenesisAllocation(holder, partition, common.NewBig(100))
+	genesisSecurityToken := genesis.NewGenesisSecurityToken(
+		stotypes.NewDesign(stoID, 1, stoPolicy, stotypes.EmptyJurisdictionRule()),
+		[]genesis.GenesisAllocation{allocation},
+	)
+
+	fs = append(fs,
+		fixture{hinter: createItem},
+		fixture{hinter: issueItem},
+		fixture{hinter: transferItem},
+		fixture{hinter: redeemItem},
+		fixture{hinter: authorizeItem},
+		fixture{hinter: revokeItem},
+		fixture{hinter: authorizeGlobalItem},
+		fixture{hinter: revokeGlobalItem},
+		fixture{hinter: addControllersItem},
+		fixture{hinter: removeControllersItem},
+		fixture{hinter: addCustomersItem},
+		fixture{hinter: updateCustomersItem},
+		fixture{hinter: renewCustomersItem},
+		fixture{hinter: approveCustomersItem},
+		fixture{hinter: removeCustomersItem},
+		fixture{hinter: genesisCustomer},
+		fixture{hinter: genesisKYCService},
+		fixture{hinter: allocation},
+		fixture{hinter: genesisSecurityToken},
+	)
+
+	// operations and their facts
+	ops := []func() (base.Operation, error){
+		func() (base.Operation, error) { return b.CreateSecurityTokens(sender, createItem) },
+		func() (base.Operation, error) { return b.IssueSecurityTokens(sender, issueItem) },
+		func() (base.Operation, error) { return b.TransferSecurityTokensPartition(sender, transferItem) },
+		func() (base.Operation, error) { return b.RedeemTokens(sender, redeemItem) },
+		func() (base.Operation, error) { return b.AuthorizeOperators(sender, authorizeItem) },
+		func() (base.Operation, error) { return b.RevokeOperators(sender, revokeItem) },
+		func() (base.Operation, error) { return b.AuthorizeGlobalOperators(sender, authorizeGlobalItem) },
+		func() (base.Operation, error) { return b.RevokeGlobalOperators(sender, revokeGlobalItem) },
+		func() (base.Operation, error) {
+			return b.SetDocument(sender, contract, stoID, "prospectus", "https://example.com/prospectus.pdf", "DOCHASH", currency)
+		},
+		func() (base.Operation, error) {
+			return b.UpdateJurisdictionRule(sender, contract, stoID, rule, currency)
+		},
+		func() (base.Operation, error) {
+			return b.UpdateFeeSchedule(sender, contract, stoID, schedule, currency)
+		},
+		func() (base.Operation, error) {
+			return b.MigrateSecurityTokens(
+				sender, contract, stoID, newContract, sto.MigrationModeMigrate, []base.Address{holder}, currency)
+		},
+		func() (base.Operation, error) {
+			return b.RecoverTokenHolder(sender, contract, stoID, holder, controller, currency)
+		},
+		func() (base.Operation, error) {
+			return b.CreateKYCService(sender, contract, kycID, []kyctypes.Controller{kycController}, 2, currency)
+		},
+		func() (base.Operation, error) { return b.AddControllers(sender, addControllersItem) },
+		func() (base.Operation, error) { return b.RemoveControllers(sender, removeControllersItem) },
+		func() (base.Operation, error) { return b.AddCustomers(sender, addCustomersItem) },
+		func() (base.Operation, error) { return b.UpdateCustomers(sender, updateCustomersItem) },
+		func() (base.Operation, error) { return b.RenewCustomers(sender, renewCustomersItem) },
+		func() (base.Operation, error) { return b.ApproveCustomers(sender, approveCustomersItem) },
+		func() (base.Operation, error) { return b.RemoveCustomers(sender, removeCustomersItem) },
+		func() (base.Operation, error) {
+			return b.MigrateKYCService(sender, contract, kycID, newContract, []base.Address{customer}, currency)
+		},
+		func() (base.Operation, error) {
+			op, err := network.NewUpdateNetworkPolicy(network.NewUpdateNetworkPolicyFact(token, networkPolicy))
+			if err != nil {
+				return nil, err
+			}
+
+			if err := op.NodeSign(priv, networkID, node); err != nil {
+				return nil, err
+			}
+
+			return op, nil
+		},
+	}
+
+	for i := range ops {
+		op, err := ops[i]()
+		if err != nil {
+			t.Fatalf("failed to build operation: %+v", err)
+		}
+
+		fs = append(fs, fixture{hinter: op}, fixture{hinter: op.Fact()})
+	}
+
+	genesisNetworkPolicy := network.NewGenesisNetworkPolicy(network.NewGenesisNetworkPolicyFact(networkPolicy))
+	if err := genesisNetworkPolicy.Sign(priv, networkID); err != nil {
+		t.Fatalf("failed to sign GenesisNetworkPolicy: %+v", err)
+	}
+
+	genesisContract := genesis.NewGenesisContract(genesis.NewGenesisContractFact(
+		contract, sender, []genesis.GenesisKYCService{genesisKYCService}, []genesis.GenesisSecurityToken{genesisSecurityToken},
+	))
+	if err := genesisContract.Sign(priv, networkID); err != nil {
+		t.Fatalf("failed to sign GenesisContract: %+v", err)
+	}
+
+	fs = append(fs,
+		fixture{hinter: genesisNetworkPolicy, volatile: true},
+		fixture{hinter: genesisNetworkPolicy.Fact().(hint.Hinter), volatile: true},
+		fixture{hinter: genesisContract, volatile: true},
+		fixture{hinter: genesisContract.Fact().(hint.Hinter), volatile: true},
+	)
+
+	return fs
+}
+
+func legacyFixtures() []legacyFixture {
+	// NOTE the bytes are written as node wrote them by the legacy hints
+	stoPolicy := util.ConcatBytesSlice(
+		partition.Bytes(),
+		controller.Bytes(),
+		common.NewBig(100).Bytes(),
+	)
+
+	// NOTE the facts are written without token, because the token of json
+	// document is not converted to the same bytes in bson
+	createKYCService := util.ConcatBytesSlice(
+		sender.Bytes(),
+		contract.Bytes(),
+		kycID.Bytes(),
+		controller.Bytes(),
+		currency.Bytes(),
+	)
+	rolesCreateKYCService := util.ConcatBytesSlice(
+		sender.Bytes(),
+		contract.Bytes(),
+		kycID.Bytes(),
+		controller.Bytes(),
+		kyctypes.RoleReviewer.Bytes(),
+		currency.Bytes(),
+	)
+
+	return []legacyFixture{
+		{
+			ht:       stotypes.LegacyPolicyHint,
+			instance: stotypes.Policy{},
+			bytes:    stoPolicy,
+		},
+		{
+			ht:       stotypes.LegacyDesignHint,
+			instance: stotypes.Design{},
+			bytes:    util.ConcatBytesSlice(stoID.Bytes(), util.Uint64ToBigBytes(1), stoPolicy),
+		},
+		{
+			ht:       stotypes.LegacyJurisdictionsDesignHint,
+			instance: stotypes.Design{},
+			bytes: util.ConcatBytesSlice(
+				stoID.Bytes(),
+				util.Uint64ToBigBytes(1),
+				stoPolicy,
+				contract.Bytes(),
+				kycID.Bytes(),
+				stotypes.KYCModeAnyOf.Bytes(),
+				kyctypes.Jurisdiction("KR").Bytes(),
+			),
+		},
+		{
+			ht:       kyctypes.LegacyPolicyHint,
+			instance: kyctypes.Policy{},
+			bytes:    controller.Bytes(),
+		},
+		{
+			ht:       kyctypes.LegacyRolesPolicyHint,
+			instance: kyctypes.Policy{},
+			bytes:    util.ConcatBytesSlice(controller.Bytes(), kyctypes.RoleReviewer.Bytes()),
+		},
+		{
+			ht:       kycstate.LegacyCustomerStateValueHint,
+			instance: kycstate.CustomerStateValue{},
+			bytes:    []byte{1},
+		},
+		{
+			ht:       sto.LegacyCreateSecurityTokensItemHint,
+			instance: sto.CreateSecurityTokensItem{},
+			bytes: util.ConcatBytesSlice(
+				contract.Bytes(),
+				stoID.Bytes(),
+				util.Uint64ToBytes(1),
+				partition.Bytes(),
+				controller.Bytes(),
+				currency.Bytes(),
+			),
+		},
+		{
+			ht:       kyc.LegacyCreateKYCServiceFactHint,
+			instance: kyc.CreateKYCServiceFact{},
+			bytes:    createKYCService,
+		},
+		{
+			ht:       kyc.LegacyCreateKYCServiceHint,
+			instance: kyc.CreateKYCService{},
+			bytes:    createKYCService,
+		},
+		{
+			ht:       kyc.LegacyRolesCreateKYCServiceFactHint,
+			instance: kyc.CreateKYCServiceFact{},
+			bytes:    rolesCreateKYCService,
+		},
+		{
+			ht:       kyc.LegacyRolesCreateKYCServiceHint,
+			instance: kyc.CreateKYCService{},
+			bytes:    rolesCreateKYCService,
+		},
+		{
+			ht:       kyc.LegacyAddControllersItemHint,
+			instance: kyc.AddControllersItem{},
+			bytes:    util.ConcatBytesSlice(contract.Bytes(), kycID.Bytes(), controller.Bytes(), currency.Bytes()),
+		},
+		{
+			ht:       kyc.LegacyAddCustomersItemHint,
+			instance: kyc.AddCustomersItem{},
+			bytes:    util.ConcatBytesSlice(contract.Bytes(), kycID.Bytes(), customer.Bytes(), []byte{1}, currency.Bytes()),
+		},
+		{
+			ht:       kyc.LegacyUpdateCustomersItemHint,
+			instance: kyc.UpdateCustomersItem{},
+			bytes:    util.ConcatBytesSlice(contract.Bytes(), kycID.Bytes(), customer.Bytes(), []byte{0}, currency.Bytes()),
+		},
+	}
+}
@@ -0,0 +1,330 @@
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-sto/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var update = flag.Bool("update", false, "write the corpus from the fixtures")
+
+func newEncoders(t *testing.T) (*jsonenc.Encoder, *bsonenc.Encoder) {
+	t.Helper()
+
+	jenc := jsonenc.NewEncoder()
+	if err := cmds.LoadHinters(jenc); err != nil {
+		t.Fatalf("failed to load hinters: %+v", err)
+	}
+
+	benc := bsonenc.NewEncoder()
+	if err := cmds.LoadHinters(benc); err != nil {
+		t.Fatalf("failed to load hinters: %+v", err)
+	}
+
+	return jenc, benc
+}
+
+// hashOf is the hash of the bytes which the hash of fact or state value is
+// generated from; the operation is hashed by its fact, because the signs of
+// operation have the signed time.
+func hashOf(t *testing.T, i interface{}) util.Hash {
+	t.Helper()
+
+	switch v := i.(type) {
+	case base.Operation:
+		return hashOf(t, v.Fact())
+	case interface{ Bytes() []byte }:
+		return valuehash.NewSHA256(v.Bytes())
+	case interface{ HashBytes() []byte }:
+		return valuehash.NewSHA256(v.HashBytes())
+	default:
+		t.Fatalf("bytes of %T not found", i)
+
+		return nil
+	}
+}
+
+// checkValid checks i is valid; operations and facts are checked with the
+// network id of fixtures.
+func checkValid(t *testing.T, i interface{}) {
+	t.Helper()
+
+	v, ok := i.(util.IsValider)
+	if !ok {
+		return
+	}
+
+	var b []byte
+	switch i.(type) {
+	case base.Operation, base.Fact:
+		b = networkID
+	}
+
+	if err := v.IsValid(b); err != nil {
+		t.Errorf("invalid %T: %+v", i, err)
+	}
+}
+
+// corpus returns the document in path. The document is written from fresh
+// only with -update, so the new hint adds its document by the run with
+// -update, and the missing document fails.
+func corpus(t *testing.T, path string, fresh []byte) []byte {
+	t.Helper()
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("failed to create corpus directory: %+v", err)
+		}
+
+		if err := os.WriteFile(path, fresh, 0o600); err != nil {
+			t.Fatalf("failed to write corpus, %q: %+v", path, err)
+		}
+
+		t.Logf("corpus written, %q", path)
+	}
+
+	b, err := os.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		t.Fatalf("corpus not found, %q; write it with -update", path)
+	case err != nil:
+		t.Fatalf("failed to read corpus, %q: %+v", path, err)
+	}
+
+	return b
+}
+
+func decode(t *testing.T, enc encoder.Encoder, b []byte, expected interface{}) interface{} {
+	t.Helper()
+
+	i, err := enc.Decode(b)
+	if err != nil {
+		t.Fatalf("failed to decode by %T: %+v", enc, err)
+	}
+
+	if reflect.TypeOf(i) != reflect.TypeOf(expected) {
+		t.Fatalf("decoded by %T to %T, not %T", enc, i, expected)
+	}
+
+	if ht := i.(hint.Hinter).Hint(); !ht.Equal(expected.(hint.Hinter).Hint()) {
+		t.Fatalf("decoded by %T with hint, %q, not %q", enc, ht, expected.(hint.Hinter).Hint())
+	}
+
+	return i
+}
+
+// TestCorpusCoverage checks every hint of node has its fixture with the
+// type, which the hint is decoded to.
+func TestCorpusCoverage(t *testing.T) {
+	types := map[string]reflect.Type{}
+
+	for _, f := range fixtures(t) {
+		types[f.hinter.Hint().String()] = reflect.TypeOf(f.hinter)
+	}
+
+	for _, f := range legacyFixtures() {
+		types[f.ht.String()] = reflect.TypeOf(f.instance)
+	}
+
+	for _, hinters := range [][]encoder.DecodeDetail{cmds.AddedHinters, cmds.AddedSupportedHinters} {
+		for _, d := range hinters {
+			ty, found := types[d.Hint.String()]
+			if !found {
+				t.Errorf("fixture of hint, %q not found", d.Hint)
+
+				continue
+			}
+
+			if it := reflect.TypeOf(d.Instance); it != ty {
+				t.Errorf("hint, %q is decoded to %v, but fixture is %v", d.Hint, it, ty)
+			}
+		}
+	}
+}
+
+// TestCorpus checks the corpus of every fixture:
+//   - json document is decoded and encoded again to the same document
+//   - json and bson documents are decoded to the same bytes, so the same
+//     hash, as before
+//   - fixture is encoded to the same json document and has the same hash; the
+//     fields of bson document are not ordered, so bson is compared by bytes
+func TestCorpus(t *testing.T) {
+	jenc, benc := newEncoders(t)
+
+	for _, f := range fixtures(t) {
+		f := f
+
+		t.Run(f.hinter.Hint().String(), func(t *testing.T) {
+			name := filepath.Join("testdata", f.hinter.Hint().String())
+
+			fj, err := jenc.Marshal(f.hinter)
+			if err != nil {
+				t.Fatalf("failed to marshal json: %+v", err)
+			}
+
+			fb, err := benc.Marshal(f.hinter)
+			if err != nil {
+				t.Fatalf("failed to marshal bson: %+v", err)
+			}
+
+			h := []byte(hashOf(t, f.hinter).String() + "\n")
+
+			cj := corpus(t, name+".json", fj)
+			cb := corpus(t, name+".bson", fb)
+			ch := strings.TrimSpace(string(corpus(t, name+".hash", h)))
+
+			dj := decode(t, jenc, cj, f.hinter)
+			checkValid(t, dj)
+
+			if s := hashOf(t, dj).String(); s != ch {
+				t.Errorf("hash of json changed, %q != %q", s, ch)
+			}
+
+			switch b, err := jenc.Marshal(dj); {
+			case err != nil:
+				t.Fatalf("failed to marshal decoded json: %+v", err)
+			case !bytes.Equal(b, cj):
+				t.Errorf("json changed:\n%s\n!=\n%s", b, cj)
+			}
+
+			db := decode(t, benc, cb, f.hinter)
+			checkValid(t, db)
+
+			if s := hashOf(t, db).String(); s != ch {
+				t.Errorf("hash of bson changed, %q != %q", s, ch)
+			}
+
+			if f.volatile {
+				return
+			}
+
+			if s := hashOf(t, f.hinter).String(); s != ch {
+				t.Errorf("hash of fixture changed, %q != %q", s, ch)
+			}
+
+			if _, ok := f.hinter.(base.Operation); !ok && !bytes.Equal(fj, cj) {
+				t.Errorf("json of fixture changed:\n%s\n!=\n%s", fj, cj)
+			}
+		})
+	}
+}
+
+// TestRoundTrip checks every fixture is decoded from its own json and bson
+// documents to the same bytes.
+func TestRoundTrip(t *testing.T) {
+	jenc, benc := newEncoders(t)
+
+	for _, f := range fixtures(t) {
+		f := f
+
+		t.Run(f.hinter.Hint().String(), func(t *testing.T) {
+			checkValid(t, f.hinter)
+
+			h := hashOf(t, f.hinter)
+
+			for _, enc := range []encoder.Encoder{jenc, benc} {
+				b, err := enc.Marshal(f.hinter)
+				if err != nil {
+					t.Fatalf("failed to marshal by %T: %+v", enc, err)
+				}
+
+				i := decode(t, enc, b, f.hinter)
+
+				if !hashOf(t, i).Equal(h) {
+					t.Errorf("decoded by %T to different bytes", enc)
+				}
+
+				switch c, err := enc.Marshal(i); {
+				case err != nil:
+					t.Fatalf("failed to marshal decoded by %T: %+v", enc, err)
+				case enc == encoder.Encoder(jenc) && !bytes.Equal(b, c):
+					t.Errorf("json changed by round trip:\n%s\n!=\n%s", c, b)
+				}
+			}
+		})
+	}
+}
+
+// TestLegacy checks the documents of legacy hints, written by hand, are still
+// decoded from json and bson to the bytes, which the hash of value was
+// generated from by the legacy hint, and encoded again to the same bytes.
+func TestLegacy(t *testing.T) {
+	jenc, benc := newEncoders(t)
+
+	for _, f := range legacyFixtures() {
+		f := f
+
+		t.Run(f.ht.String(), func(t *testing.T) {
+			path := filepath.Join("testdata", "legacy", f.ht.String()+".json")
+
+			cj, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read legacy document, %q: %+v", path, err)
+			}
+
+			var d bson.D
+			if err := bson.UnmarshalExtJSON(cj, false, &d); err != nil {
+				t.Fatalf("failed to convert legacy document to bson: %+v", err)
+			}
+
+			cb, err := bson.Marshal(d)
+			if err != nil {
+				t.Fatalf("failed to marshal legacy bson: %+v", err)
+			}
+
+			h := valuehash.NewSHA256(f.bytes)
+
+			for _, c := range []struct {
+				enc encoder.Encoder
+				b   []byte
+			}{{enc: jenc, b: cj}, {enc: benc, b: cb}} {
+				i := decodeLegacy(t, c.enc, c.b, f.instance)
+
+				if s := hashOf(t, i); !s.Equal(h) {
+					t.Errorf("legacy document decoded by %T to different hash, %q != %q", c.enc, s, h)
+				}
+
+				b, err := c.enc.Marshal(i)
+				if err != nil {
+					t.Fatalf("failed to marshal legacy decoded by %T: %+v", c.enc, err)
+				}
+
+				if s := hashOf(t, decodeLegacy(t, c.enc, b, f.instance)); !s.Equal(h) {
+					t.Errorf("legacy document encoded by %T to different hash, %q != %q", c.enc, s, h)
+				}
+			}
+		})
+	}
+}
+
+// decodeLegacy decodes the document of legacy hint to the type of instance;
+// the hint is not checked, because some legacy values are decoded with the
+// current hint.
+func decodeLegacy(t *testing.T, enc encoder.Encoder, b []byte, instance interface{}) interface{} {
+	t.Helper()
+
+	i, err := enc.Decode(b)
+	if err != nil {
+		t.Fatalf("failed to decode by %T: %+v", enc, err)
+	}
+
+	if reflect.TypeOf(i) != reflect.TypeOf(instance) {
+		t.Fatalf("decoded by %T to %T, not %T", enc, i, instance)
+	}
+
+	return i
+}
@@ -0,0 +1,8 @@
+/*
+Package invariant checks the supply of sto is conserved in the states: the
+tokenholder balances sum to the partition balances, the partition balances sum
+to the aggregate of sto policy, the tokenholder partitions are the partitions
+with balance, and the operators of tokenholders agree with the operator
+tokenholder pairs and their counts.
+*/
+package invariant
@@ -0,0 +1,112 @@
+package invariant_test
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// stepSize is the number of bytes of one step; kind, holder, other, sender,
+// partition and amount.
+const stepSize = 6
+
+// maxSteps limits the operations of one input.
+const maxSteps = 64
+
+var fuzzPartitions = []stotypes.Partition{"PTA", "PTB", "PTC"}
+
+// operation builds the operation of step. Every operation is about the
+// holder; other is the receiver or the operator, and sender sends the
+// transfer and redeem. The operations failed by reason are also processed,
+// because the failed operation must not change states.
+func (f *fixture) operation(step []byte) (base.Operation, error) {
+	holder := f.holders[int(step[1])%len(f.holders)]
+	other := f.holders[int(step[2])%len(f.holders)]
+	partition := fuzzPartitions[int(step[4])%len(fuzzPartitions)]
+	amount := common.NewBig(int64(step[5]%128) + 1)
+
+	sender := f.controller
+	if i := int(step[3]) % (len(f.holders) + 1); i < len(f.holders) {
+		sender = f.holders[i]
+	}
+
+	switch step[0] % 7 {
+	case 0:
+		return f.controller.Builder().IssueSecurityTokens(
+			f.controller.Address,
+			sto.NewIssueSecurityTokensItem(f.contract, f.stoID, holder.Address, amount, partition, f.currency),
+		)
+	case 1:
+		return sender.Builder().TransferSecurityTokensPartition(
+			sender.Address,
+			sto.NewTransferSecurityTokensPartitionItem(
+				f.contract, f.stoID, holder.Address, other.Address, partition, amount, f.currency),
+		)
+	case 2:
+		return sender.Builder().RedeemTokens(
+			sender.Address,
+			sto.NewRedeemTokensItem(f.contract, f.stoID, holder.Address, amount, partition, f.currency),
+		)
+	case 3:
+		return holder.Builder().AuthorizeOperators(
+			holder.Address,
+			sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, other.Address, partition, common.NilBig, 0, f.currency),
+		)
+	case 4:
+		return holder.Builder().RevokeOperators(
+			holder.Address,
+			sto.NewRevokeOperatorsItem(f.contract, f.stoID, other.Address, partition, f.currency),
+		)
+	case 5:
+		return holder.Builder().AuthorizeGlobalOperators(
+			holder.Address,
+			sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, other.Address, f.currency),
+		)
+	default:
+		return holder.Builder().RevokeGlobalOperators(
+			holder.Address,
+			sto.NewRevokeGlobalOperatorsItem(f.contract, f.stoID, other.Address, f.currency),
+		)
+	}
+}
+
+// FuzzCheck processes the random sequences of issue, transfer, redeem,
+// authorize and revoke, and checks the invariants after every operation.
+func FuzzCheck(f *testing.F) {
+	for _, seed := range [][]byte{
+		// issue, transfer by holder, redeem by controller
+		{0, 0, 0, 0, 0, 99, 1, 0, 1, 0, 0, 49, 2, 1, 0, 3, 0, 9},
+		// issue, authorize operator, transfer by operator, revoke
+		{0, 0, 0, 0, 0, 99, 3, 0, 1, 0, 0, 0, 1, 0, 2, 1, 0, 99, 4, 0, 1, 0, 0, 0},
+		// issue, authorize global operator, redeem all by operator, issue again
+		{0, 0, 0, 0, 1, 9, 5, 0, 2, 0, 0, 0, 0, 1, 0, 0, 0, 9, 2, 0, 0, 2, 1, 9, 0, 2, 0, 0, 1, 4},
+		// new partition, transfer all, authorize and revoke global operators
+		{0, 1, 0, 0, 2, 19, 1, 1, 2, 1, 2, 19, 5, 2, 0, 0, 0, 0, 6, 2, 0, 0, 0, 0, 6, 2, 0, 0, 0, 0},
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		fx := newFixture(t)
+
+		for i := 0; i < maxSteps && len(b) >= stepSize; i++ {
+			step := b[:stepSize]
+			b = b[stepSize:]
+
+			op, err := fx.operation(step)
+			if err != nil {
+				// NOTE invalid operation, like transfer to tokenholder itself
+				continue
+			}
+
+			reason := fx.Process(op)
+
+			if vs := fx.check(); len(vs) > 0 {
+				t.Fatalf("violations after step %d, %v, %T, reason=%v: %v", i, step, op, reason, vs)
+			}
+		}
+	})
+}
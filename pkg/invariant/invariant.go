@@ -0,0 +1,543 @@
+package invariant
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// Violation is the broken invariant found at the state of key; the state may
+// not exist.
+type Violation struct {
+	Key     string `json:"key"`
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Key, v.Message)
+}
+
+// Check checks the invariants of every sto in sts, the last states of sto, and
+// returns the violations sorted by key. The migrated and migrating sto are not
+// checked; the migrated states are checked in the new contract account. The former operator
+// tokenholders lists stand for the operator tokenholder pairs and counts,
+// which are not written yet.
+func Check(sts []base.State) ([]Violation, error) {
+	stos := map[string]*stoStates{}
+
+	for i := range sts {
+		st := sts[i]
+
+		if !stostate.IsStateDesignKey(st.Key()) {
+			continue
+		}
+
+		switch st.Value().(type) {
+		case stostate.MigratedStateValue, stostate.MigratingStateValue:
+			continue
+		}
+
+		design, err := stostate.StateDesignValue(st)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := strings.TrimSuffix(st.Key(), stostate.DesignSuffix)
+		stos[prefix] = newSTOStates(prefix, design.Policy())
+	}
+
+	for i := range sts {
+		prefix, parts, suffix, ok := splitKey(sts[i].Key())
+		if !ok {
+			continue
+		}
+
+		s, found := stos[prefix]
+		if !found {
+			continue
+		}
+
+		if err := s.add(parts, suffix, sts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var vs []Violation
+
+	for _, s := range stos {
+		vs = append(vs, s.check()...)
+	}
+
+	sort.Slice(vs, func(i, j int) bool {
+		if vs[i].Key != vs[j].Key {
+			return vs[i].Key < vs[j].Key
+		}
+
+		return vs[i].Message < vs[j].Message
+	})
+
+	return vs, nil
+}
+
+// splitKey splits the sto state key, "sto:<contract>-<sto id>-<parts>:<suffix>",
+// into the sto prefix, parts and suffix. Addresses, sto ids and partitions do
+// not have "-" and ":".
+func splitKey(key string) (prefix string, parts []string, suffix string, ok bool) {
+	if !strings.HasPrefix(key, stostate.STOPrefix) {
+		return "", nil, "", false
+	}
+
+	rest := key[len(stostate.STOPrefix):]
+
+	i := strings.Index(rest, ":")
+	if i < 0 {
+		return "", nil, "", false
+	}
+
+	fields := strings.Split(rest[:i], "-")
+	if len(fields) < 3 {
+		return "", nil, "", false
+	}
+
+	return stostate.STOPrefix + fields[0] + "-" + fields[1], fields[2:], rest[i:], true
+}
+
+func joinKey(prefix, suffix string, parts ...string) string {
+	return prefix + "-" + strings.Join(parts, "-") + suffix
+}
+
+// stoStates keeps the states of sto by tokenholder, partition and operator.
+type stoStates struct {
+	prefix            string
+	policy            stotypes.Policy
+	partitionBalances map[string]common.Big
+	balances          map[string]map[string]common.Big
+	holderPartitions  map[string][]string
+	operators         *operatorIndexes
+	globalOperators   *operatorIndexes
+	malformed         []string
+}
+
+func newSTOStates(prefix string, policy stotypes.Policy) *stoStates {
+	return &stoStates{
+		prefix:            prefix,
+		policy:            policy,
+		partitionBalances: map[string]common.Big{},
+		balances:          map[string]map[string]common.Big{},
+		holderPartitions:  map[string][]string{},
+		operators: newOperatorIndexes(
+			func(r relation) string {
+				return joinKey(prefix, stostate.TokenHolderPartitionOperatorsSuffix, r.holder, r.partition)
+			},
+			func(r relation) string {
+				return joinKey(prefix, stostate.OperatorTokenHolderSuffix, r.operator, r.partition, r.holder)
+			},
+			func(r relation) string {
+				return joinKey(prefix, stostate.OperatorTokenHoldersCountSuffix, r.operator, r.partition)
+			},
+		),
+		globalOperators: newOperatorIndexes(
+			func(r relation) string {
+				return joinKey(prefix, stostate.TokenHolderOperatorsSuffix, r.holder)
+			},
+			func(r relation) string {
+				return joinKey(prefix, stostate.GlobalOperatorTokenHolderSuffix, r.operator, r.holder)
+			},
+			func(r relation) string {
+				return joinKey(prefix, stostate.GlobalOperatorTokenHoldersCountSuffix, r.operator)
+			},
+		),
+	}
+}
+
+func (s *stoStates) add(parts []string, suffix string, st base.State) error {
+	expected := map[string]int{
+		stostate.PartitionBalanceSuffix:                1,
+		stostate.TokenHolderPartitionBalanceSuffix:     2,
+		stostate.TokenHolderPartitionsSuffix:           1,
+		stostate.TokenHolderPartitionOperatorsSuffix:   2,
+		stostate.OperatorTokenHoldersSuffix:            2,
+		stostate.OperatorTokenHolderSuffix:             3,
+		stostate.OperatorTokenHoldersCountSuffix:       2,
+		stostate.TokenHolderOperatorsSuffix:            1,
+		stostate.GlobalOperatorTokenHolderSuffix:       2,
+		stostate.GlobalOperatorTokenHoldersCountSuffix: 1,
+	}
+
+	switch n, found := expected[suffix]; {
+	case !found:
+		return nil
+	case n != len(parts):
+		s.malformed = append(s.malformed, st.Key())
+
+		return nil
+	}
+
+	switch suffix {
+	case stostate.PartitionBalanceSuffix:
+		b, err := stostate.StatePartitionBalanceValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.partitionBalances[parts[0]] = b
+	case stostate.TokenHolderPartitionBalanceSuffix:
+		b, err := stostate.StateTokenHolderPartitionBalanceValue(st)
+		if err != nil {
+			return err
+		}
+
+		if _, found := s.balances[parts[0]]; !found {
+			s.balances[parts[0]] = map[string]common.Big{}
+		}
+
+		s.balances[parts[0]][parts[1]] = b
+	case stostate.TokenHolderPartitionsSuffix:
+		ps, err := stostate.StateTokenHolderPartitionsValue(st)
+		if err != nil {
+			return err
+		}
+
+		l := make([]string, len(ps))
+		for i := range ps {
+			l[i] = ps[i].String()
+		}
+
+		s.holderPartitions[parts[0]] = l
+	case stostate.TokenHolderPartitionOperatorsSuffix:
+		ops, err := stostate.StateTokenHolderPartitionOperatorsValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.operators.addOperators(relation{holder: parts[0], partition: parts[1]}, ops)
+	case stostate.OperatorTokenHoldersSuffix:
+		holders, err := stostate.StateOperatorTokenHoldersValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.operators.addLegacy(relation{operator: parts[0], partition: parts[1]}, holders)
+	case stostate.OperatorTokenHolderSuffix:
+		authorized, err := stostate.StateOperatorTokenHolderValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.operators.pairs[relation{operator: parts[0], partition: parts[1], holder: parts[2]}] = authorized
+	case stostate.OperatorTokenHoldersCountSuffix:
+		count, err := stostate.StateOperatorTokenHoldersCountValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.operators.counts[relation{operator: parts[0], partition: parts[1]}] = count
+	case stostate.TokenHolderOperatorsSuffix:
+		ops, err := stostate.StateTokenHolderOperatorsValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.globalOperators.addOperators(relation{holder: parts[0]}, ops)
+	case stostate.GlobalOperatorTokenHolderSuffix:
+		authorized, err := stostate.StateOperatorTokenHolderValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.globalOperators.pairs[relation{operator: parts[0], holder: parts[1]}] = authorized
+	case stostate.GlobalOperatorTokenHoldersCountSuffix:
+		count, err := stostate.StateOperatorTokenHoldersCountValue(st)
+		if err != nil {
+			return err
+		}
+
+		s.globalOperators.counts[relation{operator: parts[0]}] = count
+	}
+
+	return nil
+}
+
+func (s *stoStates) check() []Violation {
+	vs := make([]Violation, 0, len(s.malformed))
+
+	for _, k := range s.malformed {
+		vs = append(vs, Violation{Key: k, Message: "malformed state key"})
+	}
+
+	vs = append(vs, s.checkPartitions()...)
+	vs = append(vs, s.checkAggregate()...)
+	vs = append(vs, s.checkHolderPartitions()...)
+	vs = append(vs, s.operators.check()...)
+	vs = append(vs, s.globalOperators.check()...)
+
+	return vs
+}
+
+// checkPartitions checks the tokenholder balances of each partition sum to
+// the partition balance.
+func (s *stoStates) checkPartitions() []Violation {
+	sums := map[string]common.Big{}
+
+	for p := range s.partitionBalances {
+		sums[p] = common.ZeroBig
+	}
+
+	for _, p := range s.policy.Partitions() {
+		sums[p.String()] = common.ZeroBig
+	}
+
+	for _, bs := range s.balances {
+		for p, b := range bs {
+			if sum, found := sums[p]; found {
+				sums[p] = sum.Add(b)
+			} else {
+				sums[p] = b
+			}
+		}
+	}
+
+	var vs []Violation
+
+	for p, sum := range sums {
+		balance, found := s.partitionBalances[p]
+		if !found {
+			balance = common.ZeroBig
+		}
+
+		if !sum.Equal(balance) {
+			vs = append(vs, Violation{
+				Key:     joinKey(s.prefix, stostate.PartitionBalanceSuffix, p),
+				Message: fmt.Sprintf("sum of tokenholder balances, %s != partition balance, %s", sum, balance),
+			})
+		}
+	}
+
+	return vs
+}
+
+// checkAggregate checks the balances of the partitions in policy sum to the
+// aggregate and no other partition has balance.
+func (s *stoStates) checkAggregate() []Violation {
+	var vs []Violation
+
+	inPolicy := map[string]struct{}{}
+	sum := common.ZeroBig
+
+	for _, p := range s.policy.Partitions() {
+		if _, found := inPolicy[p.String()]; found {
+			vs = append(vs, Violation{
+				Key:     s.prefix + stostate.DesignSuffix,
+				Message: fmt.Sprintf("duplicated partition in policy, %q", p),
+			})
+
+			continue
+		}
+
+		inPolicy[p.String()] = struct{}{}
+
+		if b, found := s.partitionBalances[p.String()]; found {
+			sum = sum.Add(b)
+		}
+	}
+
+	if aggregate := s.policy.Aggregate(); !sum.Equal(aggregate) {
+		vs = append(vs, Violation{
+			Key:     s.prefix + stostate.DesignSuffix,
+			Message: fmt.Sprintf("sum of partition balances, %s != aggregate, %s", sum, aggregate),
+		})
+	}
+
+	for p, b := range s.partitionBalances {
+		if _, found := inPolicy[p]; !found && b.OverZero() {
+			vs = append(vs, Violation{
+				Key:     joinKey(s.prefix, stostate.PartitionBalanceSuffix, p),
+				Message: fmt.Sprintf("partition not in policy has balance, %s", b),
+			})
+		}
+	}
+
+	return vs
+}
+
+// checkHolderPartitions checks the tokenholder partitions are exactly the
+// partitions, which tokenholder has balance of.
+func (s *stoStates) checkHolderPartitions() []Violation {
+	holders := map[string]struct{}{}
+
+	for h := range s.holderPartitions {
+		holders[h] = struct{}{}
+	}
+
+	for h := range s.balances {
+		holders[h] = struct{}{}
+	}
+
+	var vs []Violation
+
+	for h := range holders {
+		k := joinKey(s.prefix, stostate.TokenHolderPartitionsSuffix, h)
+
+		listed := map[string]struct{}{}
+
+		for _, p := range s.holderPartitions[h] {
+			if _, found := listed[p]; found {
+				vs = append(vs, Violation{Key: k, Message: fmt.Sprintf("duplicated partition, %q", p)})
+			}
+
+			listed[p] = struct{}{}
+
+			if b, found := s.balances[h][p]; !found || !b.OverZero() {
+				vs = append(vs, Violation{Key: k, Message: fmt.Sprintf("partition without balance, %q", p)})
+			}
+		}
+
+		for p, b := range s.balances[h] {
+			if _, found := listed[p]; !found && b.OverZero() {
+				vs = append(vs, Violation{Key: k, Message: fmt.Sprintf("partition with balance, %q not listed", p)})
+			}
+		}
+	}
+
+	return vs
+}
+
+// relation is the operator of tokenholder in partition; partition is empty for
+// global operators. The relation without operator is the tokenholder
+// operators, and the relation without tokenholder is the operator count.
+type relation struct {
+	holder    string
+	partition string
+	operator  string
+}
+
+// operatorIndexes keeps the operators of tokenholders and its reverse
+// indexes, the authorized operator tokenholder pairs and their counts by
+// operator, and the former operator tokenholders lists.
+type operatorIndexes struct {
+	operators map[relation][]string
+	pairs     map[relation]bool
+	counts    map[relation]uint64
+	legacy    map[relation][]string
+	listKey   func(relation) string
+	pairKey   func(relation) string
+	countKey  func(relation) string
+}
+
+func newOperatorIndexes(listKey, pairKey, countKey func(relation) string) *operatorIndexes {
+	return &operatorIndexes{
+		operators: map[relation][]string{},
+		pairs:     map[relation]bool{},
+		counts:    map[relation]uint64{},
+		legacy:    map[relation][]string{},
+		listKey:   listKey,
+		pairKey:   pairKey,
+		countKey:  countKey,
+	}
+}
+
+func (o *operatorIndexes) addOperators(r relation, ops []base.Address) {
+	l := make([]string, len(ops))
+	for i := range ops {
+		l[i] = ops[i].String()
+	}
+
+	o.operators[r] = l
+}
+
+func (o *operatorIndexes) addLegacy(r relation, holders []base.Address) {
+	l := make([]string, len(holders))
+	for i := range holders {
+		l[i] = holders[i].String()
+	}
+
+	o.legacy[r] = l
+}
+
+// fillLegacy sets the pairs and counts, which are not written yet, by the
+// former operator tokenholders lists, like the operation processors read them.
+func (o *operatorIndexes) fillLegacy() {
+	for r, holders := range o.legacy {
+		if _, found := o.counts[r]; !found {
+			o.counts[r] = uint64(len(holders))
+		}
+
+		for _, h := range holders {
+			pr := relation{holder: h, partition: r.partition, operator: r.operator}
+			if _, found := o.pairs[pr]; !found {
+				o.pairs[pr] = true
+			}
+		}
+	}
+}
+
+func (o *operatorIndexes) check() []Violation {
+	o.fillLegacy()
+
+	var vs []Violation
+
+	for r, ops := range o.operators {
+		k := o.listKey(r)
+
+		listed := map[string]struct{}{}
+
+		for _, op := range ops {
+			if _, found := listed[op]; found {
+				vs = append(vs, Violation{Key: k, Message: fmt.Sprintf("duplicated operator, %q", op)})
+			}
+
+			listed[op] = struct{}{}
+
+			pr := relation{holder: r.holder, partition: r.partition, operator: op}
+			if !o.pairs[pr] {
+				vs = append(vs, Violation{Key: o.pairKey(pr), Message: "operator in tokenholder operators not authorized"})
+			}
+		}
+	}
+
+	counted := map[relation]uint64{}
+
+	for r, authorized := range o.pairs {
+		if !authorized {
+			continue
+		}
+
+		counted[relation{partition: r.partition, operator: r.operator}]++
+
+		found := false
+
+		for _, op := range o.operators[relation{holder: r.holder, partition: r.partition}] {
+			if op == r.operator {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			vs = append(vs, Violation{Key: o.pairKey(r), Message: "authorized operator not in tokenholder operators"})
+		}
+	}
+
+	for r := range o.counts {
+		if _, found := counted[r]; !found {
+			counted[r] = 0
+		}
+	}
+
+	for r, n := range counted {
+		if count := o.counts[r]; count != n {
+			vs = append(vs, Violation{
+				Key:     o.countKey(r),
+				Message: fmt.Sprintf("operator tokenholders count, %d != authorized pairs, %d", count, n),
+			})
+		}
+	}
+
+	return vs
+}
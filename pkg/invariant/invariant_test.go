@@ -0,0 +1,267 @@
+package invariant_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/sto"
+	"github.com/ProtoconNet/mitum-sto/operation/test"
+	"github.com/ProtoconNet/mitum-sto/pkg/invariant"
+	stostate "github.com/ProtoconNet/mitum-sto/state/sto"
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+// fixture is the sto, stoID of contract with the partitions, PTA and PTB,
+// and the holders, which are also the operators of each other. The currency
+// has no fee, so the operations never fail by the balance.
+type fixture struct {
+	*test.States
+	t          *testing.T
+	currency   currencytypes.CurrencyID
+	controller test.Account
+	holders    []test.Account
+	contract   base.Address
+	stoID      currencytypes.ContractID
+}
+
+func newFixture(t *testing.T) *fixture {
+	s := test.NewStates(t)
+
+	f := &fixture{
+		States:   s,
+		t:        t,
+		currency: currencytypes.CurrencyID("MCC"),
+		stoID:    currencytypes.ContractID("STO"),
+	}
+
+	am := currencytypes.NewAmount(common.NewBig(1000), f.currency)
+
+	f.controller = s.NewAccount(am)
+	f.holders = []test.Account{s.NewAccount(am), s.NewAccount(am), s.NewAccount(am)}
+	f.contract = s.NewContractAccount(f.controller.Address)
+
+	s.SetCurrency(f.currency, f.controller.Address, common.ZeroBig)
+	s.SetSTO(f.contract, f.design([]stotypes.Partition{"PTA", "PTB"}, common.ZeroBig))
+
+	return f
+}
+
+func (f *fixture) design(partitions []stotypes.Partition, aggregate common.Big) stotypes.Design {
+	return stotypes.NewDesign(
+		f.stoID,
+		1,
+		stotypes.NewPolicy(
+			partitions,
+			aggregate,
+			[]base.Address{f.controller.Address},
+			[]stotypes.Document{},
+			stotypes.EmptyKYCRequirement(),
+		),
+		stotypes.EmptyJurisdictionRule(),
+	)
+}
+
+func (f *fixture) check() []invariant.Violation {
+	f.t.Helper()
+
+	vs, err := invariant.Check(f.All())
+	if err != nil {
+		f.t.Fatalf("failed to check: %+v", err)
+	}
+
+	return vs
+}
+
+func (f *fixture) mustProcess(op base.Operation, err error) {
+	f.t.Helper()
+
+	if err != nil {
+		f.t.Fatalf("failed to build operation: %+v", err)
+	}
+
+	if reason := f.Process(op); reason != nil {
+		f.t.Fatalf("failed to process operation, %T: %v", op, reason)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	prepare := func(f *fixture) {
+		h0, h1, h2 := f.holders[0], f.holders[1], f.holders[2]
+
+		f.mustProcess(f.controller.Builder().IssueSecurityTokens(
+			f.controller.Address,
+			sto.NewIssueSecurityTokensItem(f.contract, f.stoID, h0.Address, common.NewBig(100), "PTA", f.currency),
+		))
+		f.mustProcess(f.controller.Builder().IssueSecurityTokens(
+			f.controller.Address,
+			sto.NewIssueSecurityTokensItem(f.contract, f.stoID, h1.Address, common.NewBig(50), "PTB", f.currency),
+		))
+		f.mustProcess(h0.Builder().AuthorizeOperators(
+			h0.Address,
+			sto.NewAuthorizeOperatorsItem(f.contract, f.stoID, h1.Address, "PTA", common.NilBig, 0, f.currency),
+		))
+		f.mustProcess(h0.Builder().AuthorizeGlobalOperators(
+			h0.Address,
+			sto.NewAuthorizeGlobalOperatorsItem(f.contract, f.stoID, h2.Address, f.currency),
+		))
+	}
+
+	cases := []struct {
+		name    string
+		corrupt func(*fixture)
+		key     func(*fixture) string
+		message string
+	}{
+		{
+			name: "tokenholder balance",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyTokenHolderPartitionBalance(f.contract, f.stoID, f.holders[0].Address, "PTA"),
+					stostate.NewTokenHolderPartitionBalanceStateValue(common.NewBig(90), "PTA"),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyPartitionBalance(f.contract, f.stoID, "PTA")
+			},
+			message: "sum of tokenholder balances, 90 != partition balance, 100",
+		},
+		{
+			name: "aggregate",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyDesign(f.contract, f.stoID),
+					stostate.NewDesignStateValue(f.design([]stotypes.Partition{"PTA", "PTB"}, common.NewBig(10))),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyDesign(f.contract, f.stoID)
+			},
+			message: "sum of partition balances, 150 != aggregate, 10",
+		},
+		{
+			name: "partition not in policy",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyDesign(f.contract, f.stoID),
+					stostate.NewDesignStateValue(f.design([]stotypes.Partition{"PTA"}, common.NewBig(100))),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyPartitionBalance(f.contract, f.stoID, "PTB")
+			},
+			message: "partition not in policy has balance, 50",
+		},
+		{
+			name: "partition with balance not listed",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyTokenHolderPartitions(f.contract, f.stoID, f.holders[0].Address),
+					stostate.NewTokenHolderPartitionsStateValue([]stotypes.Partition{}),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyTokenHolderPartitions(f.contract, f.stoID, f.holders[0].Address)
+			},
+			message: `partition with balance, "PTA" not listed`,
+		},
+		{
+			name: "partition without balance",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyTokenHolderPartitions(f.contract, f.stoID, f.holders[1].Address),
+					stostate.NewTokenHolderPartitionsStateValue([]stotypes.Partition{"PTB", "PTA"}),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyTokenHolderPartitions(f.contract, f.stoID, f.holders[1].Address)
+			},
+			message: `partition without balance, "PTA"`,
+		},
+		{
+			name: "operator not authorized",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyOperatorTokenHolder(f.contract, f.stoID, f.holders[1].Address, "PTA", f.holders[0].Address),
+					stostate.NewOperatorTokenHolderStateValue(false),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyOperatorTokenHolder(f.contract, f.stoID, f.holders[1].Address, "PTA", f.holders[0].Address)
+			},
+			message: "operator in tokenholder operators not authorized",
+		},
+		{
+			name: "operator tokenholders count",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyOperatorTokenHoldersCount(f.contract, f.stoID, f.holders[1].Address, "PTA"),
+					stostate.NewOperatorTokenHoldersCountStateValue(2),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyOperatorTokenHoldersCount(f.contract, f.stoID, f.holders[1].Address, "PTA")
+			},
+			message: "operator tokenholders count, 2 != authorized pairs, 1",
+		},
+		{
+			name: "legacy operator tokenholders",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyOperatorTokenHolders(f.contract, f.stoID, f.holders[1].Address, "PTA"),
+					stostate.NewOperatorTokenHoldersStateValue([]base.Address{f.holders[0].Address, f.holders[2].Address}),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyOperatorTokenHolder(f.contract, f.stoID, f.holders[1].Address, "PTA", f.holders[2].Address)
+			},
+			message: "authorized operator not in tokenholder operators",
+		},
+		{
+			name: "global operator not in tokenholder operators",
+			corrupt: func(f *fixture) {
+				f.Set(
+					stostate.StateKeyTokenHolderOperators(f.contract, f.stoID, f.holders[0].Address),
+					stostate.NewTokenHolderOperatorsStateValue([]base.Address{}),
+				)
+			},
+			key: func(f *fixture) string {
+				return stostate.StateKeyGlobalOperatorTokenHolder(f.contract, f.stoID, f.holders[2].Address, f.holders[0].Address)
+			},
+			message: "authorized operator not in tokenholder operators",
+		},
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		f := newFixture(t)
+		prepare(f)
+
+		if vs := f.check(); len(vs) > 0 {
+			t.Fatalf("unexpected violations: %v", vs)
+		}
+	})
+
+	for i := range cases {
+		c := cases[i]
+
+		t.Run(c.name, func(t *testing.T) {
+			f := newFixture(t)
+			prepare(f)
+			c.corrupt(f)
+
+			vs := f.check()
+
+			k := c.key(f)
+
+			for _, v := range vs {
+				if v.Key == k && strings.Contains(v.Message, c.message) {
+					return
+				}
+			}
+
+			t.Fatalf("expected violation, %q at %q, but %v", c.message, k, vs)
+		})
+	}
+}
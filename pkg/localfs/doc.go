@@ -0,0 +1,5 @@
+/*
+Package localfs reads the states of the blocks in local fs storage of node,
+without running consensus or digest database.
+*/
+package localfs
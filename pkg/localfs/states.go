@@ -0,0 +1,76 @@
+package localfs
+
+import (
+	"os"
+	"sort"
+
+	"github.com/ProtoconNet/mitum2/base"
+	isaacblock "github.com/ProtoconNet/mitum2/isaac/block"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+// LoadStates reads the blocks of local fs storage from genesis to height
+// and returns the last states of keys filtered by filter, sorted by key. If
+// height is base.NilHeight, every stored block is read. The height of the last
+// read block is also returned.
+func LoadStates(
+	root string,
+	enc encoder.Encoder,
+	height base.Height,
+	filter func(string) bool,
+) ([]base.State, base.Height, error) {
+	last := base.NilHeight
+	m := map[string]base.State{}
+
+	for i := base.GenesisHeight; height == base.NilHeight || i <= height; i++ {
+		reader, err := isaacblock.NewLocalFSReaderFromHeight(root, i, enc)
+		switch {
+		case err == nil:
+		case errors.Is(err, os.ErrNotExist) && height == base.NilHeight:
+			return sortedStates(m), last, nil
+		default:
+			return nil, last, err
+		}
+
+		switch _, found, err := reader.BlockMap(); {
+		case err != nil:
+			return nil, last, err
+		case !found:
+			return nil, last, errors.Errorf("blockmap not found, %d", i)
+		}
+
+		switch v, found, err := reader.Item(base.BlockMapItemTypeStates); {
+		case err != nil:
+			return nil, last, err
+		case found:
+			sts := v.([]base.State) //nolint:forcetypeassert //...
+
+			for j := range sts {
+				if filter(sts[j].Key()) {
+					m[sts[j].Key()] = sts[j]
+				}
+			}
+		}
+
+		last = i
+	}
+
+	return sortedStates(m), last, nil
+}
+
+func sortedStates(m map[string]base.State) []base.State {
+	sts := make([]base.State, len(m))
+
+	var i int
+	for k := range m {
+		sts[i] = m[k]
+		i++
+	}
+
+	sort.Slice(sts, func(i, j int) bool {
+		return sts[i].Key() < sts[j].Key()
+	})
+
+	return sts
+}
@@ -0,0 +1,6 @@
+/*
+Package simulate runs the processors of sto and kyc operations against the
+current states, so the resulting states, fees and the reason of failure are
+known before the operation is sent to the network.
+*/
+package simulate
@@ -0,0 +1,79 @@
+package simulate
+
+import (
+	"io"
+	"net/http"
+
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+var HandlerPathSimulate = `/sto/simulate`
+
+// Handler simulates the operation of request body against the current states
+// of node, as the operation of next block. The operation is not broadcasted.
+type Handler struct {
+	enc          encoder.Encoder
+	simulator    *Simulator
+	lastHeight   func() (base.Height, error)
+	getStateFunc base.GetStateFunc
+}
+
+func NewHandler(
+	enc encoder.Encoder,
+	simulator *Simulator,
+	lastHeight func() (base.Height, error),
+	getStateFunc base.GetStateFunc,
+) *Handler {
+	return &Handler{
+		enc:          enc,
+		simulator:    simulator,
+		lastHeight:   lastHeight,
+		getStateFunc: getStateFunc,
+	}
+}
+
+func (hd *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, errors.WithStack(err), http.StatusBadRequest)
+
+		return
+	}
+
+	var op base.Operation
+	if err := encoder.Decode(hd.enc, body, &op); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+
+		return
+	} else if op == nil {
+		currencydigest.HTTP2ProblemWithError(w, errors.Errorf("empty operation"), http.StatusBadRequest)
+
+		return
+	}
+
+	height, err := hd.lastHeight()
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	result, err := hd.simulator.Simulate(r.Context(), op, height+1, hd.getStateFunc)
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	b, err := hd.enc.Marshal(currencydigest.NewBaseHal(result, currencydigest.NewHalLink(HandlerPathSimulate, nil)))
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+
+		return
+	}
+
+	currencydigest.HTTP2WriteHalBytes(hd.enc, w, b, http.StatusOK)
+}
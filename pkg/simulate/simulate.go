@@ -0,0 +1,171 @@
+package simulate
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-sto/operation/processor"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+type StateValue struct {
+	Key   string          `json:"key"`
+	Value base.StateValue `json:"value"`
+}
+
+// Fee is the amount taken from the balance of key by operation.
+type Fee struct {
+	Key      string                   `json:"key"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+	Amount   common.Big               `json:"amount"`
+}
+
+// Result is the outcome of operation at height. Reason is the error which
+// makes the operation fail in block; with reason, States and Fees are empty.
+type Result struct {
+	Operation util.Hash    `json:"operation"`
+	Fact      util.Hash    `json:"fact"`
+	Height    base.Height  `json:"height"`
+	States    []StateValue `json:"states"`
+	Fees      []Fee        `json:"fees"`
+	Reason    string       `json:"reason,omitempty"`
+}
+
+// Simulator runs the processor of sto and kyc operation against the states of
+// getStateFunc, without storing anything.
+type Simulator struct {
+	networkID  base.NetworkID
+	processors map[hint.Type]currencytypes.GetNewProcessor
+}
+
+func NewSimulator(networkID base.NetworkID) *Simulator {
+	ps := processor.STOProcessors()
+
+	processors := make(map[hint.Type]currencytypes.GetNewProcessor, len(ps))
+	for i := range ps {
+		processors[ps[i].Hint.Type()] = ps[i].Processor
+	}
+
+	return &Simulator{
+		networkID:  networkID,
+		processors: processors,
+	}
+}
+
+// Simulate runs PreProcess and Process of op as the operation of block at
+// height. The signatures of op are checked only when op is signed, so unsigned
+// op fails in PreProcess when the processor checks the signs.
+func (s *Simulator) Simulate(
+	ctx context.Context,
+	op base.Operation,
+	height base.Height,
+	getStateFunc base.GetStateFunc,
+) (Result, error) {
+	f, found := s.processors[op.Hint().Type()]
+	if !found {
+		return Result{}, errors.Errorf("operation not supported, %q", op.Hint())
+	}
+
+	r := Result{
+		Operation: op.Hash(),
+		Fact:      op.Fact().Hash(),
+		Height:    height,
+	}
+
+	if len(op.Signs()) > 0 {
+		if err := op.IsValid(s.networkID); err != nil {
+			r.Reason = err.Error()
+
+			return r, nil
+		}
+	}
+
+	opp, err := f(height, getStateFunc, nil, nil)
+	if err != nil {
+		return Result{}, err
+	}
+
+	defer func() {
+		_ = opp.Close()
+	}()
+
+	ctx, reason, err := opp.PreProcess(ctx, op, getStateFunc)
+
+	switch {
+	case err != nil:
+		return Result{}, err
+	case reason != nil:
+		r.Reason = reason.Error()
+
+		return r, nil
+	}
+
+	mvs, reason, err := opp.Process(ctx, op, getStateFunc)
+
+	switch {
+	case err != nil:
+		return Result{}, err
+	case reason != nil:
+		r.Reason = reason.Error()
+
+		return r, nil
+	}
+
+	r.States = make([]StateValue, len(mvs))
+
+	for i := range mvs {
+		r.States[i] = StateValue{Key: mvs[i].Key(), Value: mvs[i].Value()}
+
+		fee, found, err := balanceDecrease(mvs[i], getStateFunc)
+		if err != nil {
+			return Result{}, err
+		}
+
+		if found {
+			r.Fees = append(r.Fees, fee)
+		}
+	}
+
+	sort.Slice(r.Fees, func(i, j int) bool {
+		return r.Fees[i].Key < r.Fees[j].Key
+	})
+
+	return r, nil
+}
+
+func balanceDecrease(mv base.StateMergeValue, getStateFunc base.GetStateFunc) (Fee, bool, error) {
+	if !currencystate.IsStateBalanceKey(mv.Key()) {
+		return Fee{}, false, nil
+	}
+
+	v, ok := mv.Value().(currencystate.BalanceStateValue)
+	if !ok {
+		return Fee{}, false, nil
+	}
+
+	st, found, err := getStateFunc(mv.Key())
+	switch {
+	case err != nil:
+		return Fee{}, false, err
+	case !found:
+		return Fee{}, false, nil
+	}
+
+	before, err := currencystate.StateBalanceValue(st)
+	if err != nil {
+		return Fee{}, false, err
+	}
+
+	d := before.Big().Sub(v.Amount.Big())
+	if !d.OverZero() {
+		return Fee{}, false, nil
+	}
+
+	return Fee{Key: mv.Key(), Currency: v.Amount.Currency(), Amount: d}, true, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	"github.com/ProtoconNet/mitum2/base"
@@ -64,6 +65,10 @@ func StateDesignValue(st base.State) (kyctypes.Design, error) {
 		return kyctypes.Design{}, util.ErrNotFound.Errorf("kyc design not found in State")
 	}
 
+	if m, ok := v.(MigratedStateValue); ok {
+		return kyctypes.Design{}, errors.Errorf("kyc service migrated to %q", m.Contract)
+	}
+
 	d, ok := v.(DesignStateValue)
 	if !ok {
 		return kyctypes.Design{}, errors.Errorf("invalid kyc design value found, %T", v)
@@ -81,19 +86,23 @@ func StateKeyDesign(addr base.Address, sid currencytypes.ContractID) string {
 	return fmt.Sprintf("%s%s", StateKeyKYCPrefix(addr, sid), DesignSuffix)
 }
 
-type Status bool
-
-var CustomerStateValueHint = hint.MustNewHint("mitum-kyc-customer-state-value-v0.0.1")
+var (
+	CustomerStateValueHint = hint.MustNewHint("mitum-kyc-customer-state-value-v0.0.2")
+	// LegacyCustomerStateValueHint is the customer state value which only has
+	// status; it is still decoded into CustomerStateValue with the legacy hint,
+	// so it keeps the hash and the document of stored blocks.
+	LegacyCustomerStateValueHint = hint.MustNewHint("mitum-kyc-customer-state-value-v0.0.1")
+)
 
 type CustomerStateValue struct {
 	hint.BaseHinter
-	status Status
+	customer kyctypes.CustomerInfo
 }
 
-func NewCustomerStateValue(status Status) CustomerStateValue {
+func NewCustomerStateValue(customer kyctypes.CustomerInfo) CustomerStateValue {
 	return CustomerStateValue{
 		BaseHinter: hint.NewBaseHinter(CustomerStateValueHint),
-		status:     status,
+		customer:   customer,
 	}
 }
 
@@ -108,29 +117,129 @@ func (sd CustomerStateValue) IsValid([]byte) error {
 		return e.Wrap(err)
 	}
 
+	if err := sd.customer.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
 	return nil
 }
 
 func (sd CustomerStateValue) HashBytes() []byte {
-	var v int8
-	if sd.status {
-		v = 1
+	if sd.isLegacy() {
+		b := []byte{0}
+		if sd.customer.IsApproved() {
+			b[0] = 1
+		}
+
+		return b
 	}
-	return []byte{byte(v)}
+
+	return sd.customer.Bytes()
 }
 
-func StateCustomerValue(st base.State) (*Status, error) {
+func (sd CustomerStateValue) isLegacy() bool {
+	return sd.Hint().Equal(LegacyCustomerStateValueHint)
+}
+
+func (sd CustomerStateValue) Customer() kyctypes.CustomerInfo {
+	return sd.customer
+}
+
+func StateCustomerValue(st base.State) (kyctypes.CustomerInfo, error) {
 	v := st.Value()
 	if v == nil {
-		return nil, util.ErrNotFound.Errorf("kyc customer not found in State")
+		return kyctypes.CustomerInfo{}, util.ErrNotFound.Errorf("kyc customer not found in State")
 	}
 
 	d, ok := v.(CustomerStateValue)
 	if !ok {
-		return nil, errors.Errorf("invalid kyc customer value found, %T", v)
+		return kyctypes.CustomerInfo{}, errors.Errorf("invalid kyc customer value found, %T", v)
+	}
+
+	return d.customer, nil
+}
+
+var RemovedCustomerStateValueHint = hint.MustNewHint("mitum-kyc-removed-customer-state-value-v0.0.1")
+
+// RemovedCustomerStateValue replaces the record of removed customer; states
+// can not be deleted, so it keeps no attribute of the customer.
+type RemovedCustomerStateValue struct {
+	hint.BaseHinter
+}
+
+func NewRemovedCustomerStateValue() RemovedCustomerStateValue {
+	return RemovedCustomerStateValue{
+		BaseHinter: hint.NewBaseHinter(RemovedCustomerStateValueHint),
 	}
+}
+
+func (sd RemovedCustomerStateValue) Hint() hint.Hint {
+	return sd.BaseHinter.Hint()
+}
 
-	return &d.status, nil
+func (sd RemovedCustomerStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid kyc RemovedCustomerStateValue")
+
+	if err := sd.BaseHinter.IsValid(RemovedCustomerStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (sd RemovedCustomerStateValue) HashBytes() []byte {
+	return []byte{0}
+}
+
+// LoadCustomer returns the record of customer; removed or migrated customer is
+// not found.
+func LoadCustomer(
+	addr base.Address, kycID currencytypes.ContractID, customer base.Address, getStateFunc base.GetStateFunc,
+) (kyctypes.CustomerInfo, bool, error) {
+	switch st, found, err := getStateFunc(StateKeyCustomer(addr, kycID, customer)); {
+	case err != nil:
+		return kyctypes.CustomerInfo{}, false, err
+	case !found:
+		return kyctypes.CustomerInfo{}, false, nil
+	default:
+		switch st.Value().(type) {
+		case RemovedCustomerStateValue, MigratedStateValue:
+			return kyctypes.CustomerInfo{}, false, nil
+		}
+
+		info, err := StateCustomerValue(st)
+		if err != nil {
+			return kyctypes.CustomerInfo{}, false, err
+		}
+
+		return info, true, nil
+	}
+}
+
+// IsVerified reports whether customer is verified by the kyc service at
+// height; unknown, removed or expired customers are not verified. When the kyc
+// service is migrated, customer is looked up in the new contract account, so
+// the sto which requires the kyc service keeps working.
+func IsVerified(
+	addr base.Address, kycID currencytypes.ContractID, customer base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) (bool, error) {
+	switch st, found, err := getStateFunc(StateKeyDesign(addr, kycID)); {
+	case err != nil:
+		return false, err
+	case found:
+		if m, ok := st.Value().(MigratedStateValue); ok {
+			addr = m.Contract
+		}
+	}
+
+	switch info, found, err := LoadCustomer(addr, kycID, customer, getStateFunc); {
+	case err != nil:
+		return false, err
+	case !found:
+		return false, nil
+	default:
+		return info.IsVerified(height), nil
+	}
 }
 
 func IsStateCustomerKey(key string) bool {
@@ -150,11 +259,258 @@ func ExistsPolicy(addr base.Address, kycid currencytypes.ContractID, getStateFun
 	case !found:
 		return kyctypes.Policy{}, base.NewBaseOperationProcessReasonError("kyc not found, %s-%s", addr, kycid)
 	default:
-		design, ok := i.Value().(DesignStateValue) //nolint:forcetypeassert //...
-		if !ok {
-			return kyctypes.Policy{}, errors.Errorf("expected DesignStateValue, not %T", i.Value())
+		design, err := StateDesignValue(i)
+		if err != nil {
+			return kyctypes.Policy{}, err
 		}
-		policy = design.Design.Policy()
+		policy = design.Policy()
 	}
 	return policy, nil
 }
+
+var (
+	CustomerApprovalsStateValueHint = hint.MustNewHint("mitum-kyc-customer-approvals-state-value-v0.0.1")
+	CustomerApprovalsSuffix         = ":approvals"
+)
+
+// CustomerApprovalsStateValue is the approvers who approved pending customer.
+// It is emptied when customer becomes verified or its record is changed, so
+// approvals are only counted for the current record.
+type CustomerApprovalsStateValue struct {
+	hint.BaseHinter
+	Approvers []base.Address
+}
+
+func NewCustomerApprovalsStateValue(approvers []base.Address) CustomerApprovalsStateValue {
+	return CustomerApprovalsStateValue{
+		BaseHinter: hint.NewBaseHinter(CustomerApprovalsStateValueHint),
+		Approvers:  approvers,
+	}
+}
+
+func (ap CustomerApprovalsStateValue) Hint() hint.Hint {
+	return ap.BaseHinter.Hint()
+}
+
+func (ap CustomerApprovalsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid kyc CustomerApprovalsStateValue")
+
+	if err := ap.BaseHinter.IsValid(CustomerApprovalsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, a := range ap.Approvers {
+		if err := a.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[a.String()]; found {
+			return e.Wrap(errors.Errorf("duplicate approver found, %q", a))
+		}
+
+		founds[a.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (ap CustomerApprovalsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(ap.Approvers))
+	for i := range ap.Approvers {
+		bs[i] = ap.Approvers[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func StateCustomerApprovalsValue(st base.State) ([]base.Address, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("kyc customer approvals not found in State")
+	}
+
+	ap, ok := v.(CustomerApprovalsStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid kyc customer approvals value found, %T", v)
+	}
+
+	return ap.Approvers, nil
+}
+
+// LoadCustomerApprovals returns the approvers of customer; empty when nobody
+// approved it.
+func LoadCustomerApprovals(
+	addr base.Address, kycID currencytypes.ContractID, customer base.Address, getStateFunc base.GetStateFunc,
+) ([]base.Address, error) {
+	switch st, found, err := getStateFunc(StateKeyCustomerApprovals(addr, kycID, customer)); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, nil
+	default:
+		return StateCustomerApprovalsValue(st)
+	}
+}
+
+func IsStateCustomerApprovalsKey(key string) bool {
+	return strings.HasPrefix(key, KYCPrefix) && strings.HasSuffix(key, CustomerApprovalsSuffix)
+}
+
+// kyc:{address}:{kycID}:{address}:approvals
+func StateKeyCustomerApprovals(addr base.Address, sid currencytypes.ContractID, customer base.Address) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyKYCPrefix(addr, sid), customer.String(), CustomerApprovalsSuffix)
+}
+
+// NewCustomerApprovalStateMergeValue returns the merge value which adds
+// approver to the approvals of customer.
+func NewCustomerApprovalStateMergeValue(key string, approver base.Address) base.StateMergeValue {
+	return NewCustomerApprovalsStateMergeValue(key, customerApprovalStateValue{approver: approver})
+}
+
+// NewCustomerApprovalsStateMergeValue returns the merge value which replaces
+// the approvals of customer with v, like emptied approvals or
+// MigratedStateValue.
+func NewCustomerApprovalsStateMergeValue(key string, v base.StateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(key, v, func(height base.Height, st base.State) base.StateValueMerger {
+		return NewCustomerApprovalsStateValueMerger(height, key, st)
+	})
+}
+
+// customerApprovalStateValue is the approval of approver added by an
+// operation.
+type customerApprovalStateValue struct {
+	approver base.Address
+}
+
+func (a customerApprovalStateValue) IsValid([]byte) error {
+	if a.approver == nil {
+		return util.ErrInvalid.Errorf("empty approver")
+	}
+
+	return a.approver.IsValid(nil)
+}
+
+func (a customerApprovalStateValue) HashBytes() []byte {
+	return a.approver.Bytes()
+}
+
+// CustomerApprovalsStateValueMerger merges the approvals added by the
+// operations in a block into the approvals of the former block, so the
+// approvals of different approvers in the same block are all kept. The other
+// values replace the approvals; the approvals added after
+// CustomerApprovalsStateValue are added to it.
+type CustomerApprovalsStateValueMerger struct {
+	*common.BaseStateValueMerger
+	replaced  base.StateValue
+	approvers []base.Address
+	loaded    bool
+}
+
+func NewCustomerApprovalsStateValueMerger(height base.Height, key string, st base.State) *CustomerApprovalsStateValueMerger {
+	return &CustomerApprovalsStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, key, st),
+	}
+}
+
+func (s *CustomerApprovalsStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.loaded {
+		s.loaded = true
+		s.approvers = []base.Address{}
+
+		if s.State != nil {
+			if v, ok := s.State.Value().(CustomerApprovalsStateValue); ok {
+				s.approvers = append(s.approvers, v.Approvers...)
+			}
+		}
+	}
+
+	switch t := value.(type) {
+	case customerApprovalStateValue:
+		if s.replaced != nil {
+			// NOTE the approvals are replaced, like migrated, by former
+			// operation
+			break
+		}
+
+		for i := range s.approvers {
+			if s.approvers[i].Equal(t.approver) {
+				t.approver = nil
+
+				break
+			}
+		}
+
+		if t.approver != nil {
+			s.approvers = append(s.approvers, t.approver)
+		}
+	case CustomerApprovalsStateValue:
+		s.replaced = nil
+		s.approvers = make([]base.Address, len(t.Approvers))
+		copy(s.approvers, t.Approvers)
+	default:
+		s.replaced = value
+	}
+
+	s.AddOperations(ops)
+
+	return nil
+}
+
+func (s *CustomerApprovalsStateValueMerger) Close() error {
+	s.BaseStateValueMerger.SetValue(s.close())
+
+	return s.BaseStateValueMerger.Close()
+}
+
+func (s *CustomerApprovalsStateValueMerger) close() base.StateValue {
+	s.RLock()
+	defer s.RUnlock()
+
+	if s.replaced != nil {
+		return s.replaced
+	}
+
+	return NewCustomerApprovalsStateValue(s.approvers)
+}
+
+var MigratedStateValueHint = hint.MustNewHint("mitum-kyc-migrated-state-value-v0.0.1")
+
+// MigratedStateValue replaces the states of kyc service which is migrated to
+// another contract account; the old keys point to the new contract account.
+type MigratedStateValue struct {
+	hint.BaseHinter
+	Contract base.Address
+}
+
+func NewMigratedStateValue(contract base.Address) MigratedStateValue {
+	return MigratedStateValue{
+		BaseHinter: hint.NewBaseHinter(MigratedStateValueHint),
+		Contract:   contract,
+	}
+}
+
+func (m MigratedStateValue) Hint() hint.Hint {
+	return m.BaseHinter.Hint()
+}
+
+func (m MigratedStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid kyc MigratedStateValue")
+
+	if err := m.BaseHinter.IsValid(MigratedStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := m.Contract.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (m MigratedStateValue) HashBytes() []byte {
+	return m.Contract.Bytes()
+}
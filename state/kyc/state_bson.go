@@ -3,6 +3,7 @@ package kyc
 import (
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -48,17 +49,27 @@ func (de *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (cm CustomerStateValue) MarshalBSON() ([]byte, error) {
+	if cm.isLegacy() {
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":  cm.Hint().String(),
+				"status": cm.customer.IsApproved(),
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":  cm.Hint().String(),
-			"status": cm.status,
+			"_hint":    cm.Hint().String(),
+			"customer": cm.customer,
 		},
 	)
 }
 
 type CustomerStateValueBSONUnmarshaler struct {
-	Hint   string `bson:"_hint"`
-	Status bool   `bson:"status"`
+	Hint     string   `bson:"_hint"`
+	Customer bson.Raw `bson:"customer"`
+	Status   bool     `bson:"status"`
 }
 
 func (cm *CustomerStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -74,9 +85,131 @@ func (cm *CustomerStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
+	if ht.Equal(LegacyCustomerStateValueHint) {
+		cm.BaseHinter = hint.NewBaseHinter(ht)
+		cm.customer = kyctypes.NewStatusCustomerInfo(u.Status)
+
+		return nil
+	}
+
 	cm.BaseHinter = hint.NewBaseHinter(ht)
 
-	cm.status = Status(u.Status)
+	var customer kyctypes.CustomerInfo
+	if err := customer.DecodeBSON(u.Customer, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	cm.customer = customer
+
+	return nil
+}
+
+func (cm RemovedCustomerStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": cm.Hint().String(),
+		},
+	)
+}
+
+type RemovedCustomerStateValueBSONUnmarshaler struct {
+	Hint string `bson:"_hint"`
+}
+
+func (cm *RemovedCustomerStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RemovedCustomerStateValue")
+
+	var u RemovedCustomerStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	cm.BaseHinter = hint.NewBaseHinter(ht)
+
+	return nil
+}
+
+func (ap CustomerApprovalsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     ap.Hint().String(),
+			"approvers": ap.Approvers,
+		},
+	)
+}
+
+type CustomerApprovalsStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Approvers []string `bson:"approvers"`
+}
+
+func (ap *CustomerApprovalsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CustomerApprovalsStateValue")
+
+	var u CustomerApprovalsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ap.BaseHinter = hint.NewBaseHinter(ht)
+
+	approvers := make([]base.Address, len(u.Approvers))
+	for i := range u.Approvers {
+		a, err := base.DecodeAddress(u.Approvers[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		approvers[i] = a
+	}
+	ap.Approvers = approvers
+
+	return nil
+}
+
+func (m MigratedStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    m.Hint().String(),
+			"contract": m.Contract,
+		},
+	)
+}
+
+type MigratedStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+}
+
+func (m *MigratedStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of kyc MigratedStateValue")
+
+	var u MigratedStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(ht)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
 
 	return nil
 }
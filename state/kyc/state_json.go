@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -46,18 +47,32 @@ func (de *DesignStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type CustomerStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Status Status `json:"status"`
+	Customer kyctypes.CustomerInfo `json:"customer"`
+}
+
+type LegacyCustomerStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Status bool `json:"status"`
 }
 
 func (cm CustomerStateValue) MarshalJSON() ([]byte, error) {
+	if cm.isLegacy() {
+		return util.MarshalJSON(LegacyCustomerStateValueJSONMarshaler{
+			BaseHinter: cm.BaseHinter,
+			Status:     cm.customer.IsApproved(),
+		})
+	}
+
 	return util.MarshalJSON(CustomerStateValueJSONMarshaler{
 		BaseHinter: cm.BaseHinter,
-		Status:     cm.status,
+		Customer:   cm.customer,
 	})
 }
 
 type CustomerStateValueJSONUnmarshaler struct {
-	Status bool `json:"status"`
+	Hint     hint.Hint       `json:"_hint"`
+	Customer json.RawMessage `json:"customer"`
+	Status   bool            `json:"status"`
 }
 
 func (cm *CustomerStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -67,7 +82,125 @@ func (cm *CustomerStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 	if err := enc.Unmarshal(b, &u); err != nil {
 		return e.Wrap(err)
 	}
-	cm.status = Status(u.Status)
+
+	if u.Hint.Equal(LegacyCustomerStateValueHint) {
+		cm.BaseHinter = hint.NewBaseHinter(u.Hint)
+		cm.customer = kyctypes.NewStatusCustomerInfo(u.Status)
+
+		return nil
+	}
+
+	cm.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var customer kyctypes.CustomerInfo
+	if err := customer.DecodeJSON(u.Customer, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	cm.customer = customer
+
+	return nil
+}
+
+type RemovedCustomerStateValueJSONMarshaler struct {
+	hint.BaseHinter
+}
+
+func (cm RemovedCustomerStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RemovedCustomerStateValueJSONMarshaler{
+		BaseHinter: cm.BaseHinter,
+	})
+}
+
+type RemovedCustomerStateValueJSONUnmarshaler struct {
+	Hint hint.Hint `json:"_hint"`
+}
+
+func (cm *RemovedCustomerStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RemovedCustomerStateValue")
+
+	var u RemovedCustomerStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	cm.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	return nil
+}
+
+type CustomerApprovalsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Approvers []base.Address `json:"approvers"`
+}
+
+func (ap CustomerApprovalsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CustomerApprovalsStateValueJSONMarshaler{
+		BaseHinter: ap.BaseHinter,
+		Approvers:  ap.Approvers,
+	})
+}
+
+type CustomerApprovalsStateValueJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Approvers []string  `json:"approvers"`
+}
+
+func (ap *CustomerApprovalsStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CustomerApprovalsStateValue")
+
+	var u CustomerApprovalsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ap.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	approvers := make([]base.Address, len(u.Approvers))
+	for i := range u.Approvers {
+		a, err := base.DecodeAddress(u.Approvers[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		approvers[i] = a
+	}
+	ap.Approvers = approvers
+
+	return nil
+}
+
+type MigratedStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address `json:"contract"`
+}
+
+func (m MigratedStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigratedStateValueJSONMarshaler{
+		BaseHinter: m.BaseHinter,
+		Contract:   m.Contract,
+	})
+}
+
+type MigratedStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+}
+
+func (m *MigratedStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of kyc MigratedStateValue")
+
+	var u MigratedStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
 
 	return nil
 }
@@ -0,0 +1,79 @@
+package network
+
+import (
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var (
+	NetworkPolicyStateValueHint = hint.MustNewHint("mitum-sto-network-policy-state-value-v0.0.1")
+	NetworkPolicyStateKey       = "sto-network-policy"
+)
+
+type NetworkPolicyStateValue struct {
+	hint.BaseHinter
+	Policy networktypes.NetworkPolicy
+}
+
+func NewNetworkPolicyStateValue(policy networktypes.NetworkPolicy) NetworkPolicyStateValue {
+	return NetworkPolicyStateValue{
+		BaseHinter: hint.NewBaseHinter(NetworkPolicyStateValueHint),
+		Policy:     policy,
+	}
+}
+
+func (s NetworkPolicyStateValue) Hint() hint.Hint {
+	return s.BaseHinter.Hint()
+}
+
+func (s NetworkPolicyStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NetworkPolicyStateValue")
+
+	if err := s.BaseHinter.IsValid(NetworkPolicyStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := s.Policy.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (s NetworkPolicyStateValue) HashBytes() []byte {
+	return s.Policy.HashBytes()
+}
+
+func StateNetworkPolicyValue(st base.State) (networktypes.NetworkPolicy, error) {
+	v := st.Value()
+	if v == nil {
+		return networktypes.NetworkPolicy{}, util.ErrNotFound.Errorf("network policy not found in State")
+	}
+
+	s, ok := v.(NetworkPolicyStateValue)
+	if !ok {
+		return networktypes.NetworkPolicy{}, errors.Errorf("invalid network policy value found, %T", v)
+	}
+
+	return s.Policy, nil
+}
+
+func IsStateNetworkPolicyKey(key string) bool {
+	return key == NetworkPolicyStateKey
+}
+
+// LoadNetworkPolicy returns the network policy in state. If the network
+// policy is not yet stored, DefaultNetworkPolicy is returned.
+func LoadNetworkPolicy(getStateFunc base.GetStateFunc) (networktypes.NetworkPolicy, error) {
+	switch st, found, err := getStateFunc(NetworkPolicyStateKey); {
+	case err != nil:
+		return networktypes.NetworkPolicy{}, err
+	case !found:
+		return networktypes.DefaultNetworkPolicy(), nil
+	default:
+		return StateNetworkPolicyValue(st)
+	}
+}
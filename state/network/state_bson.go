@@ -0,0 +1,48 @@
+package network
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s NetworkPolicyStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"policy": s.Policy,
+		},
+	)
+}
+
+type NetworkPolicyStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Policy bson.Raw `bson:"policy"`
+}
+
+func (s *NetworkPolicyStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of NetworkPolicyStateValue")
+
+	var u NetworkPolicyStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	var policy networktypes.NetworkPolicy
+	if err := policy.DecodeBSON(u.Policy, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.Policy = policy
+
+	return nil
+}
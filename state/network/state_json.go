@@ -0,0 +1,47 @@
+package network
+
+import (
+	"encoding/json"
+
+	networktypes "github.com/ProtoconNet/mitum-sto/types/network"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type NetworkPolicyStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Policy networktypes.NetworkPolicy `json:"policy"`
+}
+
+func (s NetworkPolicyStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NetworkPolicyStateValueJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Policy:     s.Policy,
+	})
+}
+
+type NetworkPolicyStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint       `json:"_hint"`
+	Policy json.RawMessage `json:"policy"`
+}
+
+func (s *NetworkPolicyStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of NetworkPolicyStateValue")
+
+	var u NetworkPolicyStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var policy networktypes.NetworkPolicy
+	if err := policy.DecodeJSON(u.Policy, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	s.Policy = policy
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	kycstate "github.com/ProtoconNet/mitum-sto/state/kyc"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -66,6 +68,13 @@ func StateDesignValue(st base.State) (stotypes.Design, error) {
 		return stotypes.Design{}, util.ErrNotFound.Errorf("sto design not found in State")
 	}
 
+	switch m := v.(type) {
+	case MigratedStateValue:
+		return stotypes.Design{}, errors.Errorf("sto migrated to %q", m.Contract)
+	case MigratingStateValue:
+		return stotypes.Design{}, errors.Errorf("sto migrating to %q", m.Contract)
+	}
+
 	d, ok := v.(DesignStateValue)
 	if !ok {
 		return stotypes.Design{}, errors.Errorf("invalid sto design value found, %T", v)
@@ -83,9 +92,6 @@ func StateKeyDesign(addr base.Address, sid currencytypes.ContractID) string {
 	return fmt.Sprintf("%s%s", StateKeySTOPrefix(addr, sid), DesignSuffix)
 }
 
-var MaxOperatorInOperators = 10
-var MaxTokenHolderInTokenHolders = 10
-
 var (
 	TokenHolderPartitionsStateValueHint = hint.MustNewHint("mitum-sto-tokenholder-partitions-state-value-v0.0.1")
 	TokenHolderPartitionsSuffix         = ":holder-partitions"
@@ -253,8 +259,6 @@ func (sv TokenHolderPartitionOperatorsStateValue) IsValid([]byte) error {
 
 	if n := len(sv.Operators); n < 1 {
 		return util.ErrInvalid.Errorf("empty keys")
-	} else if n > MaxOperatorInOperators {
-		return util.ErrInvalid.Errorf("keys over %d, %d", MaxOperatorInOperators, n)
 	}
 
 	m := map[string]struct{}{}
@@ -426,6 +430,8 @@ func IsStatePartitionControllersKey(key string) bool {
 	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, PartitionControllersSuffix)
 }
 
+// OperatorTokenHoldersStateValue is the former single-value list of operator tokenholders.
+// It is kept to decode existing states; see OperatorTokenHolderStateValue.
 var (
 	OperatorTokenHoldersStateValueHint = hint.MustNewHint("mitum-sto-operator-tokenholders-state-value-v0.0.1")
 	OperatorTokenHoldersSuffix         = ":operator-holders"
@@ -456,8 +462,6 @@ func (o OperatorTokenHoldersStateValue) IsValid([]byte) error {
 
 	if n := len(o.TokenHolders); n < 1 {
 		return util.ErrInvalid.Errorf("empty keys")
-	} else if n > MaxTokenHolderInTokenHolders {
-		return util.ErrInvalid.Errorf("keys over %d, %d", MaxTokenHolderInTokenHolders, n)
 	}
 
 	m := map[string]struct{}{}
@@ -510,6 +514,668 @@ func StateOperatorTokenHoldersValue(st base.State) ([]base.Address, error) {
 	return addrs.TokenHolders, nil
 }
 
+var (
+	OperatorTokenHolderStateValueHint = hint.MustNewHint("mitum-sto-operator-tokenholder-state-value-v0.0.1")
+	OperatorTokenHolderSuffix         = ":operator-holder"
+	GlobalOperatorTokenHolderSuffix   = ":global-operator-holder"
+)
+
+// OperatorTokenHolderStateValue tells whether a tokenholder authorized an operator.
+// Each operator and tokenholder pair has its own state, so the number of
+// tokenholders of an operator does not grow any single state.
+type OperatorTokenHolderStateValue struct {
+	hint.BaseHinter
+	Authorized bool
+}
+
+func NewOperatorTokenHolderStateValue(authorized bool) OperatorTokenHolderStateValue {
+	return OperatorTokenHolderStateValue{
+		BaseHinter: hint.NewBaseHinter(OperatorTokenHolderStateValueHint),
+		Authorized: authorized,
+	}
+}
+
+func (o OperatorTokenHolderStateValue) Hint() hint.Hint {
+	return o.BaseHinter.Hint()
+}
+
+func (o OperatorTokenHolderStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid OperatorTokenHolderStateValue")
+
+	if err := o.BaseHinter.IsValid(OperatorTokenHolderStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (o OperatorTokenHolderStateValue) HashBytes() []byte {
+	if o.Authorized {
+		return []byte{1}
+	}
+
+	return []byte{0}
+}
+
+// sto:address-stoID-operator-partition-holder:operator-holder
+func StateKeyOperatorTokenHolder(caddr base.Address, stoID currencytypes.ContractID, oaddr base.Address, partition stotypes.Partition, uaddr base.Address) string {
+	return fmt.Sprintf("%s-%s-%s-%s%s", StateKeySTOPrefix(caddr, stoID), oaddr.String(), partition.String(), uaddr.String(), OperatorTokenHolderSuffix)
+}
+
+func IsStateOperatorTokenHolderKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, OperatorTokenHolderSuffix)
+}
+
+// sto:address-stoID-operator-holder:global-operator-holder
+func StateKeyGlobalOperatorTokenHolder(caddr base.Address, stoID currencytypes.ContractID, oaddr base.Address, uaddr base.Address) string {
+	return fmt.Sprintf("%s-%s-%s%s", StateKeySTOPrefix(caddr, stoID), oaddr.String(), uaddr.String(), GlobalOperatorTokenHolderSuffix)
+}
+
+func IsStateGlobalOperatorTokenHolderKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, GlobalOperatorTokenHolderSuffix)
+}
+
+func StateOperatorTokenHolderValue(st base.State) (bool, error) {
+	v := st.Value()
+	if v == nil {
+		return false, util.ErrNotFound.Errorf("operator tokenholder not found in State")
+	}
+
+	o, ok := v.(OperatorTokenHolderStateValue)
+	if !ok {
+		return false, errors.Errorf("invalid operator tokenholder value found, %T", v)
+	}
+
+	return o.Authorized, nil
+}
+
+var (
+	OperatorTokenHoldersCountStateValueHint = hint.MustNewHint("mitum-sto-operator-tokenholders-count-state-value-v0.0.1")
+	OperatorTokenHoldersCountSuffix         = ":operator-holders-count"
+	GlobalOperatorTokenHoldersCountSuffix   = ":global-operator-holders-count"
+)
+
+// OperatorTokenHoldersCountStateValue indexes the number of tokenholders
+// which authorized an operator.
+type OperatorTokenHoldersCountStateValue struct {
+	hint.BaseHinter
+	Count uint64
+}
+
+func NewOperatorTokenHoldersCountStateValue(count uint64) OperatorTokenHoldersCountStateValue {
+	return OperatorTokenHoldersCountStateValue{
+		BaseHinter: hint.NewBaseHinter(OperatorTokenHoldersCountStateValueHint),
+		Count:      count,
+	}
+}
+
+func (o OperatorTokenHoldersCountStateValue) Hint() hint.Hint {
+	return o.BaseHinter.Hint()
+}
+
+func (o OperatorTokenHoldersCountStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid OperatorTokenHoldersCountStateValue")
+
+	if err := o.BaseHinter.IsValid(OperatorTokenHoldersCountStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (o OperatorTokenHoldersCountStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(o.Count)
+}
+
+// sto:address-stoID-operator-partition:operator-holders-count
+func StateKeyOperatorTokenHoldersCount(caddr base.Address, stoID currencytypes.ContractID, oaddr base.Address, partition stotypes.Partition) string {
+	return fmt.Sprintf("%s-%s-%s%s", StateKeySTOPrefix(caddr, stoID), oaddr.String(), partition.String(), OperatorTokenHoldersCountSuffix)
+}
+
+func IsStateOperatorTokenHoldersCountKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, OperatorTokenHoldersCountSuffix)
+}
+
+// sto:address-stoID-operator:global-operator-holders-count
+func StateKeyGlobalOperatorTokenHoldersCount(caddr base.Address, stoID currencytypes.ContractID, oaddr base.Address) string {
+	return fmt.Sprintf("%s-%s%s", StateKeySTOPrefix(caddr, stoID), oaddr.String(), GlobalOperatorTokenHoldersCountSuffix)
+}
+
+func IsStateGlobalOperatorTokenHoldersCountKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, GlobalOperatorTokenHoldersCountSuffix)
+}
+
+func StateOperatorTokenHoldersCountValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("operator tokenholders count not found in State")
+	}
+
+	o, ok := v.(OperatorTokenHoldersCountStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid operator tokenholders count value found, %T", v)
+	}
+
+	return o.Count, nil
+}
+
+var (
+	TokenHolderOperatorsStateValueHint = hint.MustNewHint("mitum-sto-tokenholder-operators-state-value-v0.0.1")
+	TokenHolderOperatorsSuffix         = ":holder-operators"
+)
+
+type TokenHolderOperatorsStateValue struct {
+	hint.BaseHinter
+	Operators []base.Address
+}
+
+func NewTokenHolderOperatorsStateValue(operators []base.Address) TokenHolderOperatorsStateValue {
+	return TokenHolderOperatorsStateValue{
+		BaseHinter: hint.NewBaseHinter(TokenHolderOperatorsStateValueHint),
+		Operators:  operators,
+	}
+}
+
+func (sv TokenHolderOperatorsStateValue) Hint() hint.Hint {
+	return sv.BaseHinter.Hint()
+}
+
+func (sv TokenHolderOperatorsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid TokenHolderOperatorsStateValue")
+
+	if err := sv.BaseHinter.IsValid(TokenHolderOperatorsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	m := map[string]struct{}{}
+	for i := range sv.Operators {
+		k := sv.Operators[i]
+		if err := util.CheckIsValiders(nil, false, k); err != nil {
+			return err
+		}
+
+		if _, found := m[k.String()]; found {
+			return util.ErrInvalid.Errorf("duplicated Account found")
+		}
+
+		m[k.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (sv TokenHolderOperatorsStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(sv.Operators))
+	sort.Slice(sv.Operators, func(i, j int) bool {
+		return bytes.Compare(sv.Operators[i].Bytes(), sv.Operators[j].Bytes()) < 0
+	})
+	for i, operator := range sv.Operators {
+		bs[i] = operator.Bytes()
+	}
+	return util.ConcatBytesSlice(bs...)
+}
+
+// sto:address-stoID-holder:holder-operators
+func StateKeyTokenHolderOperators(caddr base.Address, stoID currencytypes.ContractID, uaddr base.Address) string {
+	return fmt.Sprintf("%s-%s%s", StateKeySTOPrefix(caddr, stoID), uaddr.String(), TokenHolderOperatorsSuffix)
+}
+
+func IsStateTokenHolderOperatorsKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, TokenHolderOperatorsSuffix)
+}
+
+func StateTokenHolderOperatorsValue(st base.State) ([]base.Address, error) {
+	v := st.Value()
+	if v == nil {
+		return []base.Address{}, util.ErrNotFound.Errorf("tokenholder operators not found in State")
+	}
+
+	addrs, ok := v.(TokenHolderOperatorsStateValue)
+	if !ok {
+		return []base.Address{}, errors.Errorf("invalid tokenholder operators value found, %T", v)
+	}
+
+	return addrs.Operators, nil
+}
+
+var (
+	OperatorAllowanceStateValueHint = hint.MustNewHint("mitum-sto-operator-allowance-state-value-v0.0.1")
+	OperatorAllowanceSuffix         = ":operator-allowance"
+)
+
+type OperatorAllowanceStateValue struct {
+	hint.BaseHinter
+	Amount common.Big
+	Expiry base.Height
+}
+
+func NewOperatorAllowanceStateValue(amount common.Big, expiry base.Height) OperatorAllowanceStateValue {
+	return OperatorAllowanceStateValue{
+		BaseHinter: hint.NewBaseHinter(OperatorAllowanceStateValueHint),
+		Amount:     amount,
+		Expiry:     expiry,
+	}
+}
+
+func (sv OperatorAllowanceStateValue) Hint() hint.Hint {
+	return sv.BaseHinter.Hint()
+}
+
+func (sv OperatorAllowanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid OperatorAllowanceStateValue")
+
+	if err := sv.BaseHinter.IsValid(OperatorAllowanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, sv.Amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if sv.Expiry < 0 {
+		return e.Wrap(errors.Errorf("invalid expiry height, %d", sv.Expiry))
+	}
+
+	return nil
+}
+
+func (sv OperatorAllowanceStateValue) HashBytes() []byte {
+	return util.ConcatBytesSlice(sv.Amount.Bytes(), sv.Expiry.Bytes())
+}
+
+// IsLimited reports whether the operator can spend only up to Amount.
+func (sv OperatorAllowanceStateValue) IsLimited() bool {
+	return sv.Amount.OverNil()
+}
+
+// IsExpired reports whether the allowance is no longer usable at height.
+func (sv OperatorAllowanceStateValue) IsExpired(height base.Height) bool {
+	return sv.Expiry > 0 && height > sv.Expiry
+}
+
+// sto:address-stoID-holder-partition-operator:operator-allowance
+func StateKeyOperatorAllowance(caddr base.Address, stoID currencytypes.ContractID, uaddr base.Address, partition stotypes.Partition, oaddr base.Address) string {
+	return fmt.Sprintf("%s-%s-%s-%s%s", StateKeySTOPrefix(caddr, stoID), uaddr.String(), partition.String(), oaddr.String(), OperatorAllowanceSuffix)
+}
+
+func IsStateOperatorAllowanceKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, OperatorAllowanceSuffix)
+}
+
+func StateOperatorAllowanceValue(st base.State) (OperatorAllowanceStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return OperatorAllowanceStateValue{}, util.ErrNotFound.Errorf("operator allowance not found in State")
+	}
+
+	a, ok := v.(OperatorAllowanceStateValue)
+	if !ok {
+		return OperatorAllowanceStateValue{}, errors.Errorf("invalid operator allowance value found, %T", v)
+	}
+
+	return a, nil
+}
+
+var (
+	JurisdictionHoldersCountStateValueHint = hint.MustNewHint("mitum-sto-jurisdiction-holders-count-state-value-v0.0.1")
+	JurisdictionHoldersCountSuffix         = ":jurisdiction-holders-count"
+)
+
+// JurisdictionHoldersCountStateValue indexes the number of tokenholders in a
+// jurisdiction; it is checked against the jurisdiction caps of sto.
+type JurisdictionHoldersCountStateValue struct {
+	hint.BaseHinter
+	Count uint64
+}
+
+func NewJurisdictionHoldersCountStateValue(count uint64) JurisdictionHoldersCountStateValue {
+	return JurisdictionHoldersCountStateValue{
+		BaseHinter: hint.NewBaseHinter(JurisdictionHoldersCountStateValueHint),
+		Count:      count,
+	}
+}
+
+func (j JurisdictionHoldersCountStateValue) Hint() hint.Hint {
+	return j.BaseHinter.Hint()
+}
+
+func (j JurisdictionHoldersCountStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid JurisdictionHoldersCountStateValue")
+
+	if err := j.BaseHinter.IsValid(JurisdictionHoldersCountStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (j JurisdictionHoldersCountStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(j.Count)
+}
+
+// sto:address-stoID-jurisdiction:jurisdiction-holders-count
+func StateKeyJurisdictionHoldersCount(caddr base.Address, stoID currencytypes.ContractID, jurisdiction kyctypes.Jurisdiction) string {
+	return fmt.Sprintf("%s-%s%s", StateKeySTOPrefix(caddr, stoID), jurisdiction.String(), JurisdictionHoldersCountSuffix)
+}
+
+func IsStateJurisdictionHoldersCountKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, JurisdictionHoldersCountSuffix)
+}
+
+func StateJurisdictionHoldersCountValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("jurisdiction holders count not found in State")
+	}
+
+	j, ok := v.(JurisdictionHoldersCountStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid jurisdiction holders count value found, %T", v)
+	}
+
+	return j.Count, nil
+}
+
+// NewOperatorTokenHoldersCountStateMergeValue returns the merge value of the
+// operator tokenholders count which an operation changed from read to count.
+func NewOperatorTokenHoldersCountStateMergeValue(key string, read, count uint64) base.StateMergeValue {
+	return newHoldersCountStateMergeValue(key, read, count, func(c uint64) base.StateValue {
+		return NewOperatorTokenHoldersCountStateValue(c)
+	})
+}
+
+// NewJurisdictionHoldersCountStateMergeValue returns the merge value of the
+// jurisdiction tokenholders count which an operation changed from read to
+// count.
+func NewJurisdictionHoldersCountStateMergeValue(key string, read, count uint64) base.StateMergeValue {
+	return newHoldersCountStateMergeValue(key, read, count, func(c uint64) base.StateValue {
+		return NewJurisdictionHoldersCountStateValue(c)
+	})
+}
+
+// NewHoldersCountStateMergeValue returns the merge value which replaces the
+// tokenholders count of key with v, like MigratedStateValue.
+func NewHoldersCountStateMergeValue(key string, v base.StateValue) base.StateMergeValue {
+	return common.NewBaseStateMergeValue(key, v, func(height base.Height, st base.State) base.StateValueMerger {
+		return NewHoldersCountStateValueMerger(height, key, st)
+	})
+}
+
+func newHoldersCountStateMergeValue(
+	key string, read, count uint64, newValue func(uint64) base.StateValue,
+) base.StateMergeValue {
+	return NewHoldersCountStateMergeValue(key, holdersCountStateValue{
+		StateValue: newValue(count),
+		read:       read,
+		count:      count,
+		newValue:   newValue,
+	})
+}
+
+// holdersCountStateValue is the tokenholders count written by an operation
+// with the count it read.
+type holdersCountStateValue struct {
+	base.StateValue
+	newValue func(uint64) base.StateValue
+	read     uint64
+	count    uint64
+}
+
+// HoldersCountStateValueMerger merges the tokenholders counts written by the
+// operations in a block. The operations read the same count of the former
+// block, so the counts are merged by their differences from the read count
+// and the changes by operations of different senders are all kept. The caps
+// are checked against the read count, so they can be exceeded by the
+// operations in the same block.
+type HoldersCountStateValueMerger struct {
+	*common.BaseStateValueMerger
+	replaced base.StateValue
+	newValue func(uint64) base.StateValue
+	count    uint64
+}
+
+func NewHoldersCountStateValueMerger(height base.Height, key string, st base.State) *HoldersCountStateValueMerger {
+	return &HoldersCountStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, key, st),
+	}
+}
+
+func (s *HoldersCountStateValueMerger) Merge(value base.StateValue, ops []util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	v, ok := value.(holdersCountStateValue)
+
+	switch {
+	case !ok:
+		s.replaced = value
+	case s.replaced != nil:
+		// NOTE the count is replaced, like migrated, by former operation
+	case s.newValue == nil:
+		s.newValue = v.newValue
+		s.count = v.count
+	case v.count >= v.read:
+		s.count += v.count - v.read
+	case s.count > v.read-v.count:
+		s.count -= v.read - v.count
+	default:
+		s.count = 0
+	}
+
+	s.AddOperations(ops)
+
+	return nil
+}
+
+func (s *HoldersCountStateValueMerger) Close() error {
+	newValue, err := s.close()
+	if err != nil {
+		return errors.WithMessage(err, "failed to close HoldersCountStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.Close()
+}
+
+func (s *HoldersCountStateValueMerger) close() (base.StateValue, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	switch {
+	case s.replaced != nil:
+		return s.replaced, nil
+	case s.newValue == nil:
+		return nil, errors.Errorf("empty tokenholders count")
+	default:
+		return s.newValue(s.count), nil
+	}
+}
+
+var (
+	TokenHolderJurisdictionStateValueHint = hint.MustNewHint("mitum-sto-tokenholder-jurisdiction-state-value-v0.0.1")
+	TokenHolderJurisdictionSuffix         = ":holder-jurisdiction"
+)
+
+// TokenHolderJurisdictionStateValue is the jurisdiction the tokenholder was
+// counted in when it became a tokenholder, so the same count is decreased
+// when it stops holding tokens even if its kyc record changed in between.
+// Empty jurisdiction means the tokenholder is not counted.
+type TokenHolderJurisdictionStateValue struct {
+	hint.BaseHinter
+	Jurisdiction kyctypes.Jurisdiction
+}
+
+func NewTokenHolderJurisdictionStateValue(jurisdiction kyctypes.Jurisdiction) TokenHolderJurisdictionStateValue {
+	return TokenHolderJurisdictionStateValue{
+		BaseHinter:   hint.NewBaseHinter(TokenHolderJurisdictionStateValueHint),
+		Jurisdiction: jurisdiction,
+	}
+}
+
+func (t TokenHolderJurisdictionStateValue) Hint() hint.Hint {
+	return t.BaseHinter.Hint()
+}
+
+func (t TokenHolderJurisdictionStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid TokenHolderJurisdictionStateValue")
+
+	if err := t.BaseHinter.IsValid(TokenHolderJurisdictionStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(t.Jurisdiction) > 0 {
+		if err := t.Jurisdiction.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (t TokenHolderJurisdictionStateValue) HashBytes() []byte {
+	return t.Jurisdiction.Bytes()
+}
+
+// sto:address-stoID-holder:holder-jurisdiction
+func StateKeyTokenHolderJurisdiction(caddr base.Address, stoID currencytypes.ContractID, uaddr base.Address) string {
+	return fmt.Sprintf("%s-%s%s", StateKeySTOPrefix(caddr, stoID), uaddr.String(), TokenHolderJurisdictionSuffix)
+}
+
+func IsStateTokenHolderJurisdictionKey(key string) bool {
+	return strings.HasPrefix(key, STOPrefix) && strings.HasSuffix(key, TokenHolderJurisdictionSuffix)
+}
+
+func StateTokenHolderJurisdictionValue(st base.State) (kyctypes.Jurisdiction, error) {
+	v := st.Value()
+	if v == nil {
+		return "", util.ErrNotFound.Errorf("tokenholder jurisdiction not found in State")
+	}
+
+	t, ok := v.(TokenHolderJurisdictionStateValue)
+	if !ok {
+		return "", errors.Errorf("invalid tokenholder jurisdiction value found, %T", v)
+	}
+
+	return t.Jurisdiction, nil
+}
+
+var MigratedStateValueHint = hint.MustNewHint("mitum-sto-migrated-state-value-v0.0.1")
+
+// MigratedStateValue replaces the states of sto which is migrated to another
+// contract account; states can not be deleted, so the old keys point to the
+// new contract account.
+type MigratedStateValue struct {
+	hint.BaseHinter
+	Contract base.Address
+}
+
+func NewMigratedStateValue(contract base.Address) MigratedStateValue {
+	return MigratedStateValue{
+		BaseHinter: hint.NewBaseHinter(MigratedStateValueHint),
+		Contract:   contract,
+	}
+}
+
+func (m MigratedStateValue) Hint() hint.Hint {
+	return m.BaseHinter.Hint()
+}
+
+func (m MigratedStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid MigratedStateValue")
+
+	if err := m.BaseHinter.IsValid(MigratedStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := m.Contract.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (m MigratedStateValue) HashBytes() []byte {
+	return m.Contract.Bytes()
+}
+
+var MigratingStateValueHint = hint.MustNewHint("mitum-sto-migrating-state-value-v0.0.1")
+
+// MigratingStateValue replaces the design of sto while its tokenholders are
+// migrated to another contract account over several operations. Migrated has
+// the balances of migrated tokenholders by the partitions of design, in the
+// same order, and Holders is the number of migrated tokenholders. The sto can
+// not be used until the migration is finished or aborted.
+type MigratingStateValue struct {
+	hint.BaseHinter
+	Contract base.Address
+	Design   stotypes.Design
+	Migrated []common.Big
+	Holders  uint64
+}
+
+func NewMigratingStateValue(
+	contract base.Address, design stotypes.Design, migrated []common.Big, holders uint64,
+) MigratingStateValue {
+	return MigratingStateValue{
+		BaseHinter: hint.NewBaseHinter(MigratingStateValueHint),
+		Contract:   contract,
+		Design:     design,
+		Migrated:   migrated,
+		Holders:    holders,
+	}
+}
+
+func (m MigratingStateValue) Hint() hint.Hint {
+	return m.BaseHinter.Hint()
+}
+
+func (m MigratingStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid MigratingStateValue")
+
+	if err := m.BaseHinter.IsValid(MigratingStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, m.Contract, m.Design); err != nil {
+		return e.Wrap(err)
+	}
+
+	if n := len(m.Design.Policy().Partitions()); len(m.Migrated) != n {
+		return e.Errorf("migrated balances not match with partitions, %d != %d", len(m.Migrated), n)
+	}
+
+	for i := range m.Migrated {
+		if !m.Migrated[i].OverNil() {
+			return e.Errorf("migrated balance under zero, %q", m.Migrated[i])
+		}
+	}
+
+	return nil
+}
+
+func (m MigratingStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(m.Migrated))
+	for i := range m.Migrated {
+		bs[i] = m.Migrated[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		m.Contract.Bytes(), m.Design.Bytes(), util.ConcatBytesSlice(bs...), util.Uint64ToBytes(m.Holders))
+}
+
+// ExistsDesign returns the design of sto; migrated sto is not found.
+func ExistsDesign(ca base.Address, sid currencytypes.ContractID, getStateFunc base.GetStateFunc) (stotypes.Design, error) {
+	switch st, found, err := getStateFunc(StateKeyDesign(ca, sid)); {
+	case err != nil:
+		return stotypes.Design{}, err
+	case !found:
+		return stotypes.Design{}, base.NewBaseOperationProcessReasonError("sto not found, %s-%s", ca, sid)
+	default:
+		return StateDesignValue(st)
+	}
+}
+
 func ExistsTokenHolderPartitions(ca base.Address, sid currencytypes.ContractID, holder base.Address, getStateFunc base.GetStateFunc) ([]stotypes.Partition, error) {
 	var partitions []stotypes.Partition
 	switch i, found, err := getStateFunc(StateKeyTokenHolderPartitions(ca, sid, holder)); {
@@ -552,11 +1218,112 @@ func ExistsPolicy(addr base.Address, kycid currencytypes.ContractID, getStateFun
 	case !found:
 		return stotypes.Policy{}, base.NewBaseOperationProcessReasonError("sto not found, %s-%s", addr, kycid)
 	default:
-		design, ok := i.Value().(DesignStateValue) //nolint:forcetypeassert //...
-		if !ok {
-			return stotypes.Policy{}, errors.Errorf("expected DesignStateValue, not %T", i.Value())
+		design, err := StateDesignValue(i)
+		if err != nil {
+			return stotypes.Policy{}, err
 		}
-		policy = design.Design.Policy()
+		policy = design.Policy()
 	}
 	return policy, nil
 }
+
+// IsTokenHolderOperator reports whether operator may act for holder in partition,
+// either as a global operator of the holder or as an operator of the partition.
+func IsTokenHolderOperator(ca base.Address, sid currencytypes.ContractID, holder base.Address, p stotypes.Partition, operator base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	switch ok, err := IsTokenHolderGlobalOperator(ca, sid, holder, operator, getStateFunc); {
+	case err != nil:
+		return false, err
+	case ok:
+		return true, nil
+	}
+
+	switch st, found, err := getStateFunc(StateKeyTokenHolderPartitionOperators(ca, sid, holder, p)); {
+	case err != nil:
+		return false, err
+	case found:
+		operators, err := StateTokenHolderPartitionOperatorsValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		for _, op := range operators {
+			if op.Equal(operator) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// IsTokenHolderGlobalOperator reports whether operator may act for holder in all partitions.
+func IsTokenHolderGlobalOperator(ca base.Address, sid currencytypes.ContractID, holder base.Address, operator base.Address, getStateFunc base.GetStateFunc) (bool, error) {
+	switch st, found, err := getStateFunc(StateKeyTokenHolderOperators(ca, sid, holder)); {
+	case err != nil:
+		return false, err
+	case found:
+		operators, err := StateTokenHolderOperatorsValue(st)
+		if err != nil {
+			return false, err
+		}
+
+		for _, op := range operators {
+			if op.Equal(operator) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// CheckTokenHolderKYC checks holder is verified at height by the kyc services
+// of requirement; any-of needs one of them, all-of needs every one.
+func CheckTokenHolderKYC(
+	kyc stotypes.KYCRequirement, holder base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) error {
+	if kyc.IsEmpty() {
+		return nil
+	}
+
+	for _, s := range kyc.Services() {
+		verified, err := kycstate.IsVerified(s.Contract(), s.KYC(), holder, height, getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case verified && kyc.Mode() == stotypes.KYCModeAnyOf:
+			return nil
+		case !verified && kyc.Mode() == stotypes.KYCModeAllOf:
+			return errors.Errorf("tokenholder not verified by kyc service, %q, %s", holder, s)
+		}
+	}
+
+	if kyc.Mode() == stotypes.KYCModeAnyOf {
+		return errors.Errorf("tokenholder not verified by any kyc service, %q", holder)
+	}
+
+	return nil
+}
+
+// TokenHolderJurisdiction returns the jurisdiction of holder from the first
+// kyc service, in the order of requirement, which verifies holder at height
+// and knows its jurisdiction. Empty jurisdiction is returned when there is no
+// such record.
+func TokenHolderJurisdiction(
+	kyc stotypes.KYCRequirement, holder base.Address, height base.Height, getStateFunc base.GetStateFunc,
+) (kyctypes.Jurisdiction, error) {
+	for _, s := range kyc.Services() {
+		switch info, found, err := kycstate.LoadCustomer(s.Contract(), s.KYC(), holder, getStateFunc); {
+		case err != nil:
+			return "", err
+		case !found, !info.IsVerified(height), len(info.Jurisdiction()) < 1:
+			continue
+		default:
+			return info.Jurisdiction(), nil
+		}
+	}
+
+	return "", nil
+}
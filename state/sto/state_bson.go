@@ -3,6 +3,7 @@ package sto
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -251,3 +252,318 @@ func (oth *OperatorTokenHoldersStateValue) DecodeBSON(b []byte, enc *bsonenc.Enc
 
 	return nil
 }
+
+func (ops TokenHolderOperatorsStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     ops.Hint().String(),
+			"operators": ops.Operators,
+		},
+	)
+}
+
+type TokenHolderOperatorsStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Operators []string `bson:"operators"`
+}
+
+func (ops *TokenHolderOperatorsStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TokenHolderOperatorsStateValue")
+
+	var u TokenHolderOperatorsStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ops.BaseHinter = hint.NewBaseHinter(ht)
+
+	operators := make([]base.Address, len(u.Operators))
+	for i := range u.Operators {
+		a, err := base.DecodeAddress(u.Operators[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		operators[i] = a
+	}
+	ops.Operators = operators
+
+	return nil
+}
+
+func (sv OperatorAllowanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  sv.Hint().String(),
+			"amount": sv.Amount.String(),
+			"expiry": sv.Expiry,
+		},
+	)
+}
+
+type OperatorAllowanceStateValueBSONUnmarshaler struct {
+	Hint   string      `bson:"_hint"`
+	Amount string      `bson:"amount"`
+	Expiry base.Height `bson:"expiry"`
+}
+
+func (sv *OperatorAllowanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of OperatorAllowanceStateValue")
+
+	var u OperatorAllowanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sv.BaseHinter = hint.NewBaseHinter(ht)
+
+	big, err := common.NewBigFromString(u.Amount)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sv.Amount = big
+	sv.Expiry = u.Expiry
+
+	return nil
+}
+
+func (o OperatorTokenHolderStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      o.Hint().String(),
+			"authorized": o.Authorized,
+		},
+	)
+}
+
+type OperatorTokenHolderStateValueBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Authorized bool   `bson:"authorized"`
+}
+
+func (o *OperatorTokenHolderStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of OperatorTokenHolderStateValue")
+
+	var u OperatorTokenHolderStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	o.BaseHinter = hint.NewBaseHinter(ht)
+	o.Authorized = u.Authorized
+
+	return nil
+}
+
+func (o OperatorTokenHoldersCountStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": o.Hint().String(),
+			"count": o.Count,
+		},
+	)
+}
+
+type OperatorTokenHoldersCountStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Count uint64 `bson:"count"`
+}
+
+func (o *OperatorTokenHoldersCountStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of OperatorTokenHoldersCountStateValue")
+
+	var u OperatorTokenHoldersCountStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	o.BaseHinter = hint.NewBaseHinter(ht)
+	o.Count = u.Count
+
+	return nil
+}
+
+func (j JurisdictionHoldersCountStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": j.Hint().String(),
+			"count": j.Count,
+		},
+	)
+}
+
+type JurisdictionHoldersCountStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Count uint64 `bson:"count"`
+}
+
+func (j *JurisdictionHoldersCountStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of JurisdictionHoldersCountStateValue")
+
+	var u JurisdictionHoldersCountStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	j.BaseHinter = hint.NewBaseHinter(ht)
+	j.Count = u.Count
+
+	return nil
+}
+
+func (t TokenHolderJurisdictionStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        t.Hint().String(),
+			"jurisdiction": t.Jurisdiction,
+		},
+	)
+}
+
+type TokenHolderJurisdictionStateValueBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Jurisdiction string `bson:"jurisdiction"`
+}
+
+func (t *TokenHolderJurisdictionStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TokenHolderJurisdictionStateValue")
+
+	var u TokenHolderJurisdictionStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	t.BaseHinter = hint.NewBaseHinter(ht)
+	t.Jurisdiction = kyctypes.Jurisdiction(u.Jurisdiction)
+
+	return nil
+}
+
+func (m MigratedStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    m.Hint().String(),
+			"contract": m.Contract,
+		},
+	)
+}
+
+type MigratedStateValueBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+}
+
+func (m *MigratedStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MigratedStateValue")
+
+	var u MigratedStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(ht)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
+
+	return nil
+}
+
+func (m MigratingStateValue) MarshalBSON() ([]byte, error) {
+	migrated := make([]string, len(m.Migrated))
+	for i := range m.Migrated {
+		migrated[i] = m.Migrated[i].String()
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    m.Hint().String(),
+			"contract": m.Contract,
+			"sto":      m.Design,
+			"migrated": migrated,
+			"holders":  m.Holders,
+		},
+	)
+}
+
+type MigratingStateValueBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Contract string   `bson:"contract"`
+	STO      bson.Raw `bson:"sto"`
+	Migrated []string `bson:"migrated"`
+	Holders  uint64   `bson:"holders"`
+}
+
+func (m *MigratingStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MigratingStateValue")
+
+	var u MigratingStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(ht)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
+
+	var design stotypes.Design
+	if err := design.DecodeBSON(u.STO, enc); err != nil {
+		return e.Wrap(err)
+	}
+	m.Design = design
+
+	m.Migrated = make([]common.Big, len(u.Migrated))
+	for i := range u.Migrated {
+		big, err := common.NewBigFromString(u.Migrated[i])
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		m.Migrated[i] = big
+	}
+
+	m.Holders = u.Holders
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
 	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -225,3 +226,302 @@ func (oth *OperatorTokenHoldersStateValue) DecodeJSON(b []byte, enc *jsonenc.Enc
 
 	return nil
 }
+
+type TokenHolderOperatorsStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Operators []base.Address `json:"operators"`
+}
+
+func (ops TokenHolderOperatorsStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TokenHolderOperatorsStateValueJSONMarshaler{
+		BaseHinter: ops.BaseHinter,
+		Operators:  ops.Operators,
+	})
+}
+
+type TokenHolderOperatorsStateValueJSONUnmarshaler struct {
+	Operators []string `json:"operators"`
+}
+
+func (ops *TokenHolderOperatorsStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of TokenHolderOperatorsStateValue")
+
+	var u TokenHolderOperatorsStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	operators := make([]base.Address, len(u.Operators))
+	for i := range u.Operators {
+		a, err := base.DecodeAddress(u.Operators[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		operators[i] = a
+	}
+	ops.Operators = operators
+
+	return nil
+}
+
+type OperatorAllowanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Amount string      `json:"amount"`
+	Expiry base.Height `json:"expiry"`
+}
+
+func (sv OperatorAllowanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperatorAllowanceStateValueJSONMarshaler{
+		BaseHinter: sv.BaseHinter,
+		Amount:     sv.Amount.String(),
+		Expiry:     sv.Expiry,
+	})
+}
+
+type OperatorAllowanceStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint   `json:"_hint"`
+	Amount string      `json:"amount"`
+	Expiry base.Height `json:"expiry"`
+}
+
+func (sv *OperatorAllowanceStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of OperatorAllowanceStateValue")
+
+	var u OperatorAllowanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	sv.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	big, err := common.NewBigFromString(u.Amount)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sv.Amount = big
+	sv.Expiry = u.Expiry
+
+	return nil
+}
+
+type OperatorTokenHolderStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Authorized bool `json:"authorized"`
+}
+
+func (o OperatorTokenHolderStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperatorTokenHolderStateValueJSONMarshaler{
+		BaseHinter: o.BaseHinter,
+		Authorized: o.Authorized,
+	})
+}
+
+type OperatorTokenHolderStateValueJSONUnmarshaler struct {
+	Authorized bool `json:"authorized"`
+}
+
+func (o *OperatorTokenHolderStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of OperatorTokenHolderStateValue")
+
+	var u OperatorTokenHolderStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+	o.Authorized = u.Authorized
+
+	return nil
+}
+
+type OperatorTokenHoldersCountStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Count uint64 `json:"count"`
+}
+
+func (o OperatorTokenHoldersCountStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperatorTokenHoldersCountStateValueJSONMarshaler{
+		BaseHinter: o.BaseHinter,
+		Count:      o.Count,
+	})
+}
+
+type OperatorTokenHoldersCountStateValueJSONUnmarshaler struct {
+	Count uint64 `json:"count"`
+}
+
+func (o *OperatorTokenHoldersCountStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of OperatorTokenHoldersCountStateValue")
+
+	var u OperatorTokenHoldersCountStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+	o.Count = u.Count
+
+	return nil
+}
+
+type JurisdictionHoldersCountStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Count uint64 `json:"count"`
+}
+
+func (j JurisdictionHoldersCountStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(JurisdictionHoldersCountStateValueJSONMarshaler{
+		BaseHinter: j.BaseHinter,
+		Count:      j.Count,
+	})
+}
+
+type JurisdictionHoldersCountStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Count uint64    `json:"count"`
+}
+
+func (j *JurisdictionHoldersCountStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of JurisdictionHoldersCountStateValue")
+
+	var u JurisdictionHoldersCountStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	j.BaseHinter = hint.NewBaseHinter(u.Hint)
+	j.Count = u.Count
+
+	return nil
+}
+
+type TokenHolderJurisdictionStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Jurisdiction kyctypes.Jurisdiction `json:"jurisdiction"`
+}
+
+func (t TokenHolderJurisdictionStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TokenHolderJurisdictionStateValueJSONMarshaler{
+		BaseHinter:   t.BaseHinter,
+		Jurisdiction: t.Jurisdiction,
+	})
+}
+
+type TokenHolderJurisdictionStateValueJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	Jurisdiction string    `json:"jurisdiction"`
+}
+
+func (t *TokenHolderJurisdictionStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of TokenHolderJurisdictionStateValue")
+
+	var u TokenHolderJurisdictionStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	t.BaseHinter = hint.NewBaseHinter(u.Hint)
+	t.Jurisdiction = kyctypes.Jurisdiction(u.Jurisdiction)
+
+	return nil
+}
+
+type MigratedStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address `json:"contract"`
+}
+
+func (m MigratedStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MigratedStateValueJSONMarshaler{
+		BaseHinter: m.BaseHinter,
+		Contract:   m.Contract,
+	})
+}
+
+type MigratedStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+}
+
+func (m *MigratedStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigratedStateValue")
+
+	var u MigratedStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
+
+	return nil
+}
+
+type MigratingStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address    `json:"contract"`
+	STO      stotypes.Design `json:"sto"`
+	Migrated []string        `json:"migrated"`
+	Holders  uint64          `json:"holders"`
+}
+
+func (m MigratingStateValue) MarshalJSON() ([]byte, error) {
+	migrated := make([]string, len(m.Migrated))
+	for i := range m.Migrated {
+		migrated[i] = m.Migrated[i].String()
+	}
+
+	return util.MarshalJSON(MigratingStateValueJSONMarshaler{
+		BaseHinter: m.BaseHinter,
+		Contract:   m.Contract,
+		STO:        m.Design,
+		Migrated:   migrated,
+		Holders:    m.Holders,
+	})
+}
+
+type MigratingStateValueJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Contract string          `json:"contract"`
+	STO      json.RawMessage `json:"sto"`
+	Migrated []string        `json:"migrated"`
+	Holders  uint64          `json:"holders"`
+}
+
+func (m *MigratingStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of MigratingStateValue")
+
+	var u MigratingStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	m.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	a, err := base.DecodeAddress(u.Contract, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	m.Contract = a
+
+	var design stotypes.Design
+	if err := design.DecodeJSON(u.STO, enc); err != nil {
+		return e.Wrap(err)
+	}
+	m.Design = design
+
+	m.Migrated = make([]common.Big, len(u.Migrated))
+	for i := range u.Migrated {
+		big, err := common.NewBigFromString(u.Migrated[i])
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		m.Migrated[i] = big
+	}
+
+	m.Holders = u.Holders
+
+	return nil
+}
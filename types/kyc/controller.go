@@ -0,0 +1,124 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	ControllerHint = hint.MustNewHint("mitum-kyc-controller-v0.0.1")
+)
+
+// Role scopes what a controller can do in kyc service. Roles do not include
+// each other; a controller which should both review and approve customers
+// needs both roles.
+type Role string
+
+const (
+	// RoleReviewer can add pending customers.
+	RoleReviewer Role = "reviewer"
+	// RoleApprover can update, renew and remove customers, and so approve
+	// pending customers. Adding approved customers needs reviewer and
+	// approver roles together.
+	RoleApprover Role = "approver"
+	// RoleAdmin can add and remove controllers.
+	RoleAdmin Role = "admin"
+)
+
+func (r Role) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid([]byte) error {
+	switch r {
+	case RoleReviewer, RoleApprover, RoleAdmin:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown controller role, %q", r)
+	}
+}
+
+type Controller struct {
+	hint.BaseHinter
+	address base.Address
+	roles   []Role
+}
+
+func NewController(address base.Address, roles []Role) Controller {
+	return Controller{
+		BaseHinter: hint.NewBaseHinter(ControllerHint),
+		address:    address,
+		roles:      roles,
+	}
+}
+
+func (c Controller) Bytes() []byte {
+	bs := make([][]byte, len(c.roles))
+	for i := range c.roles {
+		bs[i] = c.roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		c.address.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (c Controller) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, c.BaseHinter, c.address); err != nil {
+		return util.ErrInvalid.Errorf("invalid Controller: %v", err)
+	}
+
+	if len(c.roles) < 1 {
+		return util.ErrInvalid.Errorf("empty roles of controller, %q", c.address)
+	}
+
+	founds := map[Role]struct{}{}
+	for _, r := range c.roles {
+		if err := r.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[r]; found {
+			return util.ErrInvalid.Errorf("duplicate role of controller found, %q, %q", c.address, r)
+		}
+
+		founds[r] = struct{}{}
+	}
+
+	return nil
+}
+
+func (c Controller) Address() base.Address {
+	return c.address
+}
+
+func (c Controller) Roles() []Role {
+	return c.roles
+}
+
+// HasRoles reports whether controller has every one of roles.
+func (c Controller) HasRoles(roles ...Role) bool {
+	for _, r := range roles {
+		var found bool
+
+		for _, cr := range c.roles {
+			if cr == r {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,41 @@
+package kyc
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (c Controller) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   c.Hint().String(),
+			"address": c.address,
+			"roles":   c.roles,
+		},
+	)
+}
+
+type ControllerBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Address string   `bson:"address"`
+	Roles   []string `bson:"roles"`
+}
+
+func (c *Controller) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Controller")
+
+	var u ControllerBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, ht, u.Address, u.Roles)
+}
@@ -0,0 +1,29 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (c *Controller) unpack(enc encoder.Encoder, ht hint.Hint, ad string, rs []string) error {
+	e := util.StringError("failed to unmarshal Controller")
+
+	c.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ad, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		c.address = a
+	}
+
+	roles := make([]Role, len(rs))
+	for i := range rs {
+		roles[i] = Role(rs[i])
+	}
+	c.roles = roles
+
+	return nil
+}
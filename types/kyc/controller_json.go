@@ -0,0 +1,39 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ControllerJSONMarshaler struct {
+	hint.BaseHinter
+	Address base.Address `json:"address"`
+	Roles   []Role       `json:"roles"`
+}
+
+func (c Controller) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ControllerJSONMarshaler{
+		BaseHinter: c.BaseHinter,
+		Address:    c.address,
+		Roles:      c.roles,
+	})
+}
+
+type ControllerJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Address string    `json:"address"`
+	Roles   []string  `json:"roles"`
+}
+
+func (c *Controller) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of Controller")
+
+	var u ControllerJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return c.unpack(enc, u.Hint, u.Address, u.Roles)
+}
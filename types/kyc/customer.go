@@ -0,0 +1,229 @@
+package kyc
+
+import (
+	"regexp"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	CustomerInfoHint = hint.MustNewHint("mitum-kyc-customer-info-v0.0.1")
+)
+
+var (
+	MaxLengthCustomerAttribute = 32
+	MaxLengthDocumentHash      = 128
+	ReValidJurisdiction        = regexp.MustCompile(`^[A-Z]{2}$`)
+	ReValidCustomerAttribute   = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
+	ReValidDocumentHash        = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+)
+
+// Jurisdiction is ISO 3166-1 alpha-2 country code of customer, like "US".
+type Jurisdiction string
+
+func (j Jurisdiction) Bytes() []byte {
+	return []byte(j)
+}
+
+func (j Jurisdiction) String() string {
+	return string(j)
+}
+
+func (j Jurisdiction) IsValid([]byte) error {
+	if !ReValidJurisdiction.Match([]byte(j)) {
+		return util.ErrInvalid.Errorf("wrong jurisdiction, %q", j)
+	}
+
+	return nil
+}
+
+// CustomerStatus is the review status of customer record.
+type CustomerStatus string
+
+const (
+	// CustomerStatusPending is the customer waiting for the approvers of kyc
+	// policy; only the pending customer can be approved by ApproveCustomers.
+	CustomerStatusPending CustomerStatus = "pending"
+	// CustomerStatusApproved is the verified customer.
+	CustomerStatusApproved CustomerStatus = "approved"
+	// CustomerStatusRejected is the customer rejected by approver, or whose
+	// approval is revoked.
+	CustomerStatusRejected CustomerStatus = "rejected"
+)
+
+func (s CustomerStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s CustomerStatus) String() string {
+	return string(s)
+}
+
+func (s CustomerStatus) IsValid([]byte) error {
+	switch s {
+	case CustomerStatusPending, CustomerStatusApproved, CustomerStatusRejected:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown customer status, %q", s)
+	}
+}
+
+// CustomerInfo is the kyc record of customer. Accreditation and category are
+// free-form identifiers like "accredited" or "retail" so that compliance rules
+// can be written against them. Expiry is the last height the record is valid
+// at; zero means the record does not expire.
+type CustomerInfo struct {
+	hint.BaseHinter
+	status        CustomerStatus
+	jurisdiction  Jurisdiction
+	accreditation string
+	category      string
+	expiry        uint64
+	documentHash  string
+}
+
+func NewCustomerInfo(
+	status CustomerStatus,
+	jurisdiction Jurisdiction,
+	accreditation, category string,
+	expiry uint64,
+	documentHash string,
+) CustomerInfo {
+	return CustomerInfo{
+		BaseHinter:    hint.NewBaseHinter(CustomerInfoHint),
+		status:        status,
+		jurisdiction:  jurisdiction,
+		accreditation: accreditation,
+		category:      category,
+		expiry:        expiry,
+		documentHash:  documentHash,
+	}
+}
+
+// NewStatusCustomerInfo is the record of legacy customer which only has
+// status. The legacy customer without status is rejected, not pending, so it
+// can not be approved by ApproveCustomers.
+func NewStatusCustomerInfo(status bool) CustomerInfo {
+	if status {
+		return NewCustomerInfo(CustomerStatusApproved, "", "", "", 0, "")
+	}
+
+	return NewCustomerInfo(CustomerStatusRejected, "", "", "", 0, "")
+}
+
+// Bytes prefixes each variable length field with its length, so the records
+// with different fields, like accreditation "retail" and empty category, and
+// empty accreditation and category "retail", do not have the same bytes.
+func (ci CustomerInfo) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		lengthPrefixed(ci.status.Bytes()),
+		lengthPrefixed(ci.jurisdiction.Bytes()),
+		lengthPrefixed([]byte(ci.accreditation)),
+		lengthPrefixed([]byte(ci.category)),
+		util.Uint64ToBytes(ci.expiry),
+		lengthPrefixed([]byte(ci.documentHash)),
+	)
+}
+
+func lengthPrefixed(b []byte) []byte {
+	return util.ConcatBytesSlice(util.Uint64ToBytes(uint64(len(b))), b)
+}
+
+func (ci CustomerInfo) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, ci.BaseHinter, ci.status); err != nil {
+		return util.ErrInvalid.Errorf("invalid CustomerInfo: %v", err)
+	}
+
+	if len(ci.jurisdiction) > 0 {
+		if err := ci.jurisdiction.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range []string{ci.accreditation, ci.category} {
+		if len(a) < 1 {
+			continue
+		}
+
+		if len(a) > MaxLengthCustomerAttribute {
+			return util.ErrInvalid.Errorf(
+				"invalid length of customer attribute, %d > %d", len(a), MaxLengthCustomerAttribute)
+		}
+
+		if !ReValidCustomerAttribute.Match([]byte(a)) {
+			return util.ErrInvalid.Errorf("wrong customer attribute, %q", a)
+		}
+	}
+
+	if l := len(ci.documentHash); l > 0 {
+		if l > MaxLengthDocumentHash {
+			return util.ErrInvalid.Errorf(
+				"invalid length of document hash, %d > %d", l, MaxLengthDocumentHash)
+		}
+
+		if !ReValidDocumentHash.Match([]byte(ci.documentHash)) {
+			return util.ErrInvalid.Errorf("wrong document hash, %q", ci.documentHash)
+		}
+	}
+
+	return nil
+}
+
+func (ci CustomerInfo) Status() CustomerStatus {
+	return ci.status
+}
+
+func (ci CustomerInfo) Jurisdiction() Jurisdiction {
+	return ci.jurisdiction
+}
+
+func (ci CustomerInfo) Accreditation() string {
+	return ci.accreditation
+}
+
+func (ci CustomerInfo) Category() string {
+	return ci.category
+}
+
+func (ci CustomerInfo) Expiry() uint64 {
+	return ci.expiry
+}
+
+func (ci CustomerInfo) DocumentHash() string {
+	return ci.documentHash
+}
+
+func (ci CustomerInfo) SetStatus(status CustomerStatus) CustomerInfo {
+	ci.status = status
+
+	return ci
+}
+
+func (ci CustomerInfo) SetExpiry(expiry uint64) CustomerInfo {
+	ci.expiry = expiry
+
+	return ci
+}
+
+// IsExpired reports whether the record is no longer valid at height.
+func (ci CustomerInfo) IsExpired(height base.Height) bool {
+	return ci.expiry > 0 && uint64(height) > ci.expiry
+}
+
+// IsVerified reports whether customer is approved and the record is not
+// expired at height.
+func (ci CustomerInfo) IsVerified(height base.Height) bool {
+	return ci.IsApproved() && !ci.IsExpired(height)
+}
+
+// IsApproved reports whether customer is approved, regardless of expiry.
+func (ci CustomerInfo) IsApproved() bool {
+	return ci.status == CustomerStatusApproved
+}
+
+// IsPending reports whether customer waits for the approvers.
+func (ci CustomerInfo) IsPending() bool {
+	return ci.status == CustomerStatusPending
+}
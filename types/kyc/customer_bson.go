@@ -0,0 +1,49 @@
+package kyc
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ci CustomerInfo) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         ci.Hint().String(),
+			"status":        ci.status,
+			"jurisdiction":  ci.jurisdiction,
+			"accreditation": ci.accreditation,
+			"category":      ci.category,
+			"expiry_height": ci.expiry,
+			"document_hash": ci.documentHash,
+		},
+	)
+}
+
+type CustomerInfoBSONUnmarshaler struct {
+	Hint          string `bson:"_hint"`
+	Status        string `bson:"status"`
+	Jurisdiction  string `bson:"jurisdiction"`
+	Accreditation string `bson:"accreditation"`
+	Category      string `bson:"category"`
+	Expiry        uint64 `bson:"expiry_height"`
+	DocumentHash  string `bson:"document_hash"`
+}
+
+func (ci *CustomerInfo) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CustomerInfo")
+
+	var u CustomerInfoBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return ci.unpack(ht, u.Status, u.Jurisdiction, u.Accreditation, u.Category, u.Expiry, u.DocumentHash)
+}
@@ -0,0 +1,17 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ci *CustomerInfo) unpack(ht hint.Hint, st string, ju, ac, ca string, ex uint64, dh string) error {
+	ci.BaseHinter = hint.NewBaseHinter(ht)
+	ci.status = CustomerStatus(st)
+	ci.jurisdiction = Jurisdiction(ju)
+	ci.accreditation = ac
+	ci.category = ca
+	ci.expiry = ex
+	ci.documentHash = dh
+
+	return nil
+}
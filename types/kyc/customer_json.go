@@ -0,0 +1,50 @@
+package kyc
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CustomerInfoJSONMarshaler struct {
+	hint.BaseHinter
+	Status        CustomerStatus `json:"status"`
+	Jurisdiction  Jurisdiction   `json:"jurisdiction"`
+	Accreditation string         `json:"accreditation"`
+	Category      string         `json:"category"`
+	Expiry        uint64         `json:"expiry_height"`
+	DocumentHash  string         `json:"document_hash"`
+}
+
+func (ci CustomerInfo) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CustomerInfoJSONMarshaler{
+		BaseHinter:    ci.BaseHinter,
+		Status:        ci.status,
+		Jurisdiction:  ci.jurisdiction,
+		Accreditation: ci.accreditation,
+		Category:      ci.category,
+		Expiry:        ci.expiry,
+		DocumentHash:  ci.documentHash,
+	})
+}
+
+type CustomerInfoJSONUnmarshaler struct {
+	Hint          hint.Hint `json:"_hint"`
+	Status        string    `json:"status"`
+	Jurisdiction  string    `json:"jurisdiction"`
+	Accreditation string    `json:"accreditation"`
+	Category      string    `json:"category"`
+	Expiry        uint64    `json:"expiry_height"`
+	DocumentHash  string    `json:"document_hash"`
+}
+
+func (ci *CustomerInfo) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CustomerInfo")
+
+	var u CustomerInfoJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return ci.unpack(u.Hint, u.Status, u.Jurisdiction, u.Accreditation, u.Category, u.Expiry, u.DocumentHash)
+}
@@ -7,18 +7,35 @@ import (
 )
 
 var (
-	PolicyHint = hint.MustNewHint("mitum-kyc-policy-v0.0.1")
+	PolicyHint = hint.MustNewHint("mitum-kyc-policy-v0.0.3")
+	// LegacyPolicyHint is the policy with controllers without roles; they are
+	// decoded into controllers with reviewer and approver roles, which allow
+	// what the controllers could do before. The policy keeps the legacy hint
+	// and the bytes of addresses until its controllers are changed.
+	LegacyPolicyHint = hint.MustNewHint("mitum-kyc-policy-v0.0.1")
+	// LegacyRolesPolicyHint is the policy without quorum; it is decoded with
+	// quorum 1. The policy keeps the legacy hint and the bytes without quorum
+	// until its controllers are changed.
+	LegacyRolesPolicyHint = hint.MustNewHint("mitum-kyc-policy-v0.0.2")
 )
 
+// LegacyControllerRoles is the roles of the controllers from LegacyPolicyHint.
+var LegacyControllerRoles = []Role{RoleReviewer, RoleApprover}
+
+// Policy is the controllers of kyc service and the quorum, the number of
+// distinct approvers which should approve a pending customer before it is
+// verified. The owner of contract account is counted as an approver.
 type Policy struct {
 	hint.BaseHinter
-	controllers []base.Address
+	controllers []Controller
+	quorum      uint64
 }
 
-func NewPolicy(controllers []base.Address) Policy {
+func NewPolicy(controllers []Controller, quorum uint64) Policy {
 	return Policy{
 		BaseHinter:  hint.NewBaseHinter(PolicyHint),
 		controllers: controllers,
+		quorum:      quorum,
 	}
 }
 
@@ -28,9 +45,21 @@ func (po Policy) Bytes() []byte {
 		bs[i] = p.Bytes()
 	}
 
-	return util.ConcatBytesSlice(
-		bs...,
-	)
+	switch {
+	case po.Hint().Equal(LegacyPolicyHint):
+		for i, p := range po.controllers {
+			bs[i] = p.Address().Bytes()
+		}
+
+		return util.ConcatBytesSlice(bs...)
+	case po.Hint().Equal(LegacyRolesPolicyHint):
+		return util.ConcatBytesSlice(bs...)
+	default:
+		return util.ConcatBytesSlice(
+			util.ConcatBytesSlice(bs...),
+			util.Uint64ToBytes(po.quorum),
+		)
+	}
 }
 
 func (po Policy) IsValid([]byte) error {
@@ -38,15 +67,69 @@ func (po Policy) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid kyc policy: %v", err)
 	}
 
+	if po.quorum < 1 {
+		return util.ErrInvalid.Errorf("zero quorum")
+	}
+
+	approvers := uint64(1) // NOTE owner of contract account
+	founds := map[string]struct{}{}
 	for _, p := range po.controllers {
 		if err := p.IsValid(nil); err != nil {
 			return util.ErrInvalid.Errorf("invalid Controller: %v", err)
 		}
+
+		if _, found := founds[p.Address().String()]; found {
+			return util.ErrInvalid.Errorf("duplicate controller found, %q", p.Address())
+		}
+
+		founds[p.Address().String()] = struct{}{}
+
+		if p.HasRoles(RoleApprover) {
+			approvers++
+		}
+	}
+
+	if po.quorum > approvers {
+		return util.ErrInvalid.Errorf("quorum over approvers, %d > %d", po.quorum, approvers)
 	}
 
 	return nil
 }
 
-func (po Policy) Controllers() []base.Address {
+func (po Policy) Controllers() []Controller {
 	return po.controllers
 }
+
+// SetControllers returns the policy with controllers; the legacy policy is
+// upgraded to PolicyHint.
+func (po Policy) SetControllers(controllers []Controller) Policy {
+	po.BaseHinter = hint.NewBaseHinter(PolicyHint)
+	po.controllers = controllers
+
+	return po
+}
+
+func (po Policy) Quorum() uint64 {
+	return po.quorum
+}
+
+// Controller returns the controller of address; false when address is not a
+// controller.
+func (po Policy) Controller(address base.Address) (Controller, bool) {
+	for _, c := range po.controllers {
+		if c.Address().Equal(address) {
+			return c, true
+		}
+	}
+
+	return Controller{}, false
+}
+
+func (po Policy) addresses() []base.Address {
+	as := make([]base.Address, len(po.controllers))
+	for i, c := range po.controllers {
+		as[i] = c.Address()
+	}
+
+	return as
+}
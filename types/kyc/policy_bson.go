@@ -9,17 +9,36 @@ import (
 )
 
 func (po Policy) MarshalBSON() ([]byte, error) {
+	switch {
+	case po.Hint().Equal(LegacyPolicyHint):
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":       po.Hint().String(),
+				"controllers": po.addresses(),
+			},
+		)
+	case po.Hint().Equal(LegacyRolesPolicyHint):
+		return bsonenc.Marshal(
+			bson.M{
+				"_hint":       po.Hint().String(),
+				"controllers": po.controllers,
+			},
+		)
+	}
+
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":       po.Hint().String(),
 			"controllers": po.controllers,
+			"quorum":      po.quorum,
 		},
 	)
 }
 
 type PolicyBSONUnmarshaler struct {
-	Hint        string   `bson:"_hint"`
-	Controllers []string `bson:"controllers"`
+	Hint        string        `bson:"_hint"`
+	Controllers bson.RawValue `bson:"controllers"`
+	Quorum      uint64        `bson:"quorum"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -35,5 +54,14 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.Controllers)
+	if ht.Equal(LegacyPolicyHint) {
+		var cons []string
+		if err := upo.Controllers.Unmarshal(&cons); err != nil {
+			return e.Wrap(err)
+		}
+
+		return po.unpackLegacy(enc, cons)
+	}
+
+	return po.unpack(enc, ht, upo.Controllers.Value, upo.Quorum)
 }
@@ -5,20 +5,51 @@ import (
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
-func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, bcs []string) error {
-	e := util.StringError("failed to decode bson of Policy")
+func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, bcs []byte, quorum uint64) error {
+	e := util.StringError("failed to decode Policy")
 
 	po.BaseHinter = hint.NewBaseHinter(ht)
+	po.quorum = quorum
 
-	controllers := make([]base.Address, len(bcs))
-	for i := range bcs {
-		ctr, err := base.DecodeAddress(bcs[i], enc)
+	if ht.Equal(LegacyRolesPolicyHint) {
+		po.quorum = 1
+	}
+
+	hcs, err := enc.DecodeSlice(bcs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	controllers := make([]Controller, len(hcs))
+	for i := range hcs {
+		c, ok := hcs[i].(Controller)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected Controller, not %T", hcs[i]))
+		}
+
+		controllers[i] = c
+	}
+	po.controllers = controllers
+
+	return nil
+}
+
+func (po *Policy) unpackLegacy(enc encoder.Encoder, cons []string) error {
+	e := util.StringError("failed to decode legacy Policy")
+
+	po.BaseHinter = hint.NewBaseHinter(LegacyPolicyHint)
+	po.quorum = 1
+
+	controllers := make([]Controller, len(cons))
+	for i := range cons {
+		ctr, err := base.DecodeAddress(cons[i], enc)
 		if err != nil {
 			return e.Wrap(err)
 		}
-		controllers[i] = ctr
+		controllers[i] = NewController(ctr, LegacyControllerRoles)
 	}
 	po.controllers = controllers
 
@@ -1,6 +1,8 @@
 package kyc
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -8,20 +10,46 @@ import (
 )
 
 type PolicyJSONMarshaler struct {
+	hint.BaseHinter
+	Controllers []Controller `json:"controllers"`
+	Quorum      uint64       `json:"quorum"`
+}
+
+type LegacyPolicyJSONMarshaler struct {
 	hint.BaseHinter
 	Controllers []base.Address `json:"controllers"`
 }
 
+type LegacyRolesPolicyJSONMarshaler struct {
+	hint.BaseHinter
+	Controllers []Controller `json:"controllers"`
+}
+
 func (po Policy) MarshalJSON() ([]byte, error) {
+	switch {
+	case po.Hint().Equal(LegacyPolicyHint):
+		return util.MarshalJSON(LegacyPolicyJSONMarshaler{
+			BaseHinter:  po.BaseHinter,
+			Controllers: po.addresses(),
+		})
+	case po.Hint().Equal(LegacyRolesPolicyHint):
+		return util.MarshalJSON(LegacyRolesPolicyJSONMarshaler{
+			BaseHinter:  po.BaseHinter,
+			Controllers: po.controllers,
+		})
+	}
+
 	return util.MarshalJSON(PolicyJSONMarshaler{
 		BaseHinter:  po.BaseHinter,
 		Controllers: po.controllers,
+		Quorum:      po.quorum,
 	})
 }
 
 type PolicyJSONUnmarshaler struct {
-	Hint        hint.Hint `json:"_hint"`
-	Controllers []string  `json:"controllers"`
+	Hint        hint.Hint       `json:"_hint"`
+	Controllers json.RawMessage `json:"controllers"`
+	Quorum      uint64          `json:"quorum"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -32,5 +60,14 @@ func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.Controllers)
+	if upo.Hint.Equal(LegacyPolicyHint) {
+		var cons []string
+		if err := enc.Unmarshal(upo.Controllers, &cons); err != nil {
+			return e.Wrap(err)
+		}
+
+		return po.unpackLegacy(enc, cons)
+	}
+
+	return po.unpack(enc, upo.Hint, upo.Controllers, upo.Quorum)
 }
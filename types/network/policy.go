@@ -0,0 +1,150 @@
+package network
+
+import (
+	stotypes "github.com/ProtoconNet/mitum-sto/types/sto"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	NetworkPolicyHint = hint.MustNewHint("mitum-sto-network-policy-v0.0.1")
+)
+
+var (
+	// MaxItemsLimit is the upper bound of items in one operation regardless
+	// of NetworkPolicy.
+	MaxItemsLimit uint64 = 1000
+
+	DefaultMaxItems                 uint64 = 10
+	DefaultMinLengthPartition       uint64 = 3
+	DefaultMaxLengthPartition       uint64 = 10
+	DefaultMaxOperatorsInOperators  uint64 = 10
+	DefaultMaxTokenHoldersInHolders uint64 = 10000
+)
+
+// NetworkPolicy keeps the limits of sto and kyc operations. It is stored in
+// state by genesis and can be updated by suffrage, so the limits can be
+// changed without upgrading binaries.
+type NetworkPolicy struct {
+	hint.BaseHinter
+	maxItems                 uint64
+	minLengthPartition       uint64
+	maxLengthPartition       uint64
+	maxOperatorsInOperators  uint64
+	maxTokenHoldersInHolders uint64
+}
+
+func NewNetworkPolicy(
+	maxItems, minLengthPartition, maxLengthPartition, maxOperatorsInOperators, maxTokenHoldersInHolders uint64,
+) NetworkPolicy {
+	return NetworkPolicy{
+		BaseHinter:               hint.NewBaseHinter(NetworkPolicyHint),
+		maxItems:                 maxItems,
+		minLengthPartition:       minLengthPartition,
+		maxLengthPartition:       maxLengthPartition,
+		maxOperatorsInOperators:  maxOperatorsInOperators,
+		maxTokenHoldersInHolders: maxTokenHoldersInHolders,
+	}
+}
+
+func DefaultNetworkPolicy() NetworkPolicy {
+	return NewNetworkPolicy(
+		DefaultMaxItems,
+		DefaultMinLengthPartition,
+		DefaultMaxLengthPartition,
+		DefaultMaxOperatorsInOperators,
+		DefaultMaxTokenHoldersInHolders,
+	)
+}
+
+func (p NetworkPolicy) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(p.maxItems),
+		util.Uint64ToBytes(p.minLengthPartition),
+		util.Uint64ToBytes(p.maxLengthPartition),
+		util.Uint64ToBytes(p.maxOperatorsInOperators),
+		util.Uint64ToBytes(p.maxTokenHoldersInHolders),
+	)
+}
+
+func (p NetworkPolicy) HashBytes() []byte {
+	return p.Bytes()
+}
+
+func (p NetworkPolicy) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid NetworkPolicy")
+
+	if err := p.BaseHinter.IsValid(NetworkPolicyHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if p.maxItems < 1 || p.maxItems > MaxItemsLimit {
+		return e.Errorf("max items out of range, 1 <= %d <= %d", p.maxItems, MaxItemsLimit)
+	}
+
+	// NOTE the partitions are also checked by stotypes.Partition, so the
+	// lengths can not go beyond its bounds
+	if p.minLengthPartition < uint64(stotypes.MinLengthPartition) {
+		return e.Errorf(
+			"min length of partition under %d, %d", stotypes.MinLengthPartition, p.minLengthPartition)
+	}
+
+	if p.maxLengthPartition > uint64(stotypes.MaxLengthPartition) {
+		return e.Errorf(
+			"max length of partition over %d, %d", stotypes.MaxLengthPartition, p.maxLengthPartition)
+	}
+
+	if p.maxLengthPartition < p.minLengthPartition {
+		return e.Errorf(
+			"max length of partition under min length, %d < %d", p.maxLengthPartition, p.minLengthPartition)
+	}
+
+	if p.maxOperatorsInOperators < 1 {
+		return e.Errorf("under zero max operators of tokenholder")
+	}
+
+	if p.maxTokenHoldersInHolders < 1 {
+		return e.Errorf("under zero max tokenholders of operator")
+	}
+
+	return nil
+}
+
+func (p NetworkPolicy) MaxItems() uint64 {
+	return p.maxItems
+}
+
+func (p NetworkPolicy) MinLengthPartition() uint64 {
+	return p.minLengthPartition
+}
+
+func (p NetworkPolicy) MaxLengthPartition() uint64 {
+	return p.maxLengthPartition
+}
+
+func (p NetworkPolicy) MaxOperatorsInOperators() uint64 {
+	return p.maxOperatorsInOperators
+}
+
+func (p NetworkPolicy) MaxTokenHoldersInHolders() uint64 {
+	return p.maxTokenHoldersInHolders
+}
+
+// CheckItems checks the number of items of an operation.
+func (p NetworkPolicy) CheckItems(n int) error {
+	if uint64(n) > p.maxItems {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, p.maxItems)
+	}
+
+	return nil
+}
+
+// CheckPartition checks the length of partition.
+func (p NetworkPolicy) CheckPartition(partition string) error {
+	if l := uint64(len(partition)); l < p.minLengthPartition || l > p.maxLengthPartition {
+		return util.ErrInvalid.Errorf(
+			"invalid length of partition, %d <= %d <= %d", p.minLengthPartition, l, p.maxLengthPartition)
+	}
+
+	return nil
+}
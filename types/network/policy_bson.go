@@ -0,0 +1,49 @@
+package network
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (p NetworkPolicy) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":                       p.Hint().String(),
+			"max_items":                   p.maxItems,
+			"min_length_partition":        p.minLengthPartition,
+			"max_length_partition":        p.maxLengthPartition,
+			"max_operators_in_operators":  p.maxOperatorsInOperators,
+			"max_tokenholders_in_holders": p.maxTokenHoldersInHolders,
+		},
+	)
+}
+
+type NetworkPolicyBSONUnmarshaler struct {
+	Hint                     string `bson:"_hint"`
+	MaxItems                 uint64 `bson:"max_items"`
+	MinLengthPartition       uint64 `bson:"min_length_partition"`
+	MaxLengthPartition       uint64 `bson:"max_length_partition"`
+	MaxOperatorsInOperators  uint64 `bson:"max_operators_in_operators"`
+	MaxTokenHoldersInHolders uint64 `bson:"max_tokenholders_in_holders"`
+}
+
+func (p *NetworkPolicy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of NetworkPolicy")
+
+	var u NetworkPolicyBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	p.unpack(ht, u.MaxItems, u.MinLengthPartition, u.MaxLengthPartition, u.MaxOperatorsInOperators, u.MaxTokenHoldersInHolders)
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package network
+
+import (
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (p *NetworkPolicy) unpack(ht hint.Hint, mi, minp, maxp, mo, mt uint64) {
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.maxItems = mi
+	p.minLengthPartition = minp
+	p.maxLengthPartition = maxp
+	p.maxOperatorsInOperators = mo
+	p.maxTokenHoldersInHolders = mt
+}
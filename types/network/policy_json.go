@@ -0,0 +1,49 @@
+package network
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type NetworkPolicyJSONMarshaler struct {
+	hint.BaseHinter
+	MaxItems                 uint64 `json:"max_items"`
+	MinLengthPartition       uint64 `json:"min_length_partition"`
+	MaxLengthPartition       uint64 `json:"max_length_partition"`
+	MaxOperatorsInOperators  uint64 `json:"max_operators_in_operators"`
+	MaxTokenHoldersInHolders uint64 `json:"max_tokenholders_in_holders"`
+}
+
+func (p NetworkPolicy) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(NetworkPolicyJSONMarshaler{
+		BaseHinter:               p.BaseHinter,
+		MaxItems:                 p.maxItems,
+		MinLengthPartition:       p.minLengthPartition,
+		MaxLengthPartition:       p.maxLengthPartition,
+		MaxOperatorsInOperators:  p.maxOperatorsInOperators,
+		MaxTokenHoldersInHolders: p.maxTokenHoldersInHolders,
+	})
+}
+
+type NetworkPolicyJSONUnmarshaler struct {
+	Hint                     hint.Hint `json:"_hint"`
+	MaxItems                 uint64    `json:"max_items"`
+	MinLengthPartition       uint64    `json:"min_length_partition"`
+	MaxLengthPartition       uint64    `json:"max_length_partition"`
+	MaxOperatorsInOperators  uint64    `json:"max_operators_in_operators"`
+	MaxTokenHoldersInHolders uint64    `json:"max_tokenholders_in_holders"`
+}
+
+func (p *NetworkPolicy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of NetworkPolicy")
+
+	var u NetworkPolicyJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	p.unpack(u.Hint, u.MaxItems, u.MinLengthPartition, u.MaxLengthPartition, u.MaxOperatorsInOperators, u.MaxTokenHoldersInHolders)
+
+	return nil
+}
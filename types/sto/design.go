@@ -7,22 +7,37 @@ import (
 )
 
 var (
-	DesignHint = hint.MustNewHint("mitum-sto-design-v0.0.1")
+	DesignHint = hint.MustNewHint("mitum-sto-design-v0.0.3")
+	// LegacyDesignHint is the design without jurisdiction rule; it is decoded
+	// into Design with empty jurisdiction rule.
+	LegacyDesignHint = hint.MustNewHint("mitum-sto-design-v0.0.1")
+	// LegacyJurisdictionsDesignHint is the design without fee schedule; it is
+	// decoded into Design with empty fee schedule.
+	LegacyJurisdictionsDesignHint = hint.MustNewHint("mitum-sto-design-v0.0.2")
 )
 
 type Design struct {
 	hint.BaseHinter
-	stoID       currencytypes.ContractID
-	granularity uint64
-	policy      Policy
+	stoID         currencytypes.ContractID
+	granularity   uint64
+	policy        Policy
+	jurisdictions JurisdictionRule
+	fees          FeeSchedule
 }
 
-func NewDesign(stoID currencytypes.ContractID, granularity uint64, policy Policy) Design {
+func NewDesign(
+	stoID currencytypes.ContractID,
+	granularity uint64,
+	policy Policy,
+	jurisdictions JurisdictionRule,
+) Design {
 	return Design{
-		BaseHinter:  hint.NewBaseHinter(DesignHint),
-		stoID:       stoID,
-		granularity: granularity,
-		policy:      policy,
+		BaseHinter:    hint.NewBaseHinter(DesignHint),
+		stoID:         stoID,
+		granularity:   granularity,
+		policy:        policy,
+		jurisdictions: jurisdictions,
+		fees:          EmptyFeeSchedule(),
 	}
 }
 
@@ -31,6 +46,8 @@ func (s Design) IsValid([]byte) error {
 		s.BaseHinter,
 		s.stoID,
 		s.policy,
+		s.jurisdictions,
+		s.fees,
 	); err != nil {
 		return util.ErrInvalid.Errorf("invalid Design: %v", err)
 	}
@@ -39,6 +56,10 @@ func (s Design) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid ContractID: %v", err)
 	}
 
+	if !s.jurisdictions.IsEmpty() && s.policy.KYC().IsEmpty() {
+		return util.ErrInvalid.Errorf("jurisdiction rule needs kyc requirement, %s", s.stoID)
+	}
+
 	return s.policy.IsValid(nil)
 }
 
@@ -47,6 +68,8 @@ func (s Design) Bytes() []byte {
 		s.stoID.Bytes(),
 		util.Uint64ToBigBytes(s.granularity),
 		s.policy.Bytes(),
+		s.jurisdictions.Bytes(),
+		s.fees.Bytes(),
 	)
 }
 
@@ -67,3 +90,23 @@ func (s Design) SetPolicy(po Policy) Design {
 
 	return s
 }
+
+func (s Design) Jurisdictions() JurisdictionRule {
+	return s.jurisdictions
+}
+
+func (s Design) SetJurisdictions(jurisdictions JurisdictionRule) Design {
+	s.jurisdictions = jurisdictions
+
+	return s
+}
+
+func (s Design) Fees() FeeSchedule {
+	return s.fees
+}
+
+func (s Design) SetFees(fees FeeSchedule) Design {
+	s.fees = fees
+
+	return s
+}
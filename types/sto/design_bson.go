@@ -11,19 +11,23 @@ import (
 func (de Design) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":       de.Hint().String(),
-			"stoid":       de.stoID,
-			"granularity": de.granularity,
-			"policy":      de.policy,
+			"_hint":         de.Hint().String(),
+			"stoid":         de.stoID,
+			"granularity":   de.granularity,
+			"policy":        de.policy,
+			"jurisdictions": de.jurisdictions,
+			"fees":          de.fees,
 		},
 	)
 }
 
 type DesignBSONUnmarshaler struct {
-	Hint        string   `bson:"_hint"`
-	STO         string   `bson:"stoid"`
-	Granularity uint64   `bson:"granularity"`
-	Policy      bson.Raw `bson:"policy"`
+	Hint          string   `bson:"_hint"`
+	STO           string   `bson:"stoid"`
+	Granularity   uint64   `bson:"granularity"`
+	Policy        bson.Raw `bson:"policy"`
+	Jurisdictions bson.Raw `bson:"jurisdictions"`
+	Fees          bson.Raw `bson:"fees"`
 }
 
 func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -39,5 +43,5 @@ func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ht, ud.STO, ud.Granularity, ud.Policy)
+	return de.unpack(enc, ht, ud.STO, ud.Granularity, ud.Policy, ud.Jurisdictions, ud.Fees)
 }
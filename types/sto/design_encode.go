@@ -8,10 +8,13 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, sto string, gra uint64, bpo []byte) error {
+func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, sto string, gra uint64, bpo, bj, bf []byte) error {
 	e := util.StringError("failed to decode bson of Design")
 
 	de.BaseHinter = hint.NewBaseHinter(ht)
+	if ht.Equal(LegacyDesignHint) || ht.Equal(LegacyJurisdictionsDesignHint) {
+		de.BaseHinter = hint.NewBaseHinter(DesignHint)
+	}
 	de.stoID = currencytypes.ContractID(sto)
 	de.granularity = gra
 
@@ -23,5 +26,34 @@ func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, sto string, gra uint
 		de.policy = po
 	}
 
+	if ht.Equal(LegacyDesignHint) {
+		de.jurisdictions = EmptyJurisdictionRule()
+		de.fees = EmptyFeeSchedule()
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bj); err != nil {
+		return e.Wrap(err)
+	} else if jr, ok := hinter.(JurisdictionRule); !ok {
+		return e.Wrap(errors.Errorf("expected JurisdictionRule, not %T", hinter))
+	} else {
+		de.jurisdictions = jr
+	}
+
+	if ht.Equal(LegacyJurisdictionsDesignHint) {
+		de.fees = EmptyFeeSchedule()
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bf); err != nil {
+		return e.Wrap(err)
+	} else if fs, ok := hinter.(FeeSchedule); !ok {
+		return e.Wrap(errors.Errorf("expected FeeSchedule, not %T", hinter))
+	} else {
+		de.fees = fs
+	}
+
 	return nil
 }
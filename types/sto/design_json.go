@@ -11,25 +11,31 @@ import (
 
 type DesignJSONMarshaler struct {
 	hint.BaseHinter
-	STO         currencytypes.ContractID `json:"stoid"`
-	Granularity uint64                   `json:"granularity"`
-	Policy      Policy                   `json:"policy"`
+	STO           currencytypes.ContractID `json:"stoid"`
+	Granularity   uint64                   `json:"granularity"`
+	Policy        Policy                   `json:"policy"`
+	Jurisdictions JurisdictionRule         `json:"jurisdictions"`
+	Fees          FeeSchedule              `json:"fees"`
 }
 
 func (de Design) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(DesignJSONMarshaler{
-		BaseHinter:  de.BaseHinter,
-		STO:         de.stoID,
-		Granularity: de.granularity,
-		Policy:      de.policy,
+		BaseHinter:    de.BaseHinter,
+		STO:           de.stoID,
+		Granularity:   de.granularity,
+		Policy:        de.policy,
+		Jurisdictions: de.jurisdictions,
+		Fees:          de.fees,
 	})
 }
 
 type DesignJSONUnmarshaler struct {
-	Hint        hint.Hint       `json:"_hint"`
-	STO         string          `json:"stoid"`
-	Granularity uint64          `json:"granularity"`
-	Policy      json.RawMessage `json:"policy"`
+	Hint          hint.Hint       `json:"_hint"`
+	STO           string          `json:"stoid"`
+	Granularity   uint64          `json:"granularity"`
+	Policy        json.RawMessage `json:"policy"`
+	Jurisdictions json.RawMessage `json:"jurisdictions"`
+	Fees          json.RawMessage `json:"fees"`
 }
 
 func (de *Design) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -40,5 +46,5 @@ func (de *Design) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ud.Hint, ud.STO, ud.Granularity, ud.Policy)
+	return de.unpack(enc, ud.Hint, ud.STO, ud.Granularity, ud.Policy, ud.Jurisdictions, ud.Fees)
 }
@@ -39,7 +39,7 @@ type Document struct {
 
 func NewDocument(stoID currencytypes.ContractID, title, hash string, uri URI) Document {
 	return Document{
-		BaseHinter: hint.NewBaseHinter(DesignHint),
+		BaseHinter: hint.NewBaseHinter(DocumentHint),
 		stoID:      stoID,
 		title:      title,
 		hash:       hash,
@@ -53,7 +53,7 @@ func (s Document) IsValid([]byte) error {
 		s.stoID,
 		s.uri,
 	); err != nil {
-		return util.ErrInvalid.Errorf("invalid Design: %v", err)
+		return util.ErrInvalid.Errorf("invalid Document: %v", err)
 	}
 
 	return nil
@@ -0,0 +1,212 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	ServiceFeeHint  = hint.MustNewHint("mitum-sto-service-fee-v0.0.1")
+	FeeScheduleHint = hint.MustNewHint("mitum-sto-fee-schedule-v0.0.1")
+)
+
+// MaxFeeRate is the rate of service fee in basis points which takes the whole
+// amount.
+var MaxFeeRate uint64 = 10000
+
+type FeeOperation string
+
+const (
+	FeeOperationIssue                   FeeOperation = "issue"
+	FeeOperationTransfer                FeeOperation = "transfer"
+	FeeOperationRedeem                  FeeOperation = "redeem"
+	FeeOperationAuthorizeOperator       FeeOperation = "authorize-operator"
+	FeeOperationRevokeOperator          FeeOperation = "revoke-operator"
+	FeeOperationAuthorizeGlobalOperator FeeOperation = "authorize-global-operator"
+	FeeOperationRevokeGlobalOperator    FeeOperation = "revoke-global-operator"
+)
+
+func (o FeeOperation) Bytes() []byte {
+	return []byte(o)
+}
+
+func (o FeeOperation) String() string {
+	return string(o)
+}
+
+func (o FeeOperation) IsValid([]byte) error {
+	switch o {
+	case FeeOperationIssue,
+		FeeOperationTransfer,
+		FeeOperationRedeem,
+		FeeOperationAuthorizeOperator,
+		FeeOperationRevokeOperator,
+		FeeOperationAuthorizeGlobalOperator,
+		FeeOperationRevokeGlobalOperator:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown fee operation, %q", o)
+	}
+}
+
+// HasAmount returns true when the items of operation carry the amount of
+// tokens, which the rate of service fee is applied to.
+func (o FeeOperation) HasAmount() bool {
+	switch o {
+	case FeeOperationIssue, FeeOperationTransfer, FeeOperationRedeem:
+		return true
+	default:
+		return false
+	}
+}
+
+// ServiceFee is the fee of operation charged by the sto in currency; flat
+// amount per item and rate, in basis points of the token amount of item.
+type ServiceFee struct {
+	hint.BaseHinter
+	operation FeeOperation
+	currency  currencytypes.CurrencyID
+	flat      common.Big
+	rate      uint64
+}
+
+func NewServiceFee(
+	operation FeeOperation, currency currencytypes.CurrencyID, flat common.Big, rate uint64,
+) ServiceFee {
+	return ServiceFee{
+		BaseHinter: hint.NewBaseHinter(ServiceFeeHint),
+		operation:  operation,
+		currency:   currency,
+		flat:       flat,
+		rate:       rate,
+	}
+}
+
+func (f ServiceFee) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		f.operation.Bytes(),
+		f.currency.Bytes(),
+		f.flat.Bytes(),
+		util.Uint64ToBytes(f.rate),
+	)
+}
+
+func (f ServiceFee) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, f.BaseHinter, f.operation, f.currency); err != nil {
+		return util.ErrInvalid.Errorf("invalid ServiceFee: %v", err)
+	}
+
+	if !f.flat.OverNil() {
+		return util.ErrInvalid.Errorf("flat fee of %q under zero, %q", f.operation, f.flat)
+	}
+
+	if f.rate > MaxFeeRate {
+		return util.ErrInvalid.Errorf("fee rate of %q over max, %d > %d", f.operation, f.rate, MaxFeeRate)
+	}
+
+	if f.rate > 0 && !f.operation.HasAmount() {
+		return util.ErrInvalid.Errorf("fee rate of operation without amount, %q", f.operation)
+	}
+
+	if f.flat.IsZero() && f.rate < 1 {
+		return util.ErrInvalid.Errorf("zero service fee of %q; remove it instead", f.operation)
+	}
+
+	return nil
+}
+
+func (f ServiceFee) Operation() FeeOperation {
+	return f.operation
+}
+
+func (f ServiceFee) Currency() currencytypes.CurrencyID {
+	return f.currency
+}
+
+func (f ServiceFee) Flat() common.Big {
+	return f.flat
+}
+
+func (f ServiceFee) Rate() uint64 {
+	return f.rate
+}
+
+// Fee returns the service fee of item with amount of tokens; the rate part is
+// rounded down.
+func (f ServiceFee) Fee(amount common.Big) common.Big {
+	if f.rate < 1 || !amount.OverZero() {
+		return f.flat
+	}
+
+	return f.flat.Add(amount.MulInt64(int64(f.rate)).Div(common.NewBig(int64(MaxFeeRate))))
+}
+
+// FeeSchedule is the service fees of sto by operation. The fees are paid to
+// the owner of contract account in addition to the fee of currency.
+type FeeSchedule struct {
+	hint.BaseHinter
+	fees []ServiceFee
+}
+
+func NewFeeSchedule(fees []ServiceFee) FeeSchedule {
+	return FeeSchedule{
+		BaseHinter: hint.NewBaseHinter(FeeScheduleHint),
+		fees:       fees,
+	}
+}
+
+// EmptyFeeSchedule is the schedule which does not charge service fees.
+func EmptyFeeSchedule() FeeSchedule {
+	return NewFeeSchedule(nil)
+}
+
+func (s FeeSchedule) Bytes() []byte {
+	bs := make([][]byte, len(s.fees))
+	for i := range s.fees {
+		bs[i] = s.fees[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (s FeeSchedule) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, s.BaseHinter); err != nil {
+		return util.ErrInvalid.Errorf("invalid FeeSchedule: %v", err)
+	}
+
+	founds := map[FeeOperation]struct{}{}
+	for _, f := range s.fees {
+		if err := f.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[f.Operation()]; found {
+			return util.ErrInvalid.Errorf("duplicate service fee of operation found, %q", f.Operation())
+		}
+
+		founds[f.Operation()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (s FeeSchedule) Fees() []ServiceFee {
+	return s.fees
+}
+
+func (s FeeSchedule) IsEmpty() bool {
+	return len(s.fees) < 1
+}
+
+// Fee returns the service fee of operation; false when it is not charged.
+func (s FeeSchedule) Fee(operation FeeOperation) (ServiceFee, bool) {
+	for _, f := range s.fees {
+		if f.Operation() == operation {
+			return f, true
+		}
+	}
+
+	return ServiceFee{}, false
+}
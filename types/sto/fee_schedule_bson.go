@@ -0,0 +1,75 @@
+package sto
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (f ServiceFee) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     f.Hint().String(),
+			"operation": f.operation,
+			"currency":  f.currency,
+			"flat":      f.flat.String(),
+			"rate":      f.rate,
+		},
+	)
+}
+
+type ServiceFeeBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Operation string `bson:"operation"`
+	Currency  string `bson:"currency"`
+	Flat      string `bson:"flat"`
+	Rate      uint64 `bson:"rate"`
+}
+
+func (f *ServiceFee) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ServiceFee")
+
+	var u ServiceFeeBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return f.unpack(ht, u.Operation, u.Currency, u.Flat, u.Rate)
+}
+
+func (s FeeSchedule) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": s.Hint().String(),
+			"fees":  s.fees,
+		},
+	)
+}
+
+type FeeScheduleBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Fees bson.Raw `bson:"fees"`
+}
+
+func (s *FeeSchedule) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of FeeSchedule")
+
+	var u FeeScheduleBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Fees)
+}
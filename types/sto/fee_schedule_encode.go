@@ -0,0 +1,51 @@
+package sto
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (f *ServiceFee) unpack(ht hint.Hint, op, cid, flat string, rate uint64) error {
+	e := util.StringError("failed to unmarshal ServiceFee")
+
+	f.BaseHinter = hint.NewBaseHinter(ht)
+	f.operation = FeeOperation(op)
+	f.currency = currencytypes.CurrencyID(cid)
+	f.rate = rate
+
+	big, err := common.NewBigFromString(flat)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	f.flat = big
+
+	return nil
+}
+
+func (s *FeeSchedule) unpack(enc encoder.Encoder, ht hint.Hint, bfs []byte) error {
+	e := util.StringError("failed to unmarshal FeeSchedule")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+
+	hfs, err := enc.DecodeSlice(bfs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	fees := make([]ServiceFee, len(hfs))
+	for i := range hfs {
+		f, ok := hfs[i].(ServiceFee)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected ServiceFee, not %T", hfs[i]))
+		}
+
+		fees[i] = f
+	}
+	s.fees = fees
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package sto
+
+import (
+	"encoding/json"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ServiceFeeJSONMarshaler struct {
+	hint.BaseHinter
+	Operation FeeOperation             `json:"operation"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+	Flat      string                   `json:"flat"`
+	Rate      uint64                   `json:"rate"`
+}
+
+func (f ServiceFee) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ServiceFeeJSONMarshaler{
+		BaseHinter: f.BaseHinter,
+		Operation:  f.operation,
+		Currency:   f.currency,
+		Flat:       f.flat.String(),
+		Rate:       f.rate,
+	})
+}
+
+type ServiceFeeJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Operation string    `json:"operation"`
+	Currency  string    `json:"currency"`
+	Flat      string    `json:"flat"`
+	Rate      uint64    `json:"rate"`
+}
+
+func (f *ServiceFee) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ServiceFee")
+
+	var u ServiceFeeJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return f.unpack(u.Hint, u.Operation, u.Currency, u.Flat, u.Rate)
+}
+
+type FeeScheduleJSONMarshaler struct {
+	hint.BaseHinter
+	Fees []ServiceFee `json:"fees"`
+}
+
+func (s FeeSchedule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeScheduleJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Fees:       s.fees,
+	})
+}
+
+type FeeScheduleJSONUnmarshaler struct {
+	Hint hint.Hint       `json:"_hint"`
+	Fees json.RawMessage `json:"fees"`
+}
+
+func (s *FeeSchedule) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of FeeSchedule")
+
+	var u FeeScheduleJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Fees)
+}
@@ -0,0 +1,235 @@
+package sto
+
+import (
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	JurisdictionCapHint  = hint.MustNewHint("mitum-sto-jurisdiction-cap-v0.0.1")
+	JurisdictionRuleHint = hint.MustNewHint("mitum-sto-jurisdiction-rule-v0.0.1")
+)
+
+var MaxJurisdictions = 250
+
+// JurisdictionCap limits the number of tokenholders in jurisdiction.
+type JurisdictionCap struct {
+	hint.BaseHinter
+	jurisdiction kyctypes.Jurisdiction
+	maxHolders   uint64
+}
+
+func NewJurisdictionCap(jurisdiction kyctypes.Jurisdiction, maxHolders uint64) JurisdictionCap {
+	return JurisdictionCap{
+		BaseHinter:   hint.NewBaseHinter(JurisdictionCapHint),
+		jurisdiction: jurisdiction,
+		maxHolders:   maxHolders,
+	}
+}
+
+func (c JurisdictionCap) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		c.jurisdiction.Bytes(),
+		util.Uint64ToBytes(c.maxHolders),
+	)
+}
+
+func (c JurisdictionCap) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, c.BaseHinter, c.jurisdiction); err != nil {
+		return util.ErrInvalid.Errorf("invalid JurisdictionCap: %v", err)
+	}
+
+	if c.maxHolders < 1 {
+		return util.ErrInvalid.Errorf("zero max holders of jurisdiction, %q; deny it instead", c.jurisdiction)
+	}
+
+	return nil
+}
+
+func (c JurisdictionCap) Jurisdiction() kyctypes.Jurisdiction {
+	return c.jurisdiction
+}
+
+func (c JurisdictionCap) MaxHolders() uint64 {
+	return c.maxHolders
+}
+
+// JurisdictionRule restricts the tokenholders of sto by the jurisdiction of
+// their kyc records. When allowed is not empty, only the tokenholders in
+// allowed jurisdictions can receive tokens; tokenholders in denied
+// jurisdictions never can. Caps limit the number of tokenholders per
+// jurisdiction.
+type JurisdictionRule struct {
+	hint.BaseHinter
+	allowed []kyctypes.Jurisdiction
+	denied  []kyctypes.Jurisdiction
+	caps    []JurisdictionCap
+}
+
+func NewJurisdictionRule(allowed, denied []kyctypes.Jurisdiction, caps []JurisdictionCap) JurisdictionRule {
+	return JurisdictionRule{
+		BaseHinter: hint.NewBaseHinter(JurisdictionRuleHint),
+		allowed:    allowed,
+		denied:     denied,
+		caps:       caps,
+	}
+}
+
+// EmptyJurisdictionRule is the rule which does not restrict tokenholders.
+func EmptyJurisdictionRule() JurisdictionRule {
+	return NewJurisdictionRule(nil, nil, nil)
+}
+
+func (r JurisdictionRule) Bytes() []byte {
+	abs := make([][]byte, len(r.allowed))
+	for i := range r.allowed {
+		abs[i] = r.allowed[i].Bytes()
+	}
+
+	dbs := make([][]byte, len(r.denied))
+	for i := range r.denied {
+		dbs[i] = r.denied[i].Bytes()
+	}
+
+	cbs := make([][]byte, len(r.caps))
+	for i := range r.caps {
+		cbs[i] = r.caps[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(abs...),
+		util.ConcatBytesSlice(dbs...),
+		util.ConcatBytesSlice(cbs...),
+	)
+}
+
+func (r JurisdictionRule) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, r.BaseHinter); err != nil {
+		return util.ErrInvalid.Errorf("invalid JurisdictionRule: %v", err)
+	}
+
+	for _, l := range [][]kyctypes.Jurisdiction{r.allowed, r.denied} {
+		if n := len(l); n > MaxJurisdictions {
+			return util.ErrInvalid.Errorf("jurisdictions, %d over max, %d", n, MaxJurisdictions)
+		}
+	}
+
+	if n := len(r.caps); n > MaxJurisdictions {
+		return util.ErrInvalid.Errorf("jurisdiction caps, %d over max, %d", n, MaxJurisdictions)
+	}
+
+	allowed := map[kyctypes.Jurisdiction]struct{}{}
+	for _, j := range r.allowed {
+		if err := j.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := allowed[j]; found {
+			return util.ErrInvalid.Errorf("duplicate allowed jurisdiction found, %q", j)
+		}
+
+		allowed[j] = struct{}{}
+	}
+
+	denied := map[kyctypes.Jurisdiction]struct{}{}
+	for _, j := range r.denied {
+		if err := j.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := denied[j]; found {
+			return util.ErrInvalid.Errorf("duplicate denied jurisdiction found, %q", j)
+		}
+
+		if _, found := allowed[j]; found {
+			return util.ErrInvalid.Errorf("jurisdiction both allowed and denied, %q", j)
+		}
+
+		denied[j] = struct{}{}
+	}
+
+	capped := map[kyctypes.Jurisdiction]struct{}{}
+	for _, c := range r.caps {
+		if err := c.IsValid(nil); err != nil {
+			return err
+		}
+
+		j := c.Jurisdiction()
+
+		if _, found := capped[j]; found {
+			return util.ErrInvalid.Errorf("duplicate jurisdiction cap found, %q", j)
+		}
+
+		if _, found := denied[j]; found {
+			return util.ErrInvalid.Errorf("cap of denied jurisdiction, %q", j)
+		}
+
+		if _, found := allowed[j]; len(allowed) > 0 && !found {
+			return util.ErrInvalid.Errorf("cap of not allowed jurisdiction, %q", j)
+		}
+
+		capped[j] = struct{}{}
+	}
+
+	return nil
+}
+
+func (r JurisdictionRule) Allowed() []kyctypes.Jurisdiction {
+	return r.allowed
+}
+
+func (r JurisdictionRule) Denied() []kyctypes.Jurisdiction {
+	return r.denied
+}
+
+func (r JurisdictionRule) Caps() []JurisdictionCap {
+	return r.caps
+}
+
+func (r JurisdictionRule) IsEmpty() bool {
+	return len(r.allowed) < 1 && len(r.denied) < 1 && len(r.caps) < 1
+}
+
+// Check checks tokenholder in jurisdiction can hold tokens. Empty
+// jurisdiction, which means the kyc record does not have one, passes only the
+// empty rule.
+func (r JurisdictionRule) Check(j kyctypes.Jurisdiction) error {
+	if r.IsEmpty() {
+		return nil
+	}
+
+	if len(j) < 1 {
+		return util.ErrInvalid.Errorf("unknown jurisdiction of tokenholder")
+	}
+
+	for _, d := range r.denied {
+		if d == j {
+			return util.ErrInvalid.Errorf("jurisdiction denied, %q", j)
+		}
+	}
+
+	if len(r.allowed) < 1 {
+		return nil
+	}
+
+	for _, a := range r.allowed {
+		if a == j {
+			return nil
+		}
+	}
+
+	return util.ErrInvalid.Errorf("jurisdiction not allowed, %q", j)
+}
+
+// Cap returns the max tokenholders of jurisdiction; false when it is not
+// capped.
+func (r JurisdictionRule) Cap(j kyctypes.Jurisdiction) (uint64, bool) {
+	for _, c := range r.caps {
+		if c.Jurisdiction() == j {
+			return c.MaxHolders(), true
+		}
+	}
+
+	return 0, false
+}
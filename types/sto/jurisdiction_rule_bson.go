@@ -0,0 +1,77 @@
+package sto
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (c JurisdictionCap) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":        c.Hint().String(),
+			"jurisdiction": c.jurisdiction,
+			"max_holders":  c.maxHolders,
+		},
+	)
+}
+
+type JurisdictionCapBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	Jurisdiction string `bson:"jurisdiction"`
+	MaxHolders   uint64 `bson:"max_holders"`
+}
+
+func (c *JurisdictionCap) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of JurisdictionCap")
+
+	var u JurisdictionCapBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	c.unpack(ht, u.Jurisdiction, u.MaxHolders)
+
+	return nil
+}
+
+func (r JurisdictionRule) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   r.Hint().String(),
+			"allowed": r.allowed,
+			"denied":  r.denied,
+			"caps":    r.caps,
+		},
+	)
+}
+
+type JurisdictionRuleBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Allowed []string `bson:"allowed"`
+	Denied  []string `bson:"denied"`
+	Caps    bson.Raw `bson:"caps"`
+}
+
+func (r *JurisdictionRule) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of JurisdictionRule")
+
+	var u JurisdictionRuleBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Allowed, u.Denied, u.Caps)
+}
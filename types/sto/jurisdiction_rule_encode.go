@@ -0,0 +1,49 @@
+package sto
+
+import (
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (c *JurisdictionCap) unpack(ht hint.Hint, j string, maxHolders uint64) {
+	c.BaseHinter = hint.NewBaseHinter(ht)
+	c.jurisdiction = kyctypes.Jurisdiction(j)
+	c.maxHolders = maxHolders
+}
+
+func (r *JurisdictionRule) unpack(enc encoder.Encoder, ht hint.Hint, allowed, denied []string, bcs []byte) error {
+	e := util.StringError("failed to unmarshal JurisdictionRule")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+
+	r.allowed = make([]kyctypes.Jurisdiction, len(allowed))
+	for i := range allowed {
+		r.allowed[i] = kyctypes.Jurisdiction(allowed[i])
+	}
+
+	r.denied = make([]kyctypes.Jurisdiction, len(denied))
+	for i := range denied {
+		r.denied[i] = kyctypes.Jurisdiction(denied[i])
+	}
+
+	hcs, err := enc.DecodeSlice(bcs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	caps := make([]JurisdictionCap, len(hcs))
+	for i := range hcs {
+		c, ok := hcs[i].(JurisdictionCap)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected JurisdictionCap, not %T", hcs[i]))
+		}
+
+		caps[i] = c
+	}
+	r.caps = caps
+
+	return nil
+}
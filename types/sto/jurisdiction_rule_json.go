@@ -0,0 +1,77 @@
+package sto
+
+import (
+	"encoding/json"
+
+	kyctypes "github.com/ProtoconNet/mitum-sto/types/kyc"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type JurisdictionCapJSONMarshaler struct {
+	hint.BaseHinter
+	Jurisdiction kyctypes.Jurisdiction `json:"jurisdiction"`
+	MaxHolders   uint64                `json:"max_holders"`
+}
+
+func (c JurisdictionCap) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(JurisdictionCapJSONMarshaler{
+		BaseHinter:   c.BaseHinter,
+		Jurisdiction: c.jurisdiction,
+		MaxHolders:   c.maxHolders,
+	})
+}
+
+type JurisdictionCapJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	Jurisdiction string    `json:"jurisdiction"`
+	MaxHolders   uint64    `json:"max_holders"`
+}
+
+func (c *JurisdictionCap) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of JurisdictionCap")
+
+	var u JurisdictionCapJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	c.unpack(u.Hint, u.Jurisdiction, u.MaxHolders)
+
+	return nil
+}
+
+type JurisdictionRuleJSONMarshaler struct {
+	hint.BaseHinter
+	Allowed []kyctypes.Jurisdiction `json:"allowed"`
+	Denied  []kyctypes.Jurisdiction `json:"denied"`
+	Caps    []JurisdictionCap       `json:"caps"`
+}
+
+func (r JurisdictionRule) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(JurisdictionRuleJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Allowed:    r.allowed,
+		Denied:     r.denied,
+		Caps:       r.caps,
+	})
+}
+
+type JurisdictionRuleJSONUnmarshaler struct {
+	Hint    hint.Hint       `json:"_hint"`
+	Allowed []string        `json:"allowed"`
+	Denied  []string        `json:"denied"`
+	Caps    json.RawMessage `json:"caps"`
+}
+
+func (r *JurisdictionRule) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of JurisdictionRule")
+
+	var u JurisdictionRuleJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Allowed, u.Denied, u.Caps)
+}
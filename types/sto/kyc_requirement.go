@@ -0,0 +1,149 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var (
+	KYCServiceHint     = hint.MustNewHint("mitum-sto-kyc-service-v0.0.1")
+	KYCRequirementHint = hint.MustNewHint("mitum-sto-kyc-requirement-v0.0.1")
+)
+
+var MaxKYCServices = 10
+
+type KYCMode string
+
+const (
+	// KYCModeAnyOf requires verification by at least one of kyc services.
+	KYCModeAnyOf KYCMode = "any-of"
+	// KYCModeAllOf requires verification by every kyc service.
+	KYCModeAllOf KYCMode = "all-of"
+)
+
+func (m KYCMode) Bytes() []byte {
+	return []byte(m)
+}
+
+func (m KYCMode) String() string {
+	return string(m)
+}
+
+func (m KYCMode) IsValid([]byte) error {
+	switch m {
+	case KYCModeAnyOf, KYCModeAllOf:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("unknown kyc mode, %q", m)
+	}
+}
+
+// KYCService points to the kyc service, kycID of contract.
+type KYCService struct {
+	hint.BaseHinter
+	contract base.Address
+	kycID    currencytypes.ContractID
+}
+
+func NewKYCService(contract base.Address, kycID currencytypes.ContractID) KYCService {
+	return KYCService{
+		BaseHinter: hint.NewBaseHinter(KYCServiceHint),
+		contract:   contract,
+		kycID:      kycID,
+	}
+}
+
+func (s KYCService) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		s.contract.Bytes(),
+		s.kycID.Bytes(),
+	)
+}
+
+func (s KYCService) IsValid([]byte) error {
+	return util.CheckIsValiders(nil, false, s.BaseHinter, s.contract, s.kycID)
+}
+
+func (s KYCService) Contract() base.Address {
+	return s.contract
+}
+
+func (s KYCService) KYC() currencytypes.ContractID {
+	return s.kycID
+}
+
+func (s KYCService) String() string {
+	return s.contract.String() + "-" + s.kycID.String()
+}
+
+// KYCRequirement is the kyc services which verify the tokenholders of sto.
+// Empty services means sto does not require kyc.
+type KYCRequirement struct {
+	hint.BaseHinter
+	services []KYCService
+	mode     KYCMode
+}
+
+func NewKYCRequirement(services []KYCService, mode KYCMode) KYCRequirement {
+	return KYCRequirement{
+		BaseHinter: hint.NewBaseHinter(KYCRequirementHint),
+		services:   services,
+		mode:       mode,
+	}
+}
+
+// EmptyKYCRequirement is the requirement of sto which does not need kyc.
+func EmptyKYCRequirement() KYCRequirement {
+	return NewKYCRequirement(nil, KYCModeAnyOf)
+}
+
+func (r KYCRequirement) Bytes() []byte {
+	bs := make([][]byte, len(r.services))
+	for i, s := range r.services {
+		bs[i] = s.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(bs...),
+		r.mode.Bytes(),
+	)
+}
+
+func (r KYCRequirement) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false, r.BaseHinter, r.mode); err != nil {
+		return util.ErrInvalid.Errorf("invalid KYCRequirement: %v", err)
+	}
+
+	if n := len(r.services); n > MaxKYCServices {
+		return util.ErrInvalid.Errorf("kyc services, %d over max, %d", n, MaxKYCServices)
+	}
+
+	founds := map[string]struct{}{}
+	for _, s := range r.services {
+		if err := s.IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[s.String()]; found {
+			return util.ErrInvalid.Errorf("duplicate kyc service found, %s", s)
+		}
+
+		founds[s.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (r KYCRequirement) Services() []KYCService {
+	return r.services
+}
+
+func (r KYCRequirement) Mode() KYCMode {
+	return r.mode
+}
+
+func (r KYCRequirement) IsEmpty() bool {
+	return len(r.services) < 1
+}
@@ -0,0 +1,73 @@
+package sto
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s KYCService) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    s.Hint().String(),
+			"contract": s.contract,
+			"kycid":    s.kycID,
+		},
+	)
+}
+
+type KYCServiceBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Contract string `bson:"contract"`
+	KYC      string `bson:"kycid"`
+}
+
+func (s *KYCService) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of KYCService")
+
+	var u KYCServiceBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Contract, u.KYC)
+}
+
+func (r KYCRequirement) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    r.Hint().String(),
+			"services": r.services,
+			"mode":     r.mode,
+		},
+	)
+}
+
+type KYCRequirementBSONUnmarshaler struct {
+	Hint     string   `bson:"_hint"`
+	Services bson.Raw `bson:"services"`
+	Mode     string   `bson:"mode"`
+}
+
+func (r *KYCRequirement) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of KYCRequirement")
+
+	var u KYCRequirementBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Services, u.Mode)
+}
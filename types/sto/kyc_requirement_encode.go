@@ -0,0 +1,51 @@
+package sto
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (s *KYCService) unpack(enc encoder.Encoder, ht hint.Hint, ca, kyc string) error {
+	e := util.StringError("failed to unmarshal KYCService")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.kycID = currencytypes.ContractID(kyc)
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		s.contract = a
+	}
+
+	return nil
+}
+
+func (r *KYCRequirement) unpack(enc encoder.Encoder, ht hint.Hint, bss []byte, mode string) error {
+	e := util.StringError("failed to unmarshal KYCRequirement")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.mode = KYCMode(mode)
+
+	hss, err := enc.DecodeSlice(bss)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	services := make([]KYCService, len(hss))
+	for i := range hss {
+		s, ok := hss[i].(KYCService)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected KYCService, not %T", hss[i]))
+		}
+
+		services[i] = s
+	}
+	r.services = services
+
+	return nil
+}
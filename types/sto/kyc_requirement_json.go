@@ -0,0 +1,73 @@
+package sto
+
+import (
+	"encoding/json"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type KYCServiceJSONMarshaler struct {
+	hint.BaseHinter
+	Contract base.Address             `json:"contract"`
+	KYC      currencytypes.ContractID `json:"kycid"`
+}
+
+func (s KYCService) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KYCServiceJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Contract:   s.contract,
+		KYC:        s.kycID,
+	})
+}
+
+type KYCServiceJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Contract string    `json:"contract"`
+	KYC      string    `json:"kycid"`
+}
+
+func (s *KYCService) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of KYCService")
+
+	var u KYCServiceJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Contract, u.KYC)
+}
+
+type KYCRequirementJSONMarshaler struct {
+	hint.BaseHinter
+	Services []KYCService `json:"services"`
+	Mode     KYCMode      `json:"mode"`
+}
+
+func (r KYCRequirement) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(KYCRequirementJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Services:   r.services,
+		Mode:       r.mode,
+	})
+}
+
+type KYCRequirementJSONUnmarshaler struct {
+	Hint     hint.Hint       `json:"_hint"`
+	Services json.RawMessage `json:"services"`
+	Mode     string          `json:"mode"`
+}
+
+func (r *KYCRequirement) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of KYCRequirement")
+
+	var u KYCRequirementJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Services, u.Mode)
+}
@@ -6,9 +6,11 @@ import (
 	"github.com/ProtoconNet/mitum2/util"
 )
 
+// MinLengthPartition and MaxLengthPartition are the bounds of any partition;
+// the network policy narrows them for new partitions.
 var (
-	MinLengthPartition = 3
-	MaxLengthPartition = 10
+	MinLengthPartition = 2
+	MaxLengthPartition = 64
 	ReValidPartition   = regexp.MustCompile(`^[A-Z0-9][A-Z0-9_\.\!\$\*\@]*[A-Z0-9]$`)
 )
 
@@ -8,7 +8,10 @@ import (
 )
 
 var (
-	PolicyHint = hint.MustNewHint("mitum-sto-policy-v0.0.1")
+	PolicyHint = hint.MustNewHint("mitum-sto-policy-v0.0.2")
+	// LegacyPolicyHint is the policy without kyc requirement; it is decoded
+	// into Policy with empty kyc requirement.
+	LegacyPolicyHint = hint.MustNewHint("mitum-sto-policy-v0.0.1")
 )
 
 type Policy struct {
@@ -17,15 +20,23 @@ type Policy struct {
 	aggregate   common.Big
 	controllers []base.Address
 	documents   []Document
+	kyc         KYCRequirement
 }
 
-func NewPolicy(partitions []Partition, aggregate common.Big, controllers []base.Address, documents []Document) Policy {
+func NewPolicy(
+	partitions []Partition,
+	aggregate common.Big,
+	controllers []base.Address,
+	documents []Document,
+	kyc KYCRequirement,
+) Policy {
 	return Policy{
 		BaseHinter:  hint.NewBaseHinter(PolicyHint),
 		partitions:  partitions,
 		aggregate:   aggregate,
 		controllers: controllers,
 		documents:   documents,
+		kyc:         kyc,
 	}
 }
 
@@ -41,9 +52,17 @@ func (po Policy) Bytes() []byte {
 		bs[i+len(po.partitions)+len(po.controllers)] = p.Bytes()
 	}
 
+	// NOTE the policy without kyc requirement has the bytes of
+	// LegacyPolicyHint, so the designs of stored blocks keep their hashes
+	var kb []byte
+	if !po.kyc.IsEmpty() {
+		kb = po.kyc.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		util.ConcatBytesSlice(bs...),
 		po.aggregate.Bytes(),
+		kb,
 	)
 }
 
@@ -72,7 +91,7 @@ func (po Policy) IsValid([]byte) error {
 		}
 	}
 
-	return nil
+	return po.kyc.IsValid(nil)
 }
 
 func (po Policy) Partitions() []Partition {
@@ -90,3 +109,7 @@ func (po Policy) Controllers() []base.Address {
 func (po Policy) Documents() []Document {
 	return po.documents
 }
+
+func (po Policy) KYC() KYCRequirement {
+	return po.kyc
+}
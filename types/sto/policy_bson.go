@@ -16,6 +16,7 @@ func (po Policy) MarshalBSON() ([]byte, error) {
 			"aggregate":   po.aggregate.String(),
 			"controllers": po.controllers,
 			"documents":   po.documents,
+			"kyc":         po.kyc,
 		},
 	)
 }
@@ -26,6 +27,7 @@ type PolicyBSONUnmarshaler struct {
 	Aggregate   string   `bson:"aggregate"`
 	Controllers []string `bson:"controllers"`
 	Documents   bson.Raw `bson:"documents"`
+	KYC         bson.Raw `bson:"kyc"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -41,5 +43,5 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.Partitions, upo.Aggregate, upo.Controllers, upo.Documents)
+	return po.unpack(enc, ht, upo.Partitions, upo.Aggregate, upo.Controllers, upo.Documents, upo.KYC)
 }
@@ -9,10 +9,13 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, ps []string, big string, bcs []string, bds []byte) error {
+func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, ps []string, big string, bcs []string, bds, bk []byte) error {
 	e := util.StringError("failed to decode bson of Policy")
 
 	po.BaseHinter = hint.NewBaseHinter(ht)
+	if ht.Equal(LegacyPolicyHint) {
+		po.BaseHinter = hint.NewBaseHinter(PolicyHint)
+	}
 
 	partitions := make([]Partition, len(ps))
 	for i, p := range ps {
@@ -52,5 +55,19 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, ps []string, big str
 	}
 	po.documents = documents
 
+	if ht.Equal(LegacyPolicyHint) {
+		po.kyc = EmptyKYCRequirement()
+
+		return nil
+	}
+
+	if hinter, err := enc.Decode(bk); err != nil {
+		return e.Wrap(err)
+	} else if kyc, ok := hinter.(KYCRequirement); !ok {
+		return e.Wrap(errors.Errorf("expected KYCRequirement, not %T", hinter))
+	} else {
+		po.kyc = kyc
+	}
+
 	return nil
 }
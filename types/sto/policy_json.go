@@ -15,6 +15,7 @@ type PolicyJSONMarshaler struct {
 	Aggregate   string         `json:"aggregate"`
 	Controllers []base.Address `json:"controllers"`
 	Documents   []Document     `json:"documents"`
+	KYC         KYCRequirement `json:"kyc"`
 }
 
 func (po Policy) MarshalJSON() ([]byte, error) {
@@ -24,6 +25,7 @@ func (po Policy) MarshalJSON() ([]byte, error) {
 		Aggregate:   po.aggregate.String(),
 		Controllers: po.controllers,
 		Documents:   po.documents,
+		KYC:         po.kyc,
 	})
 }
 
@@ -33,6 +35,7 @@ type PolicyJSONUnmarshaler struct {
 	Aggregate   string          `json:"aggregate"`
 	Controllers []string        `json:"controllers"`
 	Documents   json.RawMessage `json:"documents"`
+	KYC         json.RawMessage `json:"kyc"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -43,5 +46,5 @@ func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.Partitions, upo.Aggregate, upo.Controllers, upo.Documents)
+	return po.unpack(enc, upo.Hint, upo.Partitions, upo.Aggregate, upo.Controllers, upo.Documents, upo.KYC)
 }